@@ -0,0 +1,101 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// AdaptiveConcurrency configures adaptive concurrency limiting for upstream backends.
+//
+// Envoy periodically measures the minimum round-trip time (minRTT) of the requests
+// while limiting the concurrency to a small value, then samples the latencies of
+// the requests and adjusts the concurrency limit with a gradient controller:
+// the limit grows while the sampled latency stays close to the minRTT, and shrinks
+// when it rises. Requests exceeding the limit are rejected with a 503 response.
+// Unlike static circuit breaker thresholds, the limit follows the capacity of the
+// backends as it changes with deploys and autoscaling.
+//
+// All fields are optional. When omitted, Envoy's gradient controller defaults are used.
+type AdaptiveConcurrency struct {
+	// SampleAggregatePercentile is the percentile of the sampled request latencies,
+	// in the range [1, 100], compared with the minRTT to compute the concurrency limit.
+	// Defaults to 50.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	SampleAggregatePercentile *uint32 `json:"sampleAggregatePercentile,omitempty"`
+
+	// ConcurrencyLimit defines the bounds and the update interval of the concurrency limit.
+	//
+	// +optional
+	ConcurrencyLimit *AdaptiveConcurrencyLimit `json:"concurrencyLimit,omitempty"`
+
+	// MinRTT defines how the minimum round-trip time of the requests is measured.
+	//
+	// +optional
+	MinRTT *AdaptiveConcurrencyMinRTT `json:"minRTT,omitempty"`
+}
+
+// AdaptiveConcurrencyLimit defines the bounds and the update interval of the concurrency limit.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.min) || !has(self.max) || self.min <= self.max",message="min must be less than or equal to max"
+// +kubebuilder:validation:XValidation:rule="!has(self.updateInterval) || duration(self.updateInterval) > duration('0s')",message="updateInterval must be greater than 0s"
+type AdaptiveConcurrencyLimit struct {
+	// Min is the lower bound of the concurrency limit. It is also the concurrency
+	// limit applied while the minRTT is measured. Defaults to 3.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Min *uint32 `json:"min,omitempty"`
+
+	// Max is the upper bound of the concurrency limit. Defaults to 1000.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Max *uint32 `json:"max,omitempty"`
+
+	// UpdateInterval is the period of time over which the request latencies are
+	// sampled before the concurrency limit is recalculated. Defaults to 100ms.
+	//
+	// +optional
+	UpdateInterval *gwapiv1.Duration `json:"updateInterval,omitempty"`
+}
+
+// AdaptiveConcurrencyMinRTT defines how the minimum round-trip time of the requests is measured.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.interval) || duration(self.interval) > duration('0s')",message="interval must be greater than 0s"
+type AdaptiveConcurrencyMinRTT struct {
+	// Interval is the time between two measurements of the minRTT. Defaults to 60s.
+	//
+	// +optional
+	Interval *gwapiv1.Duration `json:"interval,omitempty"`
+
+	// RequestCount is the number of requests sampled to measure the minRTT. Defaults to 50.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RequestCount *uint32 `json:"requestCount,omitempty"`
+
+	// JitterPercent is the random delay added to the start of each minRTT measurement, as a
+	// percentage of the interval in the range [0, 100], so that the proxies don't all reduce
+	// their concurrency at the same time. Defaults to 15.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	JitterPercent *uint32 `json:"jitterPercent,omitempty"`
+
+	// BufferPercent is the tolerance added to the measured minRTT, as a percentage of the
+	// measured value in the range [0, 100], to absorb the natural variability of the latency.
+	// Defaults to 25.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	BufferPercent *uint32 `json:"bufferPercent,omitempty"`
+}
@@ -119,7 +119,7 @@ type Operation struct {
 // or any other identity that can be extracted from a custom header.
 // If there are multiple principal types, all principals must match for the rule to match.
 //
-// +kubebuilder:validation:XValidation:rule="(has(self.clientCIDRs) || has(self.jwt) || has(self.headers) || has(self.clientIPGeoLocations))",message="at least one of clientCIDRs, jwt, headers, or clientIPGeoLocations must be specified"
+// +kubebuilder:validation:XValidation:rule="(has(self.clientCIDRs) || has(self.jwt) || has(self.headers) || has(self.clientIPGeoLocations) || has(self.clientIPTags) || has(self.clientCertificate))",message="at least one of clientCIDRs, jwt, headers, clientIPGeoLocations, clientIPTags, or clientCertificate must be specified"
 type Principal struct {
 	// ClientCIDRs are the IP CIDR ranges of the client.
 	// Valid examples are "192.168.1.0/24" or "2001:db8::/64"
@@ -171,6 +171,77 @@ type Principal struct {
 	// +optional
 	// +kubebuilder:validation:MinItems=1
 	ClientIPGeoLocations []ClientIPGeoLocation `json:"clientIPGeoLocations,omitempty"`
+
+	// ClientIPTags authorizes the request based on the IP tags of the client IP.
+	// The tags are defined in the `ipTagging` field of the EnvoyProxy.
+	// This field is supported for HTTPRoute and GRPCRoute authorization.
+	// It is not supported for TCPRoute targets.
+	//
+	// If multiple tags are specified, one of the tags must match for the rule to match.
+	//
+	// The client IP is inferred from the X-Forwarded-For header, a custom header, or the
+	// proxy protocol, as configured by the `ClientIPDetection` field in the `ClientTrafficPolicy`.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ClientIPTags []string `json:"clientIPTags,omitempty"`
+
+	// ClientCertificate authorizes the request based on the identity of the client
+	// certificate, as verified by the mTLS handshake.
+	// This field is supported for HTTPRoute, GRPCRoute and TCPRoute authorization.
+	//
+	// The client certificate is only available when the listener requires or accepts
+	// client certificates, as configured by the `TLS.ClientValidation` field in the
+	// `ClientTrafficPolicy`. A rule with ClientCertificate never matches a connection
+	// without a client certificate.
+	//
+	// +optional
+	ClientCertificate *ClientCertificatePrincipal `json:"clientCertificate,omitempty"`
+}
+
+// ClientCertificatePrincipal specifies the client certificate identity match criteria for authorization.
+// If multiple fields are specified, all of them must match for the principal to match.
+//
+// The issuer of the client certificate can't be matched, since Envoy doesn't expose it to the
+// authorization rules. To only accept the certificates of an issuer, trust only this issuer
+// in the client validation of the ClientTrafficPolicy.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.subject) || has(self.dnsNames) || has(self.uris)",message="at least one of subject, dnsNames, or uris must be specified"
+type ClientCertificatePrincipal struct {
+	// Subject matches the subject distinguished name of the client certificate,
+	// in the RFC 2253 format, for example "CN=billing,O=Example Corp".
+	//
+	// +optional
+	Subject *StringMatch `json:"subject,omitempty"`
+
+	// DNSNames matches the DNS subject alternative names of the client certificate.
+	// If multiple matches are specified, one of them must match one of the DNS names
+	// of the client certificate.
+	//
+	// Envoy provides the SANs as a comma-separated list, so a SAN containing a comma is
+	// matched as several SANs, and the values, other than regular expressions, can't
+	// contain a comma. A regular expression never matches a comma.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	DNSNames []StringMatch `json:"dnsNames,omitempty"`
+
+	// URIs matches the URI subject alternative names of the client certificate,
+	// such as SPIFFE IDs.
+	// If multiple matches are specified, one of them must match one of the URIs
+	// of the client certificate.
+	// As for DNSNames, a SAN containing a comma is matched as several SANs.
+	//
+	// For example, the following regular expression matches the SPIFFE ID of the
+	// `billing` service account in any namespace:
+	// `^spiffe://corp/ns/[^/]+/sa/billing$`
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	URIs []StringMatch `json:"uris,omitempty"`
 }
 
 // ClientIPGeoLocation specifies geolocation-based match criteria for authorization.
@@ -132,6 +132,7 @@ type UnixSocket struct {
 
 // BackendSpec describes the desired state of BackendSpec.
 // +kubebuilder:validation:XValidation:rule="self.type != 'DynamicResolver' || !has(self.endpoints)",message="DynamicResolver type cannot have endpoints specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.dnsResolver) || self.type == 'DynamicResolver' || (has(self.endpoints) && self.endpoints.all(e, has(e.fqdn)))",message="dnsResolver can only be used with FQDN endpoints or the DynamicResolver type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'DynamicResolver' || !has(self.tls) || !(has(self.tls.autoSNIFromEndpointHostname) && self.tls.autoSNIFromEndpointHostname)",message="DynamicResolver type cannot use autoSNIFromEndpointHostname"
 // +kubebuilder:validation:XValidation:rule="!has(self.tls) || !(has(self.tls.autoSNIFromEndpointHostname) && self.tls.autoSNIFromEndpointHostname) || self.endpoints.all(e, (!has(e.ip) && !has(e.unix)) || has(e.hostname))",message="when autoSNIFromEndpointHostname is enabled, IP and Unix endpoints must define a hostname"
 type BackendSpec struct {
@@ -170,6 +171,15 @@ type BackendSpec struct {
 	//
 	// +optional
 	TLS *BackendTLSSettings `json:"tls,omitempty"`
+
+	// DNSResolver defines the DNS resolver used to resolve the FQDN endpoints of the backend,
+	// or the hosts of a DynamicResolver backend.
+	// If set, this configuration overrides the DNS resolver of the EnvoyProxy.
+	// The FQDN backends of a route rule must use the same DNS resolver, as they share one cluster.
+	// It isn't applied to the OpenTelemetry metrics sinks, which use the default DNS resolver of Envoy.
+	//
+	// +optional
+	DNSResolver *DNSResolver `json:"dnsResolver,omitempty"`
 }
 
 // BackendTLSSettings holds the TLS settings for the backend.
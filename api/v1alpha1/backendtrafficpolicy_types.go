@@ -47,6 +47,7 @@ type BackendTrafficPolicy struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.compression) || !has(self.compressor)", message="either compression or compressor can be set, not both"
 // +kubebuilder:validation:XValidation:rule="!has(self.requestBuffer) || !has(self.httpUpgrade) || self.httpUpgrade.size() == 0", message="requestBuffer cannot be used together with httpUpgrade"
 // +kubebuilder:validation:XValidation:rule="!has(self.admissionControl) || ((!has(self.targetRef) || self.targetRef.kind in ['Gateway', 'ListenerSet', 'HTTPRoute', 'GRPCRoute']) && (!has(self.targetRefs) || self.targetRefs.all(ref, ref.kind in ['Gateway', 'ListenerSet', 'HTTPRoute', 'GRPCRoute'])) && (!has(self.targetSelectors) || self.targetSelectors.all(sel, sel.kind in ['Gateway', 'ListenerSet', 'HTTPRoute', 'GRPCRoute'])))", message="admissionControl can only be used with HTTPRoute, GRPCRoute, Gateway, or ListenerSet targets"
+// +kubebuilder:validation:XValidation:rule="!has(self.cache) || ((!has(self.targetRef) || self.targetRef.kind in ['Gateway', 'ListenerSet', 'HTTPRoute', 'GRPCRoute']) && (!has(self.targetRefs) || self.targetRefs.all(ref, ref.kind in ['Gateway', 'ListenerSet', 'HTTPRoute', 'GRPCRoute'])) && (!has(self.targetSelectors) || self.targetSelectors.all(sel, sel.kind in ['Gateway', 'ListenerSet', 'HTTPRoute', 'GRPCRoute'])))", message="cache can only be used with HTTPRoute, GRPCRoute, Gateway, or ListenerSet targets"
 type BackendTrafficPolicySpec struct {
 	PolicyTargetReferences `json:",inline"`
 	ClusterSettings        `json:",inline"`
@@ -64,14 +65,29 @@ type BackendTrafficPolicySpec struct {
 
 	// RateLimit allows the user to limit the number of incoming requests
 	// to a predefined value based on attributes within the traffic flow.
+	//
+	// For TCPRoute and TLSRoute, the Local rate limit limits the number of new connections.
+	// Only the rules without clientSelectors or with a single non-distinct and non-inverted
+	// sourceCIDR selector apply to connections, the other rules are ignored and reported in the
+	// policy status. A connection is limited by the rules of the most specific sourceCIDR matching
+	// the client address, regardless of the order of the rules, the rules sharing the same
+	// sourceCIDR all apply.
 	// +optional
 	RateLimit *RateLimitSpec `json:"rateLimit,omitempty"`
 
 	// BandwidthLimit allows the user to limit the bandwidth of traffic
 	// sent to and received from the backend.
+	//
+	// For TCPRoute and TLSRoute, the request limit applies to the data received from
+	// the client, and the response limit to the data sent to the client.
 	// +optional
 	BandwidthLimit *BandwidthLimitSpec `json:"bandwidthLimit,omitempty"`
 
+	// UDPTimeout defines the timeout settings of the UDP sessions, only applicable to UDPRoutes.
+	//
+	// +optional
+	UDPTimeout *UDPTimeout `json:"udpTimeout,omitempty"`
+
 	// FaultInjection defines the fault injection policy to be applied. This configuration can be used to
 	// inject delays and abort requests to mimic failure scenarios such as service failures and overloads
 	// +optional
@@ -83,6 +99,12 @@ type BackendTrafficPolicySpec struct {
 	// +optional
 	AdmissionControl *AdmissionControl `json:"admissionControl,omitempty"`
 
+	// AdaptiveConcurrency defines the adaptive concurrency limit to be applied. This configuration
+	// dynamically limits the number of concurrent requests sent to the backends based on the
+	// sampled latencies of the requests.
+	// +optional
+	AdaptiveConcurrency *AdaptiveConcurrency `json:"adaptiveConcurrency,omitempty"`
+
 	// UseClientProtocol configures Envoy to prefer sending requests to backends using
 	// the same HTTP protocol that the incoming request used. Defaults to false, which means
 	// that Envoy will use the protocol indicated by the attached BackendRef.
@@ -110,6 +132,13 @@ type BackendTrafficPolicySpec struct {
 	// +optional
 	Compressor []*Compression `json:"compressor,omitempty" patchMergeKey:"type" patchStrategy:"merge"`
 
+	// Decompression enables the decompression of the compressed request bodies, and
+	// optionally response bodies, so that the filters and the backends that cannot
+	// inflate the payloads receive them decompressed.
+	//
+	// +optional
+	Decompression *Decompression `json:"decompression,omitempty"`
+
 	// ResponseOverride defines the configuration to override specific responses with a custom one.
 	// If multiple configurations are specified, the first one to match wins.
 	//
@@ -140,6 +169,13 @@ type BackendTrafficPolicySpec struct {
 	//
 	// +optional
 	RequestBuffer *RequestBuffer `json:"requestBuffer,omitempty"`
+
+	// Cache enables caching of the HTTP responses from the backends, so that
+	// identical requests can be served by Envoy without reaching the backends.
+	//
+	// +optional
+	Cache *Cache `json:"cache,omitempty"`
+
 	// Telemetry configures the telemetry settings for the policy target (Gateway or xRoute).
 	// This will override the telemetry settings in the EnvoyProxy resource.
 	//
@@ -0,0 +1,109 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import "k8s.io/apimachinery/pkg/api/resource"
+
+// CacheType defines the type of storage used to cache HTTP responses.
+// +kubebuilder:validation:Enum=FileSystem
+type CacheType string
+
+const (
+	// FileSystemCacheType stores the cached responses on the local file system
+	// of each Envoy proxy, evicting the least recently used entries once the
+	// cache reaches its size limit.
+	FileSystemCacheType CacheType = "FileSystem"
+)
+
+// Cache defines the configuration for caching HTTP responses.
+// Responses are cached and validated according to the cache-control headers of
+// the requests and responses, as described by RFC 9111.
+type Cache struct {
+	// Type is the type of storage used to cache the responses.
+	// Currently, only FileSystem is supported, which stores the responses on the
+	// local file system of each Envoy proxy. The cache is not shared between
+	// Envoy replicas, and it is lost when the proxy pod is recreated.
+	//
+	// +kubebuilder:default=FileSystem
+	// +optional
+	Type CacheType `json:"type,omitempty"`
+
+	// MaxSize is the maximum total size of the cached responses in each Envoy proxy.
+	// Once the limit is reached, the least recently used responses are evicted.
+	// Defaults to 256Mi.
+	//
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Pattern="^[1-9]+[0-9]*([EPTGMK]i|[EPTGMk])?$"
+	// +kubebuilder:default="256Mi"
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+
+	// MaxEntrySize is the maximum size of a single cached response.
+	// Larger responses are not cached. If unset, a response can take up
+	// the whole cache.
+	//
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Pattern="^[1-9]+[0-9]*([EPTGMK]i|[EPTGMk])?$"
+	// +optional
+	MaxEntrySize *resource.Quantity `json:"maxEntrySize,omitempty"`
+
+	// AllowedVaryHeaders is an allow list of the request header names that are
+	// allowed to appear in the `vary` header of a cacheable response.
+	// A response that varies on any header not matched by this list is not cached.
+	// If unset, responses with a `vary` header are not cached.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	AllowedVaryHeaders []StringMatch `json:"allowedVaryHeaders,omitempty"`
+
+	// Key customizes how the cache key is computed from the request.
+	// By default, the scheme, host, path and all the query parameters of the
+	// request are part of the cache key.
+	//
+	// +optional
+	Key *CacheKey `json:"key,omitempty"`
+
+	// IgnoreRequestCacheControl ignores the `cache-control: no-cache` and
+	// `pragma: no-cache` request headers. By default, these headers cause the
+	// cached response to be validated with the backend even on a cache hit.
+	//
+	// +optional
+	IgnoreRequestCacheControl *bool `json:"ignoreRequestCacheControl,omitempty"`
+}
+
+// CacheKey defines which parts of the request are used to compute the cache key.
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.includedQueryParameters) && has(self.excludedQueryParameters))",message="only one of includedQueryParameters or excludedQueryParameters can be set"
+type CacheKey struct {
+	// ExcludeScheme excludes the URL scheme from the cache key.
+	// Set it to true if the backends always return the same response for
+	// http and https requests.
+	//
+	// +optional
+	ExcludeScheme *bool `json:"excludeScheme,omitempty"`
+
+	// ExcludeHost excludes the host from the cache key.
+	// Set it to true if the responses of the backends never depend on the host.
+	//
+	// +optional
+	ExcludeHost *bool `json:"excludeHost,omitempty"`
+
+	// IncludedQueryParameters is a list of query parameter names. If set, only
+	// these query parameters are part of the cache key.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	IncludedQueryParameters []string `json:"includedQueryParameters,omitempty"`
+
+	// ExcludedQueryParameters is a list of query parameter names that are
+	// excluded from the cache key.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	ExcludedQueryParameters []string `json:"excludedQueryParameters,omitempty"`
+}
@@ -69,3 +69,39 @@ type Compression struct {
 	// +kubebuilder:validation:Pattern="^[1-9]+[0-9]*([EPTGMK]i|[EPTGMk])?$"
 	MinContentLength *resource.Quantity `json:"minContentLength,omitempty"`
 }
+
+// Decompression defines the config of decompressing the request bodies sent by the
+// clients, and optionally the response bodies sent by the backends, before they are
+// processed by the other filters, such as ExtAuth with body forwarding, ExtProc or Lua.
+//
+// The decompressed bodies are forwarded to the backends and the clients without
+// the Content-Encoding header.
+//
+// The size of the decompressed bodies is not limited. The gzip decompressor limits the ratio
+// between the decompressed and the compressed size to 100, the brotli and zstd decompressors
+// have no such limit.
+type Decompression struct {
+	// Decompressor defines the content encodings that can be decompressed.
+	// The request bodies with any other content encoding are forwarded as is.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:XValidation:rule="self.all(d, self.exists_one(x, x.type == d.type))",message="decompressor types must be unique"
+	Decompressor []*Decompressor `json:"decompressor"`
+
+	// Response enables the decompression of the response bodies sent by the backends,
+	// in addition to the request bodies. The content encodings are also advertised in
+	// the Accept-Encoding header of the requests sent to the backends.
+	// Default: false
+	//
+	// +optional
+	Response *bool `json:"response,omitempty"`
+}
+
+// Decompressor defines the config of a decompressor library.
+type Decompressor struct {
+	// Type defines the content encoding to decompress.
+	//
+	// +required
+	Type CompressorType `json:"type"`
+}
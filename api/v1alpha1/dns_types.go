@@ -44,4 +44,51 @@ type DNS struct {
 	// If set, this configuration overrides other defaults.
 	// +optional
 	LookupFamily *DNSLookupFamily `json:"lookupFamily,omitempty"`
+	// Resolver defines the DNS resolver used to resolve the hostnames of the backends.
+	// If set, this configuration overrides the DNS resolver of the Backend and of the EnvoyProxy.
+	// It isn't applied to the OpenTelemetry metrics sinks, which use the default DNS resolver of Envoy.
+	//
+	// +optional
+	Resolver *DNSResolver `json:"resolver,omitempty"`
+}
+
+// DNSResolverType defines the implementation of the DNS resolver.
+// +kubebuilder:validation:Enum=CAres;GetAddrInfo
+type DNSResolverType string
+
+const (
+	// CAresDNSResolverType resolves the hostnames with the c-ares library,
+	// which is the default DNS resolver of Envoy.
+	CAresDNSResolverType DNSResolverType = "CAres"
+	// GetAddrInfoDNSResolverType resolves the hostnames with the getaddrinfo system call,
+	// which follows the resolver configuration of the host, such as /etc/nsswitch.conf.
+	GetAddrInfoDNSResolverType DNSResolverType = "GetAddrInfo"
+)
+
+// DNSResolver defines the DNS resolver used by Envoy to resolve the hostnames of the backends.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type == 'CAres' || (!has(self.addresses) && !has(self.useTCP) && !has(self.noDefaultSearchDomain))",message="addresses, useTCP and noDefaultSearchDomain are only supported by the CAres resolver"
+type DNSResolver struct {
+	// Type is the implementation of the DNS resolver.
+	// Defaults to CAres.
+	//
+	// +optional
+	Type *DNSResolverType `json:"type,omitempty"`
+	// Addresses is the list of the DNS servers used to resolve the hostnames,
+	// instead of the DNS servers of the host.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Addresses []IPEndpoint `json:"addresses,omitempty"`
+	// UseTCP sends the DNS queries over TCP instead of UDP.
+	// Defaults to false.
+	//
+	// +optional
+	UseTCP *bool `json:"useTCP,omitempty"`
+	// NoDefaultSearchDomain disables the search domains of the host, such as the
+	// search domains of the pod, so that the hostnames are resolved as they are.
+	// Defaults to false.
+	//
+	// +optional
+	NoDefaultSearchDomain *bool `json:"noDefaultSearchDomain,omitempty"`
 }
@@ -89,6 +89,49 @@ type EnvoyExtensionPolicySpec struct {
 	// +kubebuilder:validation:MaxItems=16
 	// +optional
 	DynamicModule []DynamicModule `json:"dynamicModule,omitempty"`
+
+	// GRPCJSONTranscoder configures the gRPC-JSON transcoder filter, which
+	// allows RESTful JSON clients to call gRPC services.
+	//
+	// +optional
+	GRPCJSONTranscoder *GRPCJSONTranscoder `json:"grpcJSONTranscoder,omitempty"`
+}
+
+// ExtensionMatch defines the conditions of a request for an extension to be executed.
+// All the specified conditions must match for the extension to be executed.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.headers) || has(self.path) || has(self.methods) || has(self.cel)",message="at least one of headers, path, methods or cel must be specified"
+type ExtensionMatch struct {
+	// Headers are the HTTP headers of the request.
+	// If multiple headers are specified, all headers must match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Headers []AuthorizationHeaderMatch `json:"headers,omitempty"`
+
+	// Path is the HTTP path of the request.
+	// Support Exact, PathPrefix and RegularExpression match types.
+	//
+	// +optional
+	Path *PathMatch `json:"path,omitempty"`
+
+	// Methods are the HTTP methods of the request.
+	// If multiple methods are specified, the request matches if its method is any of them.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Methods []gwapiv1.HTTPMethod `json:"methods,omitempty"`
+
+	// CEL is a Common Expression Language expression evaluated against the request.
+	// The request matches if the expression evaluates to true.
+	//
+	// Examples:
+	// `request.headers['x-tenant'] == 'team-a'`
+	//
+	// +optional
+	CEL *CELExpression `json:"cel,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -134,6 +134,14 @@ func (e *EnvoyGateway) GatewayNamespaceMode() bool {
 		*e.Provider.Kubernetes.Deploy.Type == KubernetesDeployModeTypeGatewayNamespace
 }
 
+// RateLimitQuotaTLS returns the TLS settings of the rate limit quota service, or nil if it doesn't use TLS.
+func (e *EnvoyGateway) RateLimitQuotaTLS() *RateLimitQuotaTLSSettings {
+	if e.RateLimitQuota == nil {
+		return nil
+	}
+	return e.RateLimitQuota.TLS
+}
+
 // TopologyInjectorDisabled checks whether the provided EnvoyGateway disables TopologyInjector
 func (e *EnvoyGateway) TopologyInjectorDisabled() bool {
 	if e.Provider != nil &&
@@ -169,8 +177,9 @@ func (e *EnvoyGateway) GetEnvoyProxyDefaultSpec() *EnvoyProxySpec {
 
 // defaultRuntimeFlags are the default runtime flags for Envoy Gateway.
 var defaultRuntimeFlags = map[RuntimeFlag]bool{
-	XDSNameSchemeV2:    false,
-	EndpointSliceIndex: true,
+	XDSNameSchemeV2:        false,
+	EndpointSliceIndex:     true,
+	IncrementalTranslation: false,
 }
 
 // IsEnabled checks if an experimental Gateway API is enabled in the EnvoyGateway configuration.
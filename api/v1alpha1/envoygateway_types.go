@@ -91,6 +91,14 @@ type EnvoyGatewaySpec struct {
 	// +optional
 	RateLimit *RateLimit `json:"rateLimit,omitempty"`
 
+	// RateLimitQuota defines the configuration associated with the external Rate Limit
+	// Quota Service (RLQS) required to implement the Quota rate limiting functionality.
+	// Unlike the Global rate limit service, the RLQS server is not deployed by Envoy Gateway.
+	// This configuration is unneeded for "Global" and "Local" rate limiting.
+	//
+	// +optional
+	RateLimitQuota *RateLimitQuota `json:"rateLimitQuota,omitempty"`
+
 	// ExtensionManager defines an extension manager to register for the Envoy Gateway Control Plane.
 	//
 	// Warning: Enabling an Extension Server may lead to complete security compromise of your system.
@@ -162,7 +170,7 @@ type GatewayAPISettings struct {
 // RuntimeFlag defines a runtime flag used to guard breaking changes or risky experimental features in new Envoy Gateway releases.
 // A runtime flag may be enabled or disabled by default and can be toggled through the EnvoyGateway resource.
 // +enum
-// +kubebuilder:validation:Enum=XDSNameSchemeV2;EndpointSliceIndex;PerResourceSystemCASecret
+// +kubebuilder:validation:Enum=XDSNameSchemeV2;EndpointSliceIndex;PerResourceSystemCASecret;IncrementalTranslation
 type RuntimeFlag string
 
 const (
@@ -183,6 +191,12 @@ const (
 	// upgrades — Envoy must warm the new system_ca_certificates secret before clusters can use
 	// it, which may cause a brief disruption to new connections on first enable.
 	PerResourceSystemCASecret RuntimeFlag = "PerResourceSystemCASecret" //nolint:gosec // not a credential
+
+	// IncrementalTranslation indicates that only the Gateways affected by a resource change are translated again,
+	// and only their IR is published again.
+	// It is disabled by default. Enabling it reduces CPU usage in clusters with many Routes and policies,
+	// consider disabling it again if the status or configuration of a Gateway isn't updated as expected.
+	IncrementalTranslation RuntimeFlag = "IncrementalTranslation"
 )
 
 // RuntimeFlags provide a mechanism to guard breaking changes or risky experimental features in new Envoy Gateway releases.
@@ -244,6 +258,84 @@ type XDSServer struct {
 	//
 	// +optional
 	MaxReceiveMessageSize *resource.Quantity `json:"maxReceiveMessageSize,omitempty"`
+
+	// SnapshotCheckpoint persists the last xDS snapshot served for each IR key, so that
+	// after a restart Envoy Gateway serves it to the proxies right away, instead of
+	// leaving them without configuration until the resources are read and translated again.
+	//
+	// When enabled, the snapshot versions are derived from the content of the resources,
+	// so the proxies that reconnect after a restart don't receive the unchanged resources again.
+	//
+	// The snapshots include the secrets, such as the TLS private keys, so they are either
+	// encrypted or stored in Secrets.
+	// The snapshots are written in the background, shortly after they are updated.
+	//
+	// +optional
+	SnapshotCheckpoint *XDSSnapshotCheckpoint `json:"snapshotCheckpoint,omitempty"`
+}
+
+// XDSSnapshotCheckpoint defines where the xDS snapshots are persisted.
+type XDSSnapshotCheckpoint struct {
+	// Type is the type of the storage of the snapshots. Supported types are:
+	//	* File: Writes the snapshots to a local directory, encrypted.
+	//	* Secret: Writes the snapshots to Secrets in the Envoy Gateway namespace.
+	//
+	// +unionDiscriminator
+	Type XDSSnapshotCheckpointType `json:"type"`
+
+	// File defines the local directory the snapshots are written to.
+	//
+	// +optional
+	File *XDSSnapshotCheckpointFile `json:"file,omitempty"`
+
+	// Secret defines the Secrets the snapshots are written to.
+	//
+	// +optional
+	Secret *XDSSnapshotCheckpointSecret `json:"secret,omitempty"`
+
+	// WarmStartTimeout is how long the persisted snapshots are served after a restart
+	// for the IR keys that haven't been translated again. Once it expires, these
+	// snapshots are discarded, since their Gateways likely no longer exist.
+	// Defaults to 5m.
+	//
+	// +optional
+	WarmStartTimeout *gwapiv1.Duration `json:"warmStartTimeout,omitempty"`
+}
+
+// XDSSnapshotCheckpointType specifies the types of storage of the xDS snapshots.
+// +kubebuilder:validation:Enum=File;Secret
+type XDSSnapshotCheckpointType string
+
+const (
+	// FileXDSSnapshotCheckpointType writes the xDS snapshots to a local directory.
+	FileXDSSnapshotCheckpointType XDSSnapshotCheckpointType = "File"
+	// SecretXDSSnapshotCheckpointType writes the xDS snapshots to Secrets.
+	SecretXDSSnapshotCheckpointType XDSSnapshotCheckpointType = "Secret"
+)
+
+// XDSSnapshotCheckpointFile defines the local directory of the xDS snapshots.
+type XDSSnapshotCheckpointFile struct {
+	// Path is the directory the snapshots are written to, one file per IR key.
+	// It should be backed by a volume that outlives the Envoy Gateway container.
+	Path string `json:"path"`
+
+	// EncryptionKeyPath is the path of the file holding the key the snapshots are
+	// encrypted with, a base64 encoded 32 bytes AES-256 key, e.g. generated with
+	// `openssl rand -base64 32`.
+	EncryptionKeyPath string `json:"encryptionKeyPath"`
+}
+
+// XDSSnapshotCheckpointSecret defines the Secrets of the xDS snapshots.
+type XDSSnapshotCheckpointSecret struct {
+	// Name is the prefix of the names of the Secrets in the Envoy Gateway namespace,
+	// the snapshots are stored compressed, one Secret per IR key.
+	// A Secret is limited to 1MiB, the snapshots that exceed it aren't persisted, an error
+	// is logged and the xds_snapshot_checkpoint_errors_total metric is incremented.
+	// The File type should be used for larger configurations.
+	// Defaults to "envoy-gateway-xds-snapshots".
+	//
+	// +optional
+	Name *string `json:"name,omitempty"`
 }
 
 // LeaderElection defines the desired leader election settings.
@@ -675,6 +767,47 @@ type RateLimit struct {
 	Telemetry *RateLimitTelemetry `json:"telemetry,omitempty"`
 }
 
+// RateLimitQuota defines the configuration associated with the Rate Limit Quota Service
+// used for Quota Rate Limiting.
+type RateLimitQuota struct {
+	// URL is the address of the Rate Limit Quota Service, which implements the
+	// RLQS gRPC protocol. The URL must be in the form "grpc://<host>:<port>".
+	// For example, "grpc://rlqs.quota-system.svc.cluster.local:18081".
+	URL string `json:"url"`
+
+	// Timeout specifies the timeout period for the proxy to connect to the
+	// rate limit quota server. If not set, timeout is 10s.
+	//
+	// +optional
+	Timeout *gwapiv1.Duration `json:"timeout,omitempty"`
+
+	// TLS defines the TLS settings for the connections from the proxy to the
+	// rate limit quota server. If not set, the connections are plaintext.
+	//
+	// +optional
+	TLS *RateLimitQuotaTLSSettings `json:"tls,omitempty"`
+}
+
+// RateLimitQuotaTLSSettings defines the TLS settings for the connections to the
+// Rate Limit Quota Service. The host of the URL is used as the SNI and is verified
+// against the certificate presented by the server, unless it's an IP address.
+type RateLimitQuotaTLSSettings struct {
+	// CACertificateRef is a reference to a Kubernetes Secret with a CA certificate in a key
+	// named "ca.crt", which is used to verify the certificate presented by the server.
+	// If not specified, the system trust store is used.
+	// The namespace of Envoy Gateway is used if the namespace is not specified.
+	//
+	// +optional
+	CACertificateRef *gwapiv1.SecretObjectReference `json:"caCertificateRef,omitempty"`
+
+	// ClientCertificateRef is a reference to a Kubernetes Secret of type TLS with a client
+	// certificate and key, which is presented to the server for mTLS authentication.
+	// The namespace of Envoy Gateway is used if the namespace is not specified.
+	//
+	// +optional
+	ClientCertificateRef *gwapiv1.SecretObjectReference `json:"clientCertificateRef,omitempty"`
+}
+
 type RateLimitTelemetry struct {
 	// Metrics defines metrics configuration for RateLimit.
 	Metrics *RateLimitMetrics `json:"metrics,omitempty"`
@@ -727,6 +860,8 @@ type RateLimitTracingProvider struct {
 type RateLimitDatabaseBackend struct {
 	// Type is the type of database backend to use. Supported types are:
 	//	* Redis: Connects to a Redis database.
+	//	* Memcached: Connects to a Memcached database.
+	//	* Local: Runs an in-memory database alongside a single rate limit replica.
 	//
 	// +unionDiscriminator
 	Type RateLimitDatabaseBackendType `json:"type"`
@@ -734,18 +869,92 @@ type RateLimitDatabaseBackend struct {
 	//
 	// +optional
 	Redis *RateLimitRedisSettings `json:"redis,omitempty"`
+	// Memcached defines the settings needed to connect to a Memcached database.
+	//
+	// +optional
+	Memcached *RateLimitMemcachedSettings `json:"memcached,omitempty"`
+	// Local defines the settings of the in-memory database.
+	//
+	// +optional
+	Local *RateLimitLocalSettings `json:"local,omitempty"`
 }
 
 // RateLimitDatabaseBackendType specifies the types of database backend
 // to be used by the rate limit service.
-// +kubebuilder:validation:Enum=Redis
+// +kubebuilder:validation:Enum=Redis;Memcached;Local
 type RateLimitDatabaseBackendType string
 
 const (
 	// RedisBackendType uses a redis database for the rate limit service.
 	RedisBackendType RateLimitDatabaseBackendType = "Redis"
+	// MemcachedBackendType uses a memcached database for the rate limit service.
+	MemcachedBackendType RateLimitDatabaseBackendType = "Memcached"
+	// LocalBackendType uses an in-memory database running next to the rate limit service.
+	// The counters are not shared across replicas, so the rate limit service is limited
+	// to a single replica, and the counters are lost when the replica restarts.
+	// With the host infrastructure provider, the redis-server binary found in $PATH is run
+	// next to the rate limit process, listening on 127.0.0.1:6379.
+	LocalBackendType RateLimitDatabaseBackendType = "Local"
 )
 
+// RateLimitMemcachedSettings defines the configuration for connecting to memcached database.
+type RateLimitMemcachedSettings struct {
+	// HostPorts is the list of memcached host:port addresses.
+	// Mutually exclusive with SRV.
+	//
+	// +optional
+	HostPorts []string `json:"hostPorts,omitempty"`
+
+	// SRV is the SRV record used to discover the memcached hosts,
+	// e.g. "_memcache._tcp.memcached.svc.cluster.local".
+	// Mutually exclusive with HostPorts.
+	//
+	// +optional
+	SRV *string `json:"srv,omitempty"`
+
+	// SRVRefresh is the interval at which the SRV record is resolved again.
+	// If unset, the SRV record is only resolved on startup.
+	//
+	// +optional
+	SRVRefresh *gwapiv1.Duration `json:"srvRefresh,omitempty"`
+
+	// MaxIdleConnections is the maximum number of idle connections kept
+	// in the pool per memcached host. Defaults to 2.
+	//
+	// +optional
+	MaxIdleConnections *uint32 `json:"maxIdleConnections,omitempty"`
+
+	// TLS defines TLS configuration for connecting to memcached database.
+	//
+	// +optional
+	TLS *MemcachedTLSSettings `json:"tls,omitempty"`
+}
+
+// MemcachedTLSSettings defines the TLS configuration for connecting to memcached database.
+type MemcachedTLSSettings struct {
+	// CertificateRef defines the client certificate reference for TLS connections.
+	// Currently only a Kubernetes Secret of type TLS is supported.
+	// +optional
+	CertificateRef *gwapiv1.SecretObjectReference `json:"certificateRef,omitempty"`
+}
+
+// RateLimitLocalSettings defines the configuration of the in-memory database
+// running alongside the rate limit service.
+type RateLimitLocalSettings struct {
+	// Image specifies the image of the in-memory database container.
+	// Defaults to DefaultRateLimitLocalBackendImage.
+	// It's ignored by the host infrastructure provider.
+	//
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Resources required by the in-memory database container.
+	// It's ignored by the host infrastructure provider.
+	//
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
 // RedisTLSSettings defines the TLS configuration for connecting to redis database.
 type RedisTLSSettings struct {
 	// CertificateRef defines the client certificate reference for TLS connections.
@@ -755,11 +964,12 @@ type RedisTLSSettings struct {
 }
 
 // RateLimitRedisSettings defines the configuration for connecting to redis database.
-// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.urlRef)",message="exactly one of url or urlRef must be set"
+// +kubebuilder:validation:XValidation:rule="[has(self.url), has(self.urlRef), has(self.sentinel), has(self.cluster)].filter(x, x).size() == 1",message="exactly one of url, urlRef, sentinel or cluster must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.tls) || !has(self.tls.certificateRef) || !has(self.perSecond) || !has(self.perSecond.tls) || !has(self.perSecond.tls.certificateRef) || self.tls.certificateRef == self.perSecond.tls.certificateRef",message="tls.certificateRef and perSecond.tls.certificateRef must reference the same Secret"
 type RateLimitRedisSettings struct {
 	// URL of the Redis Database.
 	// This can reference a single Redis host or a comma delimited list for Sentinel and Cluster deployments of Redis.
-	// Mutually exclusive with URLRef.
+	// Mutually exclusive with URLRef, Sentinel and Cluster.
 	//
 	// +optional
 	URL *string `json:"url,omitempty"`
@@ -776,6 +986,98 @@ type RateLimitRedisSettings struct {
 	//
 	// +optional
 	TLS *RedisTLSSettings `json:"tls,omitempty"`
+
+	RedisConnectionSettings `json:",inline"`
+
+	// PerSecond defines a separate Redis database for the rate limits with a per second unit.
+	// Using a separate database for the per second limits, which are the most frequently
+	// updated, reduces the load on the main database.
+	//
+	// +optional
+	PerSecond *RateLimitRedisPerSecondSettings `json:"perSecond,omitempty"`
+}
+
+// RedisConnectionSettings defines the deployment mode and the client settings of a
+// Redis database used by the rate limit service.
+type RedisConnectionSettings struct {
+	// Sentinel connects to the master of a Redis Sentinel deployment.
+	//
+	// +optional
+	Sentinel *RedisSentinelSettings `json:"sentinel,omitempty"`
+
+	// Cluster connects to a Redis Cluster deployment.
+	//
+	// +optional
+	Cluster *RedisClusterSettings `json:"cluster,omitempty"`
+
+	// PoolSize is the number of connections in the connection pool to each Redis host.
+	// Defaults to 10.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PoolSize *uint32 `json:"poolSize,omitempty"`
+
+	// PipelineWindow enables implicit pipelining, which flushes the commands
+	// that are queued within this window in a single pipeline.
+	// Implicit pipelining is recommended for Redis Cluster.
+	//
+	// +optional
+	PipelineWindow *gwapiv1.Duration `json:"pipelineWindow,omitempty"`
+
+	// PipelineLimit enables implicit pipelining, which flushes the queued commands
+	// in a single pipeline once this number of commands is reached.
+	// When PipelineWindow is also set, the pipeline is flushed by whichever comes first.
+	//
+	// +optional
+	PipelineLimit *uint32 `json:"pipelineLimit,omitempty"`
+}
+
+// RedisSentinelSettings defines the configuration for connecting to a Redis Sentinel deployment.
+type RedisSentinelSettings struct {
+	// MasterName is the name of the master monitored by the sentinels.
+	//
+	// +kubebuilder:validation:MinLength=1
+	MasterName string `json:"masterName"`
+
+	// Addresses is the list of host:port addresses of the sentinels.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Addresses []string `json:"addresses"`
+
+	// AuthRef references the Secret key that holds the password of the sentinels.
+	// The Secret must be in the same namespace as the Envoy Gateway rate limit deployment.
+	//
+	// +optional
+	AuthRef *corev1.SecretKeySelector `json:"authRef,omitempty"`
+}
+
+// RedisClusterSettings defines the configuration for connecting to a Redis Cluster deployment.
+type RedisClusterSettings struct {
+	// Addresses is the list of host:port addresses of the cluster nodes used to
+	// discover the cluster topology.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Addresses []string `json:"addresses"`
+}
+
+// RateLimitRedisPerSecondSettings defines the configuration for connecting to the redis
+// database used for the rate limits with a per second unit.
+// +kubebuilder:validation:XValidation:rule="[has(self.url), has(self.sentinel), has(self.cluster)].filter(x, x).size() == 1",message="exactly one of url, sentinel or cluster must be set"
+type RateLimitRedisPerSecondSettings struct {
+	// URL of the Redis Database.
+	// Mutually exclusive with Sentinel and Cluster.
+	//
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// TLS defines TLS configuration for connecting to the per second redis database.
+	// The rate limit service uses one client certificate for both databases, so if the
+	// certificateRef of the main database is also set, they must reference the same Secret.
+	//
+	// +optional
+	TLS *RedisTLSSettings `json:"tls,omitempty"`
+
+	RedisConnectionSettings `json:",inline"`
 }
 
 // RedisURLSource specifies where to source the Redis URL from.
@@ -1027,6 +1329,161 @@ type EnvoyGatewayAdmin struct {
 	//
 	// +optional
 	EnablePprof bool `json:"enablePprof,omitempty"`
+	// TLS defines the TLS configuration of the Envoy Gateway Admin Server.
+	// If unspecified, the admin server is served over plain HTTP.
+	// Only supported with the Kubernetes provider.
+	//
+	// +optional
+	TLS *EnvoyGatewayAdminTLS `json:"tls,omitempty"`
+	// Auth defines the authentication and authorization of the requests to the
+	// Envoy Gateway Admin Server.
+	// If unspecified, all the requests are allowed.
+	// Only supported with the Kubernetes provider.
+	//
+	// +optional
+	Auth *EnvoyGatewayAdminAuth `json:"auth,omitempty"`
+}
+
+// EnvoyGatewayAdminTLS defines the TLS configuration of the Envoy Gateway Admin Server.
+type EnvoyGatewayAdminTLS struct {
+	// CertificateRef is a reference to a Kubernetes Secret with the server certificate
+	// and private key in the keys named "tls.crt" and "tls.key".
+	// The Secret must be in the namespace of Envoy Gateway if the namespace is unspecified.
+	// The Secret is read again every minute, so a rotated certificate is served
+	// without restarting Envoy Gateway.
+	//
+	// +kubebuilder:validation:Required
+	CertificateRef gwapiv1.SecretObjectReference `json:"certificateRef"`
+
+	// ClientValidation defines the validation of the client certificates.
+	// If unspecified, the client certificates are not requested.
+	//
+	// +optional
+	ClientValidation *EnvoyGatewayAdminClientValidation `json:"clientValidation,omitempty"`
+}
+
+// EnvoyGatewayAdminClientValidation defines the validation of the client certificates
+// of the Envoy Gateway Admin Server.
+type EnvoyGatewayAdminClientValidation struct {
+	// CACertificateRef is a reference to a Kubernetes Secret with the CA certificates
+	// in a key named "ca.crt", used to verify the client certificates.
+	// The Secret must be in the namespace of Envoy Gateway if the namespace is unspecified.
+	// It is reloaded like the server certificate.
+	//
+	// +kubebuilder:validation:Required
+	CACertificateRef gwapiv1.SecretObjectReference `json:"caCertificateRef"`
+
+	// Optional set to true accepts the requests without a client certificate.
+	// The client certificate is still verified if it's presented.
+	//
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// EnvoyGatewayAdminAuthType defines the type of authentication of the Envoy Gateway Admin Server.
+//
+// +kubebuilder:validation:Enum=TokenReview;Htpasswd
+type EnvoyGatewayAdminAuthType string
+
+const (
+	// EnvoyGatewayAdminAuthTypeTokenReview authenticates the bearer tokens with the
+	// Kubernetes TokenReview API.
+	EnvoyGatewayAdminAuthTypeTokenReview EnvoyGatewayAdminAuthType = "TokenReview"
+	// EnvoyGatewayAdminAuthTypeHtpasswd authenticates the users with HTTP basic
+	// authentication and a static htpasswd file.
+	EnvoyGatewayAdminAuthTypeHtpasswd EnvoyGatewayAdminAuthType = "Htpasswd"
+)
+
+// EnvoyGatewayAdminRole defines a role of the users of the Envoy Gateway Admin Server.
+//
+// +kubebuilder:validation:Enum=ReadOnly;Debug
+type EnvoyGatewayAdminRole string
+
+const (
+	// EnvoyGatewayAdminRoleReadOnly allows the console and the read-only APIs,
+	// such as the config dump and the metrics.
+	EnvoyGatewayAdminRoleReadOnly EnvoyGatewayAdminRole = "ReadOnly"
+	// EnvoyGatewayAdminRoleDebug allows everything in the ReadOnly role, and
+	// the pprof endpoints if they are enabled.
+	EnvoyGatewayAdminRoleDebug EnvoyGatewayAdminRole = "Debug"
+)
+
+// EnvoyGatewayAdminAuth defines the authentication and authorization of the requests
+// to the Envoy Gateway Admin Server.
+//
+// +union
+//
+// +kubebuilder:validation:XValidation:rule="self.type == 'TokenReview' ? !has(self.htpasswd) : true",message="htpasswd must not be set if type is TokenReview."
+// +kubebuilder:validation:XValidation:rule="self.type == 'Htpasswd' ? has(self.htpasswd) : !has(self.htpasswd)",message="If type is Htpasswd, htpasswd field needs to be set."
+type EnvoyGatewayAdminAuth struct {
+	// Type is the type of authentication.
+	//
+	// +unionDiscriminator
+	// +kubebuilder:validation:Required
+	Type EnvoyGatewayAdminAuthType `json:"type"`
+
+	// TokenReview defines the settings of the authentication with the Kubernetes
+	// TokenReview API. The token is read from the "Authorization: Bearer" header.
+	// The results of the reviews are cached, and the calls to the TokenReview API
+	// are rate limited, the requests over the limit are rejected with a 429 status.
+	//
+	// +optional
+	TokenReview *EnvoyGatewayAdminTokenReview `json:"tokenReview,omitempty"`
+
+	// Htpasswd defines the settings of the HTTP basic authentication.
+	//
+	// +optional
+	Htpasswd *EnvoyGatewayAdminHtpasswd `json:"htpasswd,omitempty"`
+
+	// RoleBindings grant roles to the authenticated users. The requests of the
+	// users without any role are denied. If a user is granted multiple roles,
+	// the most privileged one applies.
+	//
+	// +kubebuilder:validation:MinItems=1
+	RoleBindings []EnvoyGatewayAdminRoleBinding `json:"roleBindings"`
+}
+
+// EnvoyGatewayAdminTokenReview defines the settings of the authentication with the
+// Kubernetes TokenReview API.
+type EnvoyGatewayAdminTokenReview struct {
+	// Audiences is the list of the audiences the token must be issued for.
+	// If unspecified, the audiences of the Kubernetes API server are used.
+	//
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+}
+
+// EnvoyGatewayAdminHtpasswd defines the settings of the HTTP basic authentication.
+type EnvoyGatewayAdminHtpasswd struct {
+	// UsersRef is a reference to a Kubernetes Secret with the users in the htpasswd
+	// format in a key named ".htpasswd". The passwords must be hashed with bcrypt,
+	// for example with `htpasswd -B`.
+	// The Secret must be in the namespace of Envoy Gateway if the namespace is unspecified.
+	// The Secret is read when Envoy Gateway starts.
+	//
+	// +kubebuilder:validation:Required
+	UsersRef gwapiv1.SecretObjectReference `json:"usersRef"`
+}
+
+// EnvoyGatewayAdminRoleBinding grants a role to a set of users.
+type EnvoyGatewayAdminRoleBinding struct {
+	// Role is the role granted to the users.
+	//
+	// +kubebuilder:validation:Required
+	Role EnvoyGatewayAdminRole `json:"role"`
+
+	// Users is the list of the names of the users, for example, a user of the
+	// htpasswd file, or "system:serviceaccount:<namespace>:<name>" for a
+	// Kubernetes service account.
+	//
+	// +optional
+	Users []string `json:"users,omitempty"`
+
+	// Groups is the list of the Kubernetes groups of the users, for example,
+	// "system:serviceaccounts:<namespace>". Only used with the TokenReview type.
+	//
+	// +optional
+	Groups []string `json:"groups,omitempty"`
 }
 
 // EnvoyGatewayAdminAddress defines the Envoy Gateway Admin Address configuration.
@@ -13,6 +13,7 @@ const (
 	MetricSinkTypeOpenTelemetry MetricSinkType = "OpenTelemetry"
 )
 
+// +kubebuilder:validation:XValidation:message="grpcStats can't be set when enableGRPCStats is false",rule="!has(self.grpcStats) || !has(self.enableGRPCStats) || self.enableGRPCStats"
 type ProxyMetrics struct {
 	// Prometheus defines the configuration for Admin endpoint `/stats/prometheus`.
 	Prometheus *ProxyPrometheusProvider `json:"prometheus,omitempty"`
@@ -53,6 +54,19 @@ type ProxyMetrics struct {
 	// +optional
 	EnableGRPCStats *bool `json:"enableGRPCStats,omitempty"`
 
+	// GRPCStats configures the gRPC stats filter, which emits statistics for each gRPC method,
+	// such as the number of successful and failed calls, and the number of request and response
+	// messages of the streaming calls.
+	// Setting it enables the gRPC stats filter on all the HTTP listeners, including the ones
+	// with only HTTPRoutes attached.
+	//
+	// The dots in the gRPC service names are replaced by underscores in the stat names, so that
+	// the service and the method are extracted as the `envoy_grpc_bridge_service` and
+	// `envoy_grpc_bridge_method` tags by the Prometheus endpoint and the metric sinks.
+	//
+	// +optional
+	GRPCStats *ProxyGRPCStats `json:"grpcStats,omitempty"`
+
 	// ClusterStatName defines the value of cluster alt_stat_name, determining how cluster stats are named.
 	// For more details, see envoy docs: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/cluster.proto.html
 	// The supported operators for this pattern are:
@@ -134,6 +148,43 @@ type ProxyOpenTelemetrySink struct {
 	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
 }
 
+// ProxyGRPCStats defines the settings of the gRPC stats filter.
+type ProxyGRPCStats struct {
+	// Services is the allowlist of the gRPC services and methods for which the per-method
+	// statistics are emitted. The calls to the other methods are counted in the statistics
+	// of an unknown method.
+	// If unspecified, the per-method statistics are emitted for all the methods. As the
+	// method names come from the requests, this may lead to a high number of statistics
+	// when the proxy is exposed to untrusted clients.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=name
+	Services []GRPCStatsService `json:"services,omitempty"`
+
+	// EnableUpstreamStats enables the per-method histograms of the time spent waiting for
+	// the upstream response, which are useful to track the latency SLOs of each gRPC method.
+	//
+	// +optional
+	EnableUpstreamStats *bool `json:"enableUpstreamStats,omitempty"`
+}
+
+// GRPCStatsService defines the methods of a gRPC service for which statistics are emitted.
+type GRPCStatsService struct {
+	// Name is the fully qualified name of the gRPC service, e.g. `helloworld.Greeter`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Methods are the names of the methods of the service, e.g. `SayHello`.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Methods []string `json:"methods"`
+}
+
 type ProxyPrometheusProvider struct {
 	// Disable the Prometheus endpoint.
 	Disable bool `json:"disable,omitempty"`
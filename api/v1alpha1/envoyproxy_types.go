@@ -125,6 +125,8 @@ type EnvoyProxySpec struct {
 	//
 	// - envoy.filters.http.cors
 	//
+	// - envoy.filters.http.ip_tagging
+	//
 	// - envoy.filters.http.header_mutation
 	//
 	// - envoy.filters.http.ext_authz
@@ -234,6 +236,20 @@ type EnvoyProxySpec struct {
 	// +optional
 	GeoIP *EnvoyProxyGeoIP `json:"geoIP,omitempty"`
 
+	// IPTagging defines the named IP tag sets that the client IPs are tagged with, for the
+	// authorization rules and the rate limit client selectors to match on.
+	//
+	// +optional
+	IPTagging *EnvoyProxyIPTagging `json:"ipTagging,omitempty"`
+
+	// DNSResolver defines the default DNS resolver used to resolve the hostnames of the
+	// FQDN and DynamicResolver backends.
+	// It can be overridden per Backend and by the DNS settings of a BackendTrafficPolicy.
+	// It isn't applied to the OpenTelemetry metrics sinks, which use the default DNS resolver of Envoy.
+	//
+	// +optional
+	DNSResolver *DNSResolver `json:"dnsResolver,omitempty"`
+
 	// MergeType controls how this EnvoyProxy merges with less specific configurations
 	// in the hierarchy (EnvoyGateway defaults < GatewayClass < Gateway).
 	// If unset, this EnvoyProxy completely replaces less specific settings.
@@ -388,7 +404,7 @@ type FilterPosition struct {
 }
 
 // EnvoyFilter defines the type of Envoy HTTP filter.
-// +kubebuilder:validation:Enum=envoy.filters.http.custom_response;envoy.filters.http.health_check;envoy.filters.http.fault;envoy.filters.http.cors;envoy.filters.http.csrf;envoy.filters.http.header_mutation;envoy.filters.http.ext_authz;envoy.filters.http.api_key_auth;envoy.filters.http.basic_auth;envoy.filters.http.oauth2;envoy.filters.http.jwt_authn;envoy.filters.http.stateful_session;envoy.filters.http.buffer;envoy.filters.http.lua;envoy.filters.http.ext_proc;envoy.filters.http.wasm;envoy.filters.http.dynamic_modules;envoy.filters.http.geoip;envoy.filters.http.rbac;envoy.filters.http.local_ratelimit;envoy.filters.http.ratelimit;envoy.filters.http.bandwidth_limit;envoy.filters.http.grpc_web;envoy.filters.http.grpc_stats;envoy.filters.http.credential_injector;envoy.filters.http.compressor;envoy.filters.http.dynamic_forward_proxy
+// +kubebuilder:validation:Enum=envoy.filters.http.custom_response;envoy.filters.http.health_check;envoy.filters.http.fault;envoy.filters.http.cors;envoy.filters.http.csrf;envoy.filters.http.ip_tagging;envoy.filters.http.decompressor;envoy.filters.http.waf;envoy.filters.http.header_mutation;envoy.filters.http.ext_authz;envoy.filters.http.api_key_auth;envoy.filters.http.basic_auth;envoy.filters.http.oauth2;envoy.filters.http.jwt_authn;envoy.filters.http.stateful_session;envoy.filters.http.buffer;envoy.filters.http.lua;envoy.filters.http.ext_proc;envoy.filters.http.wasm;envoy.filters.http.dynamic_modules;envoy.filters.http.geoip;envoy.filters.http.rbac;envoy.filters.http.local_ratelimit;envoy.filters.http.ratelimit;envoy.filters.http.rate_limit_quota;envoy.filters.http.bandwidth_limit;envoy.filters.http.grpc_json_transcoder;envoy.filters.http.grpc_web;envoy.filters.http.grpc_stats;envoy.filters.http.credential_injector;envoy.filters.http.cache;envoy.filters.http.adaptive_concurrency;envoy.filters.http.compressor;envoy.filters.http.dynamic_forward_proxy
 type EnvoyFilter string
 
 const (
@@ -407,6 +423,13 @@ const (
 	// EnvoyFilterCSRF defines the Envoy HTTP CSRF filter.
 	EnvoyFilterCSRF EnvoyFilter = "envoy.filters.http.csrf"
 
+	// EnvoyFilterDecompressor defines the Envoy HTTP decompressor filter.
+	EnvoyFilterDecompressor EnvoyFilter = "envoy.filters.http.decompressor"
+
+	// EnvoyFilterWAF defines the Envoy Gateway WAF filter, which runs the WAF
+	// rule engine as a Wasm or dynamic module HTTP filter.
+	EnvoyFilterWAF EnvoyFilter = "envoy.filters.http.waf"
+
 	// EnvoyFilterHeaderMutation defines the Envoy HTTP header mutation filter
 	EnvoyFilterHeaderMutation EnvoyFilter = "envoy.filters.http.header_mutation"
 
@@ -447,6 +470,9 @@ const (
 	// EnvoyFilterGeoIP defines the Envoy HTTP GeoIP filter.
 	EnvoyFilterGeoIP EnvoyFilter = "envoy.filters.http.geoip"
 
+	// EnvoyFilterIPTagging defines the Envoy HTTP IP tagging filter.
+	EnvoyFilterIPTagging EnvoyFilter = "envoy.filters.http.ip_tagging"
+
 	// EnvoyFilterRBAC defines the Envoy RBAC filter.
 	EnvoyFilterRBAC EnvoyFilter = "envoy.filters.http.rbac"
 
@@ -456,9 +482,15 @@ const (
 	// EnvoyFilterRateLimit defines the Envoy HTTP rate limit filter.
 	EnvoyFilterRateLimit EnvoyFilter = "envoy.filters.http.ratelimit"
 
+	// EnvoyFilterRateLimitQuota defines the Envoy HTTP rate limit quota filter.
+	EnvoyFilterRateLimitQuota EnvoyFilter = "envoy.filters.http.rate_limit_quota"
+
 	// EnvoyFilterBandwidthLimit defines the Envoy HTTP bandwidth limit filter.
 	EnvoyFilterBandwidthLimit EnvoyFilter = "envoy.filters.http.bandwidth_limit"
 
+	// EnvoyFilterGRPCJSONTranscoder defines the Envoy HTTP gRPC-JSON transcoder filter.
+	EnvoyFilterGRPCJSONTranscoder EnvoyFilter = "envoy.filters.http.grpc_json_transcoder"
+
 	// EnvoyFilterGRPCWeb defines the Envoy HTTP gRPC-web filter.
 	EnvoyFilterGRPCWeb EnvoyFilter = "envoy.filters.http.grpc_web"
 
@@ -468,6 +500,12 @@ const (
 	// EnvoyFilterCredentialInjector defines the Envoy HTTP credential injector filter.
 	EnvoyFilterCredentialInjector EnvoyFilter = "envoy.filters.http.credential_injector"
 
+	// EnvoyFilterCache defines the Envoy HTTP cache filter.
+	EnvoyFilterCache EnvoyFilter = "envoy.filters.http.cache"
+
+	// EnvoyFilterAdaptiveConcurrency defines the Envoy HTTP adaptive concurrency filter.
+	EnvoyFilterAdaptiveConcurrency EnvoyFilter = "envoy.filters.http.adaptive_concurrency"
+
 	// EnvoyFilterCompressor defines the Envoy HTTP compressor filter.
 	EnvoyFilterCompressor EnvoyFilter = "envoy.filters.http.compressor"
 
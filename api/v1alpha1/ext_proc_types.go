@@ -124,6 +124,13 @@ type ExtProc struct {
 	// +optional
 	// +kubebuilder:validation:Enum=400;401;402;403;404;405;406;407;408;409;410;411;412;413;414;415;416;417;421;422;423;424;426;428;429;431;500;501;502;503;504;505;506;507;508;510;511
 	StatusOnError *int32 `json:"statusOnError,omitempty"`
+
+	// Match defines the conditions of a request for the extension to be executed.
+	// The extension is skipped for the requests that don't match.
+	// If unset, the extension is executed for all the requests.
+	//
+	// +optional
+	Match *ExtensionMatch `json:"match,omitempty"`
 }
 
 // ExtProcMetadata defines options related to the sending and receiving of dynamic metadata to and from the
@@ -0,0 +1,95 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// GRPCJSONTranscoder defines the configuration for transcoding RESTful JSON
+// requests into gRPC requests, and the gRPC responses back into JSON.
+// The HTTP/JSON to gRPC mapping is defined by the `google.api.http` annotations
+// of the referenced proto descriptor set.
+type GRPCJSONTranscoder struct {
+	// ProtoDescriptorRef references a ConfigMap or a Secret that contains the
+	// binary FileDescriptorSet of the gRPC services, generated by `protoc` with
+	// the `--include_imports` and `--descriptor_set_out` flags.
+	// The value of key `descriptor.pb` will be used. If the key is not found, the
+	// ConfigMap or Secret must contain a single key, whose value will be used.
+	// For ConfigMaps, `binaryData` takes precedence over `data`.
+	//
+	// +kubebuilder:validation:XValidation:rule="(self.kind == 'ConfigMap' || self.kind == 'Secret') && (self.group == 'v1' || self.group == '')",message="Only a reference to an object of kind ConfigMap or Secret belonging to default v1 API group is supported."
+	// +required
+	ProtoDescriptorRef gwapiv1.LocalObjectReference `json:"protoDescriptorRef"`
+
+	// Services is a list of fully qualified gRPC service names, for example
+	// `helloworld.Greeter`, that will be transcoded.
+	// Every service must be defined in the referenced proto descriptor set.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +required
+	Services []string `json:"services"`
+
+	// PrintOptions control how the gRPC responses are printed as JSON.
+	//
+	// +optional
+	PrintOptions *GRPCJSONTranscoderPrintOptions `json:"printOptions,omitempty"`
+
+	// UnknownQueryParameters defines how query parameters that cannot be mapped
+	// to a field of the gRPC request message are handled.
+	// If unset, requests with unknown query parameters are passed to the
+	// upstream without being transcoded.
+	//
+	// +optional
+	UnknownQueryParameters *GRPCJSONTranscoderUnknownQueryParametersAction `json:"unknownQueryParameters,omitempty"`
+}
+
+// GRPCJSONTranscoderPrintOptions defines how the gRPC responses are printed as JSON.
+type GRPCJSONTranscoderPrintOptions struct {
+	// AddWhitespace adds spaces, line breaks and indentation to make the JSON
+	// output easy to read.
+	// Defaults to false.
+	//
+	// +optional
+	AddWhitespace *bool `json:"addWhitespace,omitempty"`
+
+	// AlwaysPrintPrimitiveFields prints primitive fields even if they have
+	// their default values. By default, such fields are omitted.
+	// Defaults to false.
+	//
+	// +optional
+	AlwaysPrintPrimitiveFields *bool `json:"alwaysPrintPrimitiveFields,omitempty"`
+
+	// AlwaysPrintEnumsAsInts prints enums as integers instead of strings.
+	// Defaults to false.
+	//
+	// +optional
+	AlwaysPrintEnumsAsInts *bool `json:"alwaysPrintEnumsAsInts,omitempty"`
+
+	// PreserveProtoFieldNames uses the original proto field names as defined
+	// in the .proto file instead of the lowerCamelCase JSON names.
+	// Defaults to false.
+	//
+	// +optional
+	PreserveProtoFieldNames *bool `json:"preserveProtoFieldNames,omitempty"`
+}
+
+// GRPCJSONTranscoderUnknownQueryParametersAction defines how query parameters
+// that cannot be mapped to a field of the gRPC request message are handled.
+//
+// +kubebuilder:validation:Enum=Ignore;Reject
+type GRPCJSONTranscoderUnknownQueryParametersAction string
+
+const (
+	// GRPCJSONTranscoderUnknownQueryParametersIgnore ignores the unknown query
+	// parameters and transcodes the request.
+	GRPCJSONTranscoderUnknownQueryParametersIgnore GRPCJSONTranscoderUnknownQueryParametersAction = "Ignore"
+
+	// GRPCJSONTranscoderUnknownQueryParametersReject rejects the request with
+	// a 400 Bad Request response.
+	GRPCJSONTranscoderUnknownQueryParametersReject GRPCJSONTranscoderUnknownQueryParametersAction = "Reject"
+)
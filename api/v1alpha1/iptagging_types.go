@@ -0,0 +1,85 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// EnvoyProxyIPTagging defines the named IP tag sets that the client IPs are tagged with.
+// The tags of a client IP can be referenced by the `clientIPTags` of the SecurityPolicy
+// authorization rules and the BackendTrafficPolicy rate limit client selectors.
+//
+// The matching tags are written to the `x-envoy-ip-tags` request header, which is forwarded
+// to the backends. Any value of this header sent by the client is removed.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.tags) || has(self.file)",message="at least one of tags or file must be specified"
+type EnvoyProxyIPTagging struct {
+	// Tags defines the IP tag sets.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=name
+	Tags []IPTag `json:"tags,omitempty"`
+
+	// File defines additional IP tag sets loaded from a file on the Envoy proxy filesystem.
+	// It's suited to large lists that change often, as the file is reloaded by Envoy when it's
+	// replaced, without a configuration update.
+	//
+	// The tags defined in the file aren't known to Envoy Gateway, so the references to them
+	// can't be validated.
+	//
+	// +optional
+	File *IPTagsFile `json:"file,omitempty"`
+}
+
+// IPTag defines a named set of client IP address ranges.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.cidrs) || has(self.valueRef)",message="at least one of cidrs or valueRef must be specified"
+type IPTag struct {
+	// Name is the name of the tag, for example "office" or "known-bad-bots".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9_.-]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// CIDRs are the IP address ranges of the tag.
+	// Valid examples are "192.168.1.0/24" or "2001:db8::/64".
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	CIDRs []CIDR `json:"cidrs,omitempty"`
+
+	// ValueRef is a reference to a ConfigMap, in the namespace of the EnvoyProxy, with additional
+	// IP address ranges of the tag.
+	// The ranges are read from the `cidrs` key of the ConfigMap, one per line. Empty lines and
+	// lines starting with `#` are ignored.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'ConfigMap' && (self.group == 'v1' || self.group == '')",message="Only a reference to an object of kind ConfigMap belonging to default v1 API group is supported."
+	ValueRef *gwapiv1.LocalObjectReference `json:"valueRef,omitempty"`
+}
+
+// IPTagsFile defines a file with IP tag sets.
+type IPTagsFile struct {
+	// Path is the path to the file, in the YAML or JSON format of the Envoy IP tagging filter
+	// `ip_tags` field. For example:
+	//
+	//   ip_tags:
+	//   - ip_tag_name: known-bad-bots
+	//     ip_list:
+	//     - address_prefix: 192.0.2.0
+	//       prefix_len: 24
+	//
+	// The file is reloaded when it's moved to this path, for example when the symbolic link of
+	// a mounted ConfigMap or Secret is updated.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
@@ -55,4 +55,12 @@ type Lua struct {
 	//
 	// +optional
 	FilterContext *apiextensionsv1.JSON `json:"filterContext,omitempty"`
+
+	// Match defines the conditions of a request for the extension to be executed.
+	// The extension is skipped for the requests that don't match.
+	// If unset, the extension is executed for all the requests.
+	// Match can't be set together with FilterContext, the policy is rejected otherwise.
+	//
+	// +optional
+	Match *ExtensionMatch `json:"match,omitempty"`
 }
@@ -12,7 +12,7 @@ import (
 // RateLimitSpec defines the desired state of RateLimitSpec.
 type RateLimitSpec struct {
 	// Type decides the scope for the RateLimits.
-	// Valid RateLimitType values are "Global" or "Local".
+	// Valid RateLimitType values are "Global", "Local" or "Quota".
 	//
 	// Deprecated: Use Global and/or Local fields directly instead. Both can be specified simultaneously for combined rate limiting.
 	//
@@ -27,10 +27,16 @@ type RateLimitSpec struct {
 	//
 	// +optional
 	Local *LocalRateLimit `json:"local,omitempty"`
+
+	// Quota defines rate limit quota configuration, where the quota of each bucket
+	// is dynamically assigned by an external Rate Limit Quota Service (RLQS).
+	//
+	// +optional
+	Quota *QuotaRateLimit `json:"quota,omitempty"`
 }
 
 // RateLimitType specifies the types of RateLimiting.
-// +kubebuilder:validation:Enum=Global;Local
+// +kubebuilder:validation:Enum=Global;Local;Quota
 type RateLimitType string
 
 const (
@@ -41,6 +47,10 @@ const (
 	// LocalRateLimitType allows the rate limits to be applied on a per Envoy
 	// proxy instance basis.
 	LocalRateLimitType RateLimitType = "Local"
+
+	// QuotaRateLimitType allows the rate limits to be assigned to the Envoy proxy
+	// instances by an external Rate Limit Quota Service.
+	QuotaRateLimitType RateLimitType = "Quota"
 )
 
 // GlobalRateLimit defines global rate limit configuration.
@@ -72,6 +82,87 @@ type LocalRateLimit struct {
 	Rules []RateLimitRule `json:"rules"`
 }
 
+// QuotaRateLimit defines rate limit quota configuration.
+//
+// Envoy groups the requests into buckets using the rules, and periodically reports
+// the usage of each bucket to the Rate Limit Quota Service configured in the
+// EnvoyGateway configuration. The service responds with a quota assignment
+// for each bucket, which is then enforced locally by each Envoy proxy instance.
+//
+// +kubebuilder:validation:XValidation:rule="self.rules.filter(r, !has(r.clientSelectors) || size(r.clientSelectors) == 0).size() <= 1",message="at most one rule without clientSelectors can be specified"
+type QuotaRateLimit struct {
+	// Domain is the application domain of the buckets, which the Rate Limit Quota
+	// Service uses to avoid bucket collisions between different applications.
+	// If not set, the domain is "<policy-namespace>/<policy-name>".
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Domain *string `json:"domain,omitempty"`
+
+	// Rules are a list of bucket selectors. The requests are assigned to the
+	// bucket of the first matching rule, in the order of the list.
+	// A rule without client selectors matches the requests not matching any other rule.
+	// The requests not matching any rule are not rate limited.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Rules []QuotaRateLimitRule `json:"rules"`
+
+	// ReportingInterval is the interval at which the usage of the buckets is reported
+	// to the Rate Limit Quota Service.
+	// If not set, the usage is reported every 10s.
+	//
+	// +optional
+	ReportingInterval *gwapiv1.Duration `json:"reportingInterval,omitempty"`
+
+	// FallbackAction defines how the requests are handled when the Rate Limit Quota
+	// Service has not assigned a quota to their bucket yet, for example because the
+	// service is unreachable, or when the assignment has expired.
+	// If not set, the requests are allowed.
+	//
+	// +optional
+	FallbackAction *QuotaFallbackAction `json:"fallbackAction,omitempty"`
+}
+
+// QuotaRateLimitRule defines the semantics for matching attributes
+// from the incoming requests, and assigning them to a quota bucket.
+type QuotaRateLimitRule struct {
+	// ClientSelectors holds the list of select conditions to select
+	// specific clients using attributes from the traffic flow.
+	// All individual select conditions must hold True for the request
+	// to be assigned to the bucket of this rule.
+	//
+	// Distinct header, query parameter and source CIDR selectors assign
+	// each unique value to its own bucket.
+	//
+	// If the policy targets a Gateway, the rule applies to each Route of the Gateway.
+	// Please note that each Route has its own buckets unless Shared is set to true.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	ClientSelectors []RateLimitSelectCondition `json:"clientSelectors,omitempty"`
+
+	// Shared determines whether the buckets of this rule are shared across all the policy targets (xRoutes).
+	// Default: false.
+	//
+	// +optional
+	Shared *bool `json:"shared,omitempty"`
+}
+
+// QuotaFallbackAction defines the action applied to the requests of a bucket without a quota assignment.
+//
+// +kubebuilder:validation:Enum=AllowAll;DenyAll
+type QuotaFallbackAction string
+
+const (
+	// QuotaFallbackActionAllowAll allows all the requests of the bucket.
+	QuotaFallbackActionAllowAll QuotaFallbackAction = "AllowAll"
+
+	// QuotaFallbackActionDenyAll denies all the requests of the bucket with a 429 status code.
+	QuotaFallbackActionDenyAll QuotaFallbackAction = "DenyAll"
+)
+
 // XRateLimitHeadersOption controls whether X-RateLimit response headers are sent for a rate limit rule.
 // Valid values are "Off" and "DraftVersion03".
 // This allows per-rule override of the global X-RateLimit header setting in ClientTrafficPolicy.
@@ -223,7 +314,7 @@ type RateLimitCostMetadata struct {
 // All the individual conditions must hold True for the overall condition to hold True.
 // And, at least one of headers or methods or path or sourceCIDR or queryParams condition must be specified.
 //
-// +kubebuilder:validation:XValidation:rule="has(self.headers) || has(self.methods) || has(self.path) || has(self.sourceCIDR) || has(self.queryParams)",message="at least one of headers, methods, path, sourceCIDR or queryParams must be specified"
+// +kubebuilder:validation:XValidation:rule="has(self.headers) || has(self.methods) || has(self.path) || has(self.sourceCIDR) || has(self.queryParams) || has(self.clientIPTags)",message="at least one of headers, methods, path, sourceCIDR, queryParams or clientIPTags must be specified"
 type RateLimitSelectCondition struct {
 	// Headers is a list of request headers to match. Multiple header values are ANDed together,
 	// meaning, a request MUST match all the specified headers.
@@ -255,6 +346,14 @@ type RateLimitSelectCondition struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems=16
 	QueryParams []QueryParamMatch `json:"queryParams,omitempty"`
+
+	// ClientIPTags is a list of IP tags to match on, defined in the `ipTagging` field of the
+	// EnvoyProxy. Multiple tags are ORed together, meaning, a request matches if the client IP
+	// has any one of the specified tags.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ClientIPTags []string `json:"clientIPTags,omitempty"`
 }
 
 // QueryParamMatch defines the match attributes within the query parameters of the request.
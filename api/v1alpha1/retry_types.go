@@ -10,6 +10,8 @@ import (
 )
 
 // Retry defines the retry strategy to be applied.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.hedge) || (has(self.perRetry) && has(self.perRetry.timeout))",message="perRetry.timeout must be set when hedge is set"
 type Retry struct {
 	// NumRetries is the number of retries to be attempted. Defaults to 2.
 	//
@@ -35,6 +37,61 @@ type Retry struct {
 	//
 	// +optional
 	PerRetry *PerRetryPolicy `json:"perRetry,omitempty"`
+
+	// Hedge enables request hedging. When the per-retry timeout of an attempt elapses,
+	// a new attempt is sent in parallel without canceling the outstanding one, and the
+	// first response received is used.
+	// The per-retry timeout must be set to use request hedging.
+	//
+	// Note: hedging is only supported in BackendTrafficPolicy, for the retries of the routes.
+	// It's rejected in the backend settings of the other resources.
+	//
+	// +optional
+	Hedge *HedgePolicy `json:"hedge,omitempty"`
+
+	// HostSelection defines how the upstream hosts are selected for the retry attempts.
+	//
+	// Note: host selection is only supported in BackendTrafficPolicy, for the retries of the routes.
+	// It's rejected in the backend settings of the other resources.
+	//
+	// +optional
+	HostSelection *RetryHostSelection `json:"hostSelection,omitempty"`
+}
+
+// HedgePolicy defines the request hedging policy.
+type HedgePolicy struct {
+	// Methods are the HTTP methods of the requests that are hedged and retried.
+	// As a hedged request may be processed more than once by the upstream, only the
+	// idempotent methods are supported.
+	//
+	// Note: the requests with other methods, such as POST and PATCH, are neither hedged
+	// nor retried, whatever the other retry settings.
+	//
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=6
+	// +kubebuilder:validation:XValidation:rule="self.all(m, m in ['GET', 'HEAD', 'OPTIONS', 'TRACE', 'PUT', 'DELETE'])",message="only the idempotent methods GET, HEAD, OPTIONS, TRACE, PUT and DELETE can be hedged"
+	Methods []gwapiv1.HTTPMethod `json:"methods"`
+}
+
+// RetryHostSelection defines how the upstream hosts are selected for the retry attempts.
+//
+// Avoiding the hosts in the same zone as the previous attempts isn't supported, and the
+// priority of the retry attempts is only configured with numAttemptsPerPriority.
+type RetryHostSelection struct {
+	// AvoidPreviousHosts rejects the hosts that were already attempted for the request
+	// when selecting the host of a retry attempt. Defaults to true.
+	//
+	// +optional
+	AvoidPreviousHosts *bool `json:"avoidPreviousHosts,omitempty"`
+
+	// MaxAttempts is the maximum number of times a host is selected for a retry attempt
+	// before the last selected host is used, even if it was rejected. Defaults to 5.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
 }
 
 type RetryOn struct {
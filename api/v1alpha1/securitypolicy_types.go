@@ -107,6 +107,12 @@ type SecurityPolicySpec struct {
 	//
 	// +optional
 	Authorization *Authorization `json:"authorization,omitempty"`
+
+	// WAF defines the configuration of the Web Application Firewall.
+	// WAF is not applicable to TCPRoute targets.
+	//
+	// +optional
+	WAF *WAF `json:"waf,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
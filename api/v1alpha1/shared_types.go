@@ -35,6 +35,9 @@ const (
 	DefaultShutdownManagerImage = "docker.io/envoyproxy/gateway-dev:latest"
 	// DefaultRateLimitImage is the default image used by ratelimit.
 	DefaultRateLimitImage = "docker.io/envoyproxy/ratelimit:master"
+	// DefaultRateLimitLocalBackendImage is the default image used by the in-memory
+	// database of the Local ratelimit backend.
+	DefaultRateLimitLocalBackendImage = "docker.io/library/redis:7.4-alpine"
 	// HTTPProtocol is the common-used http protocol.
 	HTTPProtocol = "http"
 	// GRPCProtocol is the common-used grpc protocol.
@@ -70,6 +73,27 @@ const (
 	// PolicyReasonDeprecatedField is used with the "Warning" condition when the policy
 	// uses deprecated fields that should be migrated to newer alternatives.
 	PolicyReasonDeprecatedField gwapiv1.PolicyConditionReason = "DeprecatedField"
+
+	// PolicyReasonNotApplicableField is used with the "Warning" condition when the policy
+	// sets fields that don't apply to the kind of its target, and are ignored for it.
+	PolicyReasonNotApplicableField gwapiv1.PolicyConditionReason = "NotApplicableField"
+)
+
+const (
+	// GatewayConditionXDSRejected indicates whether the proxies of the Gateway
+	// rejected (NACKed) the latest xDS configuration. The proxies keep serving the
+	// last configuration they accepted, and a restarting proxy fails to load the
+	// rejected one. The condition is only set while a rejection is ongoing.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "Rejected"
+	//
+	GatewayConditionXDSRejected gwapiv1.GatewayConditionType = "XDSRejected"
+
+	// GatewayReasonXDSRejected is used with the "XDSRejected" condition when
+	// at least one proxy of the Gateway rejected the latest xDS configuration.
+	GatewayReasonXDSRejected gwapiv1.GatewayConditionReason = "Rejected"
 )
 
 // GroupVersionKind unambiguously identifies a Kind.
@@ -28,6 +28,15 @@ type TCPTimeout struct {
 	ConnectTimeout *gwapiv1.Duration `json:"connectTimeout,omitempty"`
 }
 
+type UDPTimeout struct {
+	// The idle timeout of a UDP session. A session is removed when no datagram
+	// has been received or sent within this duration.
+	// Default: 60 seconds.
+	//
+	// +optional
+	IdleTimeout *gwapiv1.Duration `json:"idleTimeout,omitempty"`
+}
+
 type HTTPTimeout struct {
 	// The idle timeout for an HTTP connection. Idle time is defined as a period in which there are no active requests in the connection.
 	// Default: 1 hour.
@@ -7,12 +7,17 @@ package validation
 
 import (
 	"fmt"
+	"net"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
@@ -56,6 +61,14 @@ func ValidateEnvoyGateway(eg *egv1a1.EnvoyGateway) error {
 		return err
 	}
 
+	if err := validateEnvoyGatewayRateLimitReplicas(eg); err != nil {
+		return err
+	}
+
+	if err := validateEnvoyGatewayRateLimitQuota(eg.RateLimitQuota); err != nil {
+		return err
+	}
+
 	if err := validateEnvoyGatewayExtensionManagers(eg); err != nil {
 		return err
 	}
@@ -68,6 +81,10 @@ func ValidateEnvoyGateway(eg *egv1a1.EnvoyGateway) error {
 		return err
 	}
 
+	if err := validateEnvoyGatewayAdmin(eg); err != nil {
+		return err
+	}
+
 	if eg.ExtensionAPIs != nil && eg.ExtensionAPIs.DisableLua != nil && *eg.ExtensionAPIs.DisableLua == eg.ExtensionAPIs.EnableLua {
 		return fmt.Errorf("disableLua and enableLua must not have the same value")
 	}
@@ -222,18 +239,69 @@ func validateEnvoyGatewayRateLimit(rateLimit *egv1a1.RateLimit) error {
 	if rateLimit == nil {
 		return nil
 	}
-	if rateLimit.Backend.Type != egv1a1.RedisBackendType {
+	if err := ValidateRateLimitBackend(&rateLimit.Backend); err != nil {
+		return err
+	}
+	switch rateLimit.Backend.Type {
+	case egv1a1.RedisBackendType:
+		return validateRateLimitRedis(rateLimit.Backend.Redis)
+	case egv1a1.MemcachedBackendType:
+		return validateRateLimitMemcached(rateLimit.Backend.Memcached)
+	case egv1a1.LocalBackendType:
+		return nil
+	default:
 		return fmt.Errorf("unsupported ratelimit backend %v", rateLimit.Backend.Type)
 	}
-	redis := rateLimit.Backend.Redis
+}
+
+// ValidateRateLimitBackend validates that only the settings of the ratelimit backend type are set.
+func ValidateRateLimitBackend(backend *egv1a1.RateLimitDatabaseBackend) error {
+	for _, member := range []struct {
+		backendType egv1a1.RateLimitDatabaseBackendType
+		set         bool
+	}{
+		{egv1a1.RedisBackendType, backend.Redis != nil},
+		{egv1a1.MemcachedBackendType, backend.Memcached != nil},
+		{egv1a1.LocalBackendType, backend.Local != nil},
+	} {
+		if member.set && member.backendType != backend.Type {
+			return fmt.Errorf("ratelimit %s settings can't be set with the %s backend type", strings.ToLower(string(member.backendType)), backend.Type)
+		}
+	}
+	return nil
+}
+
+func validateRateLimitRedis(redis *egv1a1.RateLimitRedisSettings) error {
 	if redis == nil {
 		return fmt.Errorf("empty ratelimit redis settings")
 	}
 
 	hasURL := ptr.Deref(redis.URL, "") != ""
 	hasURLRef := redis.URLRef != nil
-	if hasURL == hasURLRef {
-		return fmt.Errorf("exactly one of ratelimit redis url or urlRef must be set")
+	modes := 0
+	for _, set := range []bool{hasURL, hasURLRef, redis.Sentinel != nil, redis.Cluster != nil} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return fmt.Errorf("exactly one of ratelimit redis url, urlRef, sentinel or cluster must be set")
+	}
+
+	if err := validateRedisConnectionSettings(&redis.RedisConnectionSettings, "ratelimit redis"); err != nil {
+		return err
+	}
+
+	if redis.PerSecond != nil {
+		if err := validateRateLimitRedisPerSecond(redis.PerSecond); err != nil {
+			return err
+		}
+		// The rate limit service uses one client certificate for both databases.
+		if redis.TLS != nil && redis.TLS.CertificateRef != nil &&
+			redis.PerSecond.TLS != nil && redis.PerSecond.TLS.CertificateRef != nil &&
+			!reflect.DeepEqual(redis.TLS.CertificateRef, redis.PerSecond.TLS.CertificateRef) {
+			return fmt.Errorf("ratelimit redis tls.certificateRef and perSecond.tls.certificateRef must reference the same Secret")
+		}
 	}
 
 	if hasURLRef {
@@ -251,7 +319,168 @@ func validateEnvoyGatewayRateLimit(rateLimit *egv1a1.RateLimit) error {
 		return nil
 	}
 
-	return ValidateRedisURL(*redis.URL)
+	if hasURL {
+		return ValidateRedisURL(*redis.URL)
+	}
+	return nil
+}
+
+func validateRateLimitRedisPerSecond(perSecond *egv1a1.RateLimitRedisPerSecondSettings) error {
+	hasURL := ptr.Deref(perSecond.URL, "") != ""
+	modes := 0
+	for _, set := range []bool{hasURL, perSecond.Sentinel != nil, perSecond.Cluster != nil} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return fmt.Errorf("exactly one of ratelimit redis perSecond url, sentinel or cluster must be set")
+	}
+
+	if err := validateRedisConnectionSettings(&perSecond.RedisConnectionSettings, "ratelimit redis perSecond"); err != nil {
+		return err
+	}
+
+	if hasURL {
+		return ValidateRedisURL(*perSecond.URL)
+	}
+	return nil
+}
+
+// validateRedisConnectionSettings validates the Sentinel and Cluster addresses and the
+// pipelining settings of a ratelimit Redis database. The prefix identifies the database
+// in the error messages.
+func validateRedisConnectionSettings(settings *egv1a1.RedisConnectionSettings, prefix string) error {
+	if sentinel := settings.Sentinel; sentinel != nil {
+		if sentinel.MasterName == "" {
+			return fmt.Errorf("%s sentinel masterName must be set", prefix)
+		}
+		if err := validateRedisAddresses(sentinel.Addresses, prefix+" sentinel"); err != nil {
+			return err
+		}
+		if ref := sentinel.AuthRef; ref != nil && (ref.Name == "" || ref.Key == "") {
+			return fmt.Errorf("%s sentinel authRef must set both name and key", prefix)
+		}
+	}
+
+	if cluster := settings.Cluster; cluster != nil {
+		if err := validateRedisAddresses(cluster.Addresses, prefix+" cluster"); err != nil {
+			return err
+		}
+	}
+
+	if settings.PoolSize != nil && *settings.PoolSize == 0 {
+		return fmt.Errorf("%s poolSize must be greater than 0", prefix)
+	}
+
+	if settings.PipelineWindow != nil {
+		if _, err := time.ParseDuration(string(*settings.PipelineWindow)); err != nil {
+			return fmt.Errorf("invalid %s pipelineWindow: %w", prefix, err)
+		}
+	}
+
+	return nil
+}
+
+func validateRedisAddresses(addresses []string, prefix string) error {
+	if len(addresses) == 0 {
+		return fmt.Errorf("%s addresses must not be empty", prefix)
+	}
+	for _, address := range addresses {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return fmt.Errorf("invalid %s address %q: %w", prefix, address, err)
+		}
+	}
+	return nil
+}
+
+func validateRateLimitMemcached(memcached *egv1a1.RateLimitMemcachedSettings) error {
+	if memcached == nil {
+		return fmt.Errorf("empty ratelimit memcached settings")
+	}
+
+	hasHostPorts := len(memcached.HostPorts) > 0
+	hasSRV := ptr.Deref(memcached.SRV, "") != ""
+	if hasHostPorts == hasSRV {
+		return fmt.Errorf("exactly one of ratelimit memcached hostPorts or srv must be set")
+	}
+
+	for _, hostPort := range memcached.HostPorts {
+		if _, _, err := net.SplitHostPort(hostPort); err != nil {
+			return fmt.Errorf("invalid ratelimit memcached hostPort %q: %w", hostPort, err)
+		}
+	}
+
+	if memcached.SRVRefresh != nil {
+		if !hasSRV {
+			return fmt.Errorf("ratelimit memcached srvRefresh requires srv to be set")
+		}
+		if _, err := time.ParseDuration(string(*memcached.SRVRefresh)); err != nil {
+			return fmt.Errorf("invalid ratelimit memcached srvRefresh: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateEnvoyGatewayRateLimitQuota(rateLimitQuota *egv1a1.RateLimitQuota) error {
+	if rateLimitQuota == nil {
+		return nil
+	}
+
+	u, err := url.Parse(rateLimitQuota.URL)
+	if err != nil {
+		return fmt.Errorf("invalid ratelimit quota url %q: %w", rateLimitQuota.URL, err)
+	}
+	if u.Scheme != "grpc" || u.Hostname() == "" || u.Port() == "" {
+		return fmt.Errorf("invalid ratelimit quota url %q: must be in the form grpc://<host>:<port>", rateLimitQuota.URL)
+	}
+	if _, err := strconv.ParseUint(u.Port(), 10, 16); err != nil {
+		return fmt.Errorf("invalid ratelimit quota url %q: %w", rateLimitQuota.URL, err)
+	}
+
+	if rateLimitQuota.Timeout != nil {
+		if _, err := time.ParseDuration(string(*rateLimitQuota.Timeout)); err != nil {
+			return fmt.Errorf("invalid ratelimit quota timeout: %w", err)
+		}
+	}
+
+	if rateLimitQuota.TLS != nil {
+		for _, ref := range []*gwapiv1.SecretObjectReference{rateLimitQuota.TLS.CACertificateRef, rateLimitQuota.TLS.ClientCertificateRef} {
+			if ref == nil {
+				continue
+			}
+			if (ref.Group != nil && *ref.Group != corev1.GroupName) ||
+				(ref.Kind != nil && *ref.Kind != "Secret") {
+				return fmt.Errorf("unsupported ratelimit quota TLS certificate reference group/kind")
+			}
+			if ref.Name == "" {
+				return fmt.Errorf("ratelimit quota TLS certificate reference name is unspecified")
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateEnvoyGatewayRateLimitReplicas validates that the Local ratelimit backend,
+// whose counters live in the memory of each rate limit pod, runs a single replica.
+func validateEnvoyGatewayRateLimitReplicas(eg *egv1a1.EnvoyGateway) error {
+	if eg.RateLimit == nil || eg.RateLimit.Backend.Type != egv1a1.LocalBackendType {
+		return nil
+	}
+
+	if eg.Provider.Type != egv1a1.ProviderTypeKubernetes || eg.Provider.Kubernetes == nil {
+		return nil
+	}
+	kube := eg.Provider.Kubernetes
+	if kube.RateLimitHpa != nil {
+		return fmt.Errorf("ratelimit hpa is not supported with the Local ratelimit backend")
+	}
+	if kube.RateLimitDeployment != nil && ptr.Deref(kube.RateLimitDeployment.Replicas, 1) > 1 {
+		return fmt.Errorf("ratelimit deployment replicas must be 1 with the Local ratelimit backend")
+	}
+	return nil
 }
 
 // ValidateRedisURL validates a ratelimit Redis URL string, which may be a single
@@ -352,6 +581,110 @@ func validateEnvoyGatewayXDSServer(xdsServer *egv1a1.XDSServer) error {
 		}
 	}
 
+	if err := validateXDSSnapshotCheckpoint(xdsServer.SnapshotCheckpoint); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateXDSSnapshotCheckpoint(checkpoint *egv1a1.XDSSnapshotCheckpoint) error {
+	if checkpoint == nil {
+		return nil
+	}
+
+	switch checkpoint.Type {
+	case egv1a1.FileXDSSnapshotCheckpointType:
+		if checkpoint.File == nil || checkpoint.File.Path == "" {
+			return fmt.Errorf("xdsServer.snapshotCheckpoint.file.path must be set for the File type")
+		}
+		if checkpoint.File.EncryptionKeyPath == "" {
+			return fmt.Errorf("xdsServer.snapshotCheckpoint.file.encryptionKeyPath must be set for the File type")
+		}
+		if checkpoint.Secret != nil {
+			return fmt.Errorf("xdsServer.snapshotCheckpoint.secret can't be set for the File type")
+		}
+	case egv1a1.SecretXDSSnapshotCheckpointType:
+		if checkpoint.File != nil {
+			return fmt.Errorf("xdsServer.snapshotCheckpoint.file can't be set for the Secret type")
+		}
+		if checkpoint.Secret != nil && checkpoint.Secret.Name != nil {
+			// The name is also the value of the label selecting the Secrets.
+			if errs := validation.IsDNS1123Label(*checkpoint.Secret.Name); len(errs) > 0 {
+				return fmt.Errorf("invalid xdsServer.snapshotCheckpoint.secret.name: %s", strings.Join(errs, ", "))
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported xdsServer.snapshotCheckpoint.type %q", checkpoint.Type)
+	}
+
+	if checkpoint.WarmStartTimeout != nil {
+		d, err := time.ParseDuration(string(*checkpoint.WarmStartTimeout))
+		if err != nil {
+			return fmt.Errorf("invalid xdsServer.snapshotCheckpoint.warmStartTimeout: %w", err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("xdsServer.snapshotCheckpoint.warmStartTimeout must be greater than zero")
+		}
+	}
+
+	return nil
+}
+
+func validateEnvoyGatewayAdmin(eg *egv1a1.EnvoyGateway) error {
+	admin := eg.Admin
+	if admin == nil || (admin.TLS == nil && admin.Auth == nil) {
+		return nil
+	}
+
+	if !eg.Provider.IsRunningOnKubernetes() {
+		return fmt.Errorf("admin.tls and admin.auth are only supported with the Kubernetes provider")
+	}
+
+	if admin.TLS != nil {
+		if admin.TLS.CertificateRef.Name == "" {
+			return fmt.Errorf("admin.tls.certificateRef.name is unspecified")
+		}
+		if admin.TLS.ClientValidation != nil && admin.TLS.ClientValidation.CACertificateRef.Name == "" {
+			return fmt.Errorf("admin.tls.clientValidation.caCertificateRef.name is unspecified")
+		}
+	}
+
+	auth := admin.Auth
+	if auth == nil {
+		return nil
+	}
+	switch auth.Type {
+	case egv1a1.EnvoyGatewayAdminAuthTypeTokenReview:
+		if auth.Htpasswd != nil {
+			return fmt.Errorf("admin.auth.htpasswd must not be set if type is TokenReview")
+		}
+	case egv1a1.EnvoyGatewayAdminAuthTypeHtpasswd:
+		if auth.Htpasswd == nil || auth.Htpasswd.UsersRef.Name == "" {
+			return fmt.Errorf("admin.auth.htpasswd.usersRef must be set if type is Htpasswd")
+		}
+		if auth.TokenReview != nil {
+			return fmt.Errorf("admin.auth.tokenReview must not be set if type is Htpasswd")
+		}
+	default:
+		return fmt.Errorf("unsupported admin.auth.type %q", auth.Type)
+	}
+
+	if len(auth.RoleBindings) == 0 {
+		return fmt.Errorf("admin.auth.roleBindings must not be empty")
+	}
+	for i, binding := range auth.RoleBindings {
+		if binding.Role != egv1a1.EnvoyGatewayAdminRoleReadOnly && binding.Role != egv1a1.EnvoyGatewayAdminRoleDebug {
+			return fmt.Errorf("unsupported admin.auth.roleBindings[%d].role %q", i, binding.Role)
+		}
+		if len(binding.Users) == 0 && len(binding.Groups) == 0 {
+			return fmt.Errorf("admin.auth.roleBindings[%d] must have at least one user or group", i)
+		}
+		if len(binding.Groups) > 0 && auth.Type != egv1a1.EnvoyGatewayAdminAuthTypeTokenReview {
+			return fmt.Errorf("admin.auth.roleBindings[%d].groups are only supported with the TokenReview type", i)
+		}
+	}
+
 	return nil
 }
 
@@ -340,6 +340,468 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			name: "happy ratelimit memcached settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+							Memcached: &egv1a1.RateLimitMemcachedSettings{
+								HostPorts: []string{"memcached-0:11211", "memcached-1:11211"},
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "happy ratelimit redis sentinel settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+									Sentinel: &egv1a1.RedisSentinelSettings{
+										MasterName: "mymaster",
+										Addresses:  []string{"sentinel-0:26379", "sentinel-1:26379"},
+										AuthRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "sentinel-auth"},
+											Key:                  "password",
+										},
+									},
+									PoolSize:       new(uint32(20)),
+									PipelineWindow: new(gwapiv1.Duration("150us")),
+								},
+								PerSecond: &egv1a1.RateLimitRedisPerSecondSettings{
+									RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+										Cluster: &egv1a1.RedisClusterSettings{
+											Addresses: []string{"redis-0:6379", "redis-1:6379"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "ratelimit redis url and sentinel both set",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL: new("redis:6379"),
+								RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+									Sentinel: &egv1a1.RedisSentinelSettings{
+										MasterName: "mymaster",
+										Addresses:  []string{"sentinel-0:26379"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit redis sentinel without masterName",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+									Sentinel: &egv1a1.RedisSentinelSettings{
+										Addresses: []string{"sentinel-0:26379"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit redis cluster address without port",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+									Cluster: &egv1a1.RedisClusterSettings{
+										Addresses: []string{"redis-0"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "invalid ratelimit redis pipelineWindow",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL: new("redis:6379"),
+								RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+									PipelineWindow: new(gwapiv1.Duration("1x")),
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit redis perSecond without url",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL:       new("redis:6379"),
+								PerSecond: &egv1a1.RateLimitRedisPerSecondSettings{},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit redis perSecond with the same tls certificateRef",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL: new("redis:6379"),
+								TLS: &egv1a1.RedisTLSSettings{
+									CertificateRef: &gwapiv1.SecretObjectReference{Name: "redis-cert"},
+								},
+								PerSecond: &egv1a1.RateLimitRedisPerSecondSettings{
+									URL: new("redis-persecond:6379"),
+									TLS: &egv1a1.RedisTLSSettings{
+										CertificateRef: &gwapiv1.SecretObjectReference{Name: "redis-cert"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "ratelimit redis perSecond with a different tls certificateRef",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL: new("redis:6379"),
+								TLS: &egv1a1.RedisTLSSettings{
+									CertificateRef: &gwapiv1.SecretObjectReference{Name: "redis-cert"},
+								},
+								PerSecond: &egv1a1.RateLimitRedisPerSecondSettings{
+									URL: new("redis-persecond:6379"),
+									TLS: &egv1a1.RedisTLSSettings{
+										CertificateRef: &gwapiv1.SecretObjectReference{Name: "redis-persecond-cert"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "happy ratelimit quota settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimitQuota: &egv1a1.RateLimitQuota{
+						URL:     "grpc://rlqs.quota-system.svc.cluster.local:18081",
+						Timeout: new(gwapiv1.Duration("500ms")),
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "ratelimit quota url without grpc scheme",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimitQuota: &egv1a1.RateLimitQuota{
+						URL: "http://rlqs.quota-system.svc.cluster.local:18081",
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit quota url without port",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimitQuota: &egv1a1.RateLimitQuota{
+						URL: "grpc://rlqs.quota-system.svc.cluster.local",
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "happy ratelimit quota tls settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimitQuota: &egv1a1.RateLimitQuota{
+						URL: "grpc://rlqs.quota-system.svc.cluster.local:18081",
+						TLS: &egv1a1.RateLimitQuotaTLSSettings{
+							CACertificateRef:     &gwapiv1.SecretObjectReference{Name: "rlqs-ca"},
+							ClientCertificateRef: &gwapiv1.SecretObjectReference{Name: "rlqs-client"},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "ratelimit quota tls certificate reference with unsupported kind",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimitQuota: &egv1a1.RateLimitQuota{
+						URL: "grpc://rlqs.quota-system.svc.cluster.local:18081",
+						TLS: &egv1a1.RateLimitQuotaTLSSettings{
+							CACertificateRef: &gwapiv1.SecretObjectReference{
+								Kind: new(gwapiv1.Kind("ConfigMap")),
+								Name: "rlqs-ca",
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "invalid ratelimit quota timeout",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimitQuota: &egv1a1.RateLimitQuota{
+						URL:     "grpc://rlqs.quota-system.svc.cluster.local:18081",
+						Timeout: new(gwapiv1.Duration("1x")),
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "empty ratelimit memcached settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type:      egv1a1.MemcachedBackendType,
+							Memcached: &egv1a1.RateLimitMemcachedSettings{},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit redis settings with the memcached backend type",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+							Memcached: &egv1a1.RateLimitMemcachedSettings{
+								HostPorts: []string{"memcached:11211"},
+							},
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL: new("redis:6379"),
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit memcached hostPorts and srv both set",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+							Memcached: &egv1a1.RateLimitMemcachedSettings{
+								HostPorts: []string{"memcached:11211"},
+								SRV:       new("_memcache._tcp.memcached"),
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit memcached hostPort without port",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+							Memcached: &egv1a1.RateLimitMemcachedSettings{
+								HostPorts: []string{"memcached"},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit memcached srvRefresh without srv",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+							Memcached: &egv1a1.RateLimitMemcachedSettings{
+								HostPorts:  []string{"memcached:11211"},
+								SRVRefresh: new(gwapiv1.Duration("10s")),
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "happy ratelimit local settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.LocalBackendType,
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "ratelimit local with multiple replicas",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway: egv1a1.DefaultGateway(),
+					Provider: &egv1a1.EnvoyGatewayProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyGatewayKubernetesProvider{
+							EnvoyGatewayKubernetesInfrastructureConfiguration: egv1a1.EnvoyGatewayKubernetesInfrastructureConfiguration{
+								RateLimitDeployment: &egv1a1.KubernetesDeploymentSpec{
+									Replicas: new(int32(2)),
+								},
+							},
+						},
+					},
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.LocalBackendType,
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit local with hpa",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway: egv1a1.DefaultGateway(),
+					Provider: &egv1a1.EnvoyGatewayProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyGatewayKubernetesProvider{
+							EnvoyGatewayKubernetesInfrastructureConfiguration: egv1a1.EnvoyGatewayKubernetesInfrastructureConfiguration{
+								RateLimitHpa: &egv1a1.KubernetesHorizontalPodAutoscalerSpec{
+									MaxReplicas: new(int32(3)),
+								},
+							},
+						},
+					},
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.LocalBackendType,
+						},
+					},
+				},
+			},
+			expect: false,
+		},
 		{
 			name: "happy extension settings",
 			eg: &egv1a1.EnvoyGateway{
@@ -1135,6 +1597,175 @@ func TestValidateEnvoyGatewayXDSServer(t *testing.T) {
 		x := &egv1a1.XDSServer{MaxReceiveMessageSize: &size}
 		require.Error(t, validateEnvoyGatewayXDSServer(x))
 	})
+
+	t.Run("valid file snapshotCheckpoint", func(t *testing.T) {
+		timeout := gwapiv1.Duration("10m")
+		x := &egv1a1.XDSServer{SnapshotCheckpoint: &egv1a1.XDSSnapshotCheckpoint{
+			Type: egv1a1.FileXDSSnapshotCheckpointType,
+			File: &egv1a1.XDSSnapshotCheckpointFile{
+				Path:              "/var/lib/envoy-gateway/xds",
+				EncryptionKeyPath: "/etc/envoy-gateway/xds-key/key",
+			},
+			WarmStartTimeout: &timeout,
+		}}
+		require.NoError(t, validateEnvoyGatewayXDSServer(x))
+	})
+
+	t.Run("valid secret snapshotCheckpoint", func(t *testing.T) {
+		x := &egv1a1.XDSServer{SnapshotCheckpoint: &egv1a1.XDSSnapshotCheckpoint{
+			Type:   egv1a1.SecretXDSSnapshotCheckpointType,
+			Secret: &egv1a1.XDSSnapshotCheckpointSecret{Name: new("xds-snapshots")},
+		}}
+		require.NoError(t, validateEnvoyGatewayXDSServer(x))
+	})
+
+	t.Run("secret snapshotCheckpoint with invalid name", func(t *testing.T) {
+		x := &egv1a1.XDSServer{SnapshotCheckpoint: &egv1a1.XDSSnapshotCheckpoint{
+			Type:   egv1a1.SecretXDSSnapshotCheckpointType,
+			Secret: &egv1a1.XDSSnapshotCheckpointSecret{Name: new("xds.snapshots")},
+		}}
+		require.Error(t, validateEnvoyGatewayXDSServer(x))
+	})
+
+	t.Run("file snapshotCheckpoint without path", func(t *testing.T) {
+		x := &egv1a1.XDSServer{SnapshotCheckpoint: &egv1a1.XDSSnapshotCheckpoint{
+			Type: egv1a1.FileXDSSnapshotCheckpointType,
+		}}
+		require.Error(t, validateEnvoyGatewayXDSServer(x))
+	})
+
+	t.Run("file snapshotCheckpoint without encryption key", func(t *testing.T) {
+		x := &egv1a1.XDSServer{SnapshotCheckpoint: &egv1a1.XDSSnapshotCheckpoint{
+			Type: egv1a1.FileXDSSnapshotCheckpointType,
+			File: &egv1a1.XDSSnapshotCheckpointFile{Path: "/var/lib/envoy-gateway/xds"},
+		}}
+		require.Error(t, validateEnvoyGatewayXDSServer(x))
+	})
+
+	t.Run("secret snapshotCheckpoint with file", func(t *testing.T) {
+		x := &egv1a1.XDSServer{SnapshotCheckpoint: &egv1a1.XDSSnapshotCheckpoint{
+			Type: egv1a1.SecretXDSSnapshotCheckpointType,
+			File: &egv1a1.XDSSnapshotCheckpointFile{Path: "/var/lib/envoy-gateway/xds"},
+		}}
+		require.Error(t, validateEnvoyGatewayXDSServer(x))
+	})
+
+	t.Run("invalid snapshotCheckpoint warmStartTimeout", func(t *testing.T) {
+		timeout := gwapiv1.Duration("0s")
+		x := &egv1a1.XDSServer{SnapshotCheckpoint: &egv1a1.XDSSnapshotCheckpoint{
+			Type:             egv1a1.SecretXDSSnapshotCheckpointType,
+			WarmStartTimeout: &timeout,
+		}}
+		require.Error(t, validateEnvoyGatewayXDSServer(x))
+	})
+}
+
+func TestValidateEnvoyGatewayAdmin(t *testing.T) {
+	newEnvoyGateway := func(admin *egv1a1.EnvoyGatewayAdmin) *egv1a1.EnvoyGateway {
+		return &egv1a1.EnvoyGateway{
+			EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+				Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+				Admin:    admin,
+			},
+		}
+	}
+	readOnly := []egv1a1.EnvoyGatewayAdminRoleBinding{
+		{Role: egv1a1.EnvoyGatewayAdminRoleReadOnly, Users: []string{"viewer"}},
+	}
+
+	t.Run("valid no tls and auth", func(t *testing.T) {
+		require.NoError(t, validateEnvoyGatewayAdmin(newEnvoyGateway(egv1a1.DefaultEnvoyGatewayAdmin())))
+	})
+
+	t.Run("valid tls and token review", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{
+			TLS: &egv1a1.EnvoyGatewayAdminTLS{
+				CertificateRef: gwapiv1.SecretObjectReference{Name: "admin-tls"},
+				ClientValidation: &egv1a1.EnvoyGatewayAdminClientValidation{
+					CACertificateRef: gwapiv1.SecretObjectReference{Name: "admin-ca"},
+				},
+			},
+			Auth: &egv1a1.EnvoyGatewayAdminAuth{
+				Type: egv1a1.EnvoyGatewayAdminAuthTypeTokenReview,
+				RoleBindings: []egv1a1.EnvoyGatewayAdminRoleBinding{
+					{Role: egv1a1.EnvoyGatewayAdminRoleDebug, Groups: []string{"system:serviceaccounts:debug"}},
+				},
+			},
+		})
+		require.NoError(t, validateEnvoyGatewayAdmin(eg))
+	})
+
+	t.Run("valid htpasswd", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{
+			Auth: &egv1a1.EnvoyGatewayAdminAuth{
+				Type:         egv1a1.EnvoyGatewayAdminAuthTypeHtpasswd,
+				Htpasswd:     &egv1a1.EnvoyGatewayAdminHtpasswd{UsersRef: gwapiv1.SecretObjectReference{Name: "admin-users"}},
+				RoleBindings: readOnly,
+			},
+		})
+		require.NoError(t, validateEnvoyGatewayAdmin(eg))
+	})
+
+	t.Run("non kubernetes provider", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{
+			TLS: &egv1a1.EnvoyGatewayAdminTLS{CertificateRef: gwapiv1.SecretObjectReference{Name: "admin-tls"}},
+		})
+		eg.Provider = &egv1a1.EnvoyGatewayProvider{Type: egv1a1.ProviderTypeCustom}
+		require.Error(t, validateEnvoyGatewayAdmin(eg))
+	})
+
+	t.Run("missing certificate name", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{TLS: &egv1a1.EnvoyGatewayAdminTLS{}})
+		require.Error(t, validateEnvoyGatewayAdmin(eg))
+	})
+
+	t.Run("htpasswd type without htpasswd", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{
+			Auth: &egv1a1.EnvoyGatewayAdminAuth{Type: egv1a1.EnvoyGatewayAdminAuthTypeHtpasswd, RoleBindings: readOnly},
+		})
+		require.Error(t, validateEnvoyGatewayAdmin(eg))
+	})
+
+	t.Run("token review type with htpasswd", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{
+			Auth: &egv1a1.EnvoyGatewayAdminAuth{
+				Type:         egv1a1.EnvoyGatewayAdminAuthTypeTokenReview,
+				Htpasswd:     &egv1a1.EnvoyGatewayAdminHtpasswd{UsersRef: gwapiv1.SecretObjectReference{Name: "admin-users"}},
+				RoleBindings: readOnly,
+			},
+		})
+		require.Error(t, validateEnvoyGatewayAdmin(eg))
+	})
+
+	t.Run("no role bindings", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{
+			Auth: &egv1a1.EnvoyGatewayAdminAuth{Type: egv1a1.EnvoyGatewayAdminAuthTypeTokenReview},
+		})
+		require.Error(t, validateEnvoyGatewayAdmin(eg))
+	})
+
+	t.Run("role binding without subjects", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{
+			Auth: &egv1a1.EnvoyGatewayAdminAuth{
+				Type:         egv1a1.EnvoyGatewayAdminAuthTypeTokenReview,
+				RoleBindings: []egv1a1.EnvoyGatewayAdminRoleBinding{{Role: egv1a1.EnvoyGatewayAdminRoleReadOnly}},
+			},
+		})
+		require.Error(t, validateEnvoyGatewayAdmin(eg))
+	})
+
+	t.Run("groups with htpasswd", func(t *testing.T) {
+		eg := newEnvoyGateway(&egv1a1.EnvoyGatewayAdmin{
+			Auth: &egv1a1.EnvoyGatewayAdminAuth{
+				Type:     egv1a1.EnvoyGatewayAdminAuthTypeHtpasswd,
+				Htpasswd: &egv1a1.EnvoyGatewayAdminHtpasswd{UsersRef: gwapiv1.SecretObjectReference{Name: "admin-users"}},
+				RoleBindings: []egv1a1.EnvoyGatewayAdminRoleBinding{
+					{Role: egv1a1.EnvoyGatewayAdminRoleReadOnly, Groups: []string{"admins"}},
+				},
+			},
+		})
+		require.Error(t, validateEnvoyGatewayAdmin(eg))
+	})
 }
 
 func TestDefaultEnvoyGatewayLoggingLevel(t *testing.T) {
@@ -0,0 +1,160 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// WAFRulesKey is the key of the ConfigMap data used as the WAF rule set when
+// it exists. Otherwise, all the keys of the ConfigMap are used in lexical order.
+const WAFRulesKey = "rules.conf"
+
+// WAF defines the configuration of the Web Application Firewall.
+// The rule engine is loaded in Envoy as a Wasm or a dynamic module HTTP filter,
+// and it is configured with SecLang rule sets, such as the OWASP Core Rule Set.
+type WAF struct {
+	// Engine defines the WAF rule engine that is loaded in Envoy to evaluate
+	// the rule sets, for example, the coraza-proxy-wasm module.
+	//
+	// +required
+	Engine WAFEngine `json:"engine"`
+
+	// Mode defines whether the requests that match the rules are blocked or
+	// only reported.
+	// Defaults to Block.
+	//
+	// +kubebuilder:default=Block
+	// +optional
+	Mode *WAFMode `json:"mode,omitempty"`
+
+	// RuleSets is an ordered list of references to the ConfigMaps containing
+	// the SecLang rules, for example, the OWASP Core Rule Set.
+	// The value of key `rules.conf` will be used. If the key is not found,
+	// all the values in the ConfigMap will be used in the lexical order of their keys.
+	// Rule sets are loaded in the order they are defined in this list.
+	//
+	// The directives that read or write files on the proxy, such as SecAuditLog or
+	// SecDataDir, are rejected. Include only accepts the rules embedded in the
+	// rule engine, whose path starts with `@`, for example `@owasp_crs/*.conf`.
+	// The rule IDs 99900-99999 are reserved for the rules generated by Envoy Gateway
+	// for the exclusions, and can't be used by the rules of the rule sets.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(ref, ref.kind == 'ConfigMap' && (ref.group == 'v1' || ref.group == ''))",message="Only a reference to an object of kind ConfigMap belonging to default v1 API group is supported."
+	// +required
+	RuleSets []gwapiv1.LocalObjectReference `json:"ruleSets"`
+
+	// Exclusions is a list of rules that are disabled, either for all the
+	// requests or for the requests whose path matches a prefix.
+	// Exclusions are useful to remove the false positives of the rule sets.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	Exclusions []WAFRuleExclusion `json:"exclusions,omitempty"`
+}
+
+// WAFMode defines how the WAF handles the requests that match the rules.
+//
+// +kubebuilder:validation:Enum=Detect;Block
+type WAFMode string
+
+const (
+	// WAFModeDetect only logs the requests that match the rules, without
+	// blocking them.
+	WAFModeDetect WAFMode = "Detect"
+
+	// WAFModeBlock blocks the requests that match the rules.
+	WAFModeBlock WAFMode = "Block"
+)
+
+// WAFEngineType defines the type of the WAF rule engine.
+//
+// +kubebuilder:validation:Enum=Wasm;DynamicModule
+type WAFEngineType string
+
+const (
+	// WAFEngineTypeWasm loads the WAF rule engine as a Wasm extension.
+	WAFEngineTypeWasm WAFEngineType = "Wasm"
+
+	// WAFEngineTypeDynamicModule loads the WAF rule engine as a dynamic module.
+	WAFEngineTypeDynamicModule WAFEngineType = "DynamicModule"
+)
+
+// WAFEngine defines the WAF rule engine that is loaded in Envoy.
+// The rules are passed to the engine as a JSON configuration in the format of
+// coraza-proxy-wasm: `{"directives_map": {"default": [...]}, "default_directives": "default"}`.
+//
+// +union
+//
+// +kubebuilder:validation:XValidation:rule="self.type == 'Wasm' ? has(self.wasm) : !has(self.wasm)",message="If type is Wasm, wasm field needs to be set."
+// +kubebuilder:validation:XValidation:rule="self.type == 'DynamicModule' ? has(self.dynamicModule) : !has(self.dynamicModule)",message="If type is DynamicModule, dynamicModule field needs to be set."
+type WAFEngine struct {
+	// Type is the type of the WAF rule engine.
+	//
+	// +unionDiscriminator
+	// +required
+	Type WAFEngineType `json:"type"`
+
+	// Wasm is the Wasm code of the WAF rule engine.
+	// The code is fetched and cached by Envoy Gateway in the same way as the
+	// Wasm extensions of the EnvoyExtensionPolicy.
+	//
+	// +optional
+	Wasm *WasmCodeSource `json:"wasm,omitempty"`
+
+	// DynamicModule is the dynamic module of the WAF rule engine.
+	//
+	// +optional
+	DynamicModule *WAFDynamicModuleEngine `json:"dynamicModule,omitempty"`
+}
+
+// WAFDynamicModuleEngine defines a WAF rule engine loaded as a dynamic module.
+type WAFDynamicModuleEngine struct {
+	// Name references a dynamic module registered in the EnvoyProxy resource's
+	// dynamicModules list.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Name string `json:"name"`
+
+	// FilterName identifies the WAF filter implementation within the dynamic module.
+	//
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	FilterName *string `json:"filterName,omitempty"`
+}
+
+// WAFRuleExclusion defines the rules that are disabled.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.ruleIDs) || has(self.ruleTags)",message="at least one of ruleIDs or ruleTags must be specified"
+type WAFRuleExclusion struct {
+	// RuleIDs is a list of the IDs of the rules to disable.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	RuleIDs []uint32 `json:"ruleIDs,omitempty"`
+
+	// RuleTags is a list of tags. The rules with any of these tags are disabled,
+	// for example, `attack-sqli`.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^[^\s"',\\]+$`
+	// +optional
+	RuleTags []string `json:"ruleTags,omitempty"`
+
+	// PathPrefix limits the exclusion to the requests whose path starts with
+	// the prefix. If unset, the rules are disabled for all the requests.
+	//
+	// +kubebuilder:validation:Pattern=`^/[^\s"'\\]*$`
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+}
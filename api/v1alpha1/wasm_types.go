@@ -67,6 +67,13 @@ type Wasm struct {
 	// Env configures the environment for the Wasm extension
 	// +optional
 	Env *WasmEnv `json:"env,omitempty"`
+
+	// Match defines the conditions of a request for the extension to be executed.
+	// The extension is skipped for the requests that don't match.
+	// If unset, the extension is executed for all the requests.
+	//
+	// +optional
+	Match *ExtensionMatch `json:"match,omitempty"`
 }
 
 // WasmCodeSource defines the source of the Wasm code.
@@ -209,6 +209,101 @@ func (in *ActiveHealthCheckPayload) DeepCopy() *ActiveHealthCheckPayload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrency) DeepCopyInto(out *AdaptiveConcurrency) {
+	*out = *in
+	if in.SampleAggregatePercentile != nil {
+		in, out := &in.SampleAggregatePercentile, &out.SampleAggregatePercentile
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConcurrencyLimit != nil {
+		in, out := &in.ConcurrencyLimit, &out.ConcurrencyLimit
+		*out = new(AdaptiveConcurrencyLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinRTT != nil {
+		in, out := &in.MinRTT, &out.MinRTT
+		*out = new(AdaptiveConcurrencyMinRTT)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrency.
+func (in *AdaptiveConcurrency) DeepCopy() *AdaptiveConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrencyLimit) DeepCopyInto(out *AdaptiveConcurrencyLimit) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.UpdateInterval != nil {
+		in, out := &in.UpdateInterval, &out.UpdateInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrencyLimit.
+func (in *AdaptiveConcurrencyLimit) DeepCopy() *AdaptiveConcurrencyLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrencyLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrencyMinRTT) DeepCopyInto(out *AdaptiveConcurrencyMinRTT) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequestCount != nil {
+		in, out := &in.RequestCount, &out.RequestCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.JitterPercent != nil {
+		in, out := &in.JitterPercent, &out.JitterPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.BufferPercent != nil {
+		in, out := &in.BufferPercent, &out.BufferPercent
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrencyMinRTT.
+func (in *AdaptiveConcurrencyMinRTT) DeepCopy() *AdaptiveConcurrencyMinRTT {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrencyMinRTT)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdmissionControl) DeepCopyInto(out *AdmissionControl) {
 	*out = *in
@@ -623,6 +718,11 @@ func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 		*out = new(BackendTLSSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNSResolver != nil {
+		in, out := &in.DNSResolver, &out.DNSResolver
+		*out = new(DNSResolver)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
@@ -827,6 +927,11 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 		*out = new(BandwidthLimitSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UDPTimeout != nil {
+		in, out := &in.UDPTimeout, &out.UDPTimeout
+		*out = new(UDPTimeout)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FaultInjection != nil {
 		in, out := &in.FaultInjection, &out.FaultInjection
 		*out = new(FaultInjection)
@@ -837,6 +942,11 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 		*out = new(AdmissionControl)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdaptiveConcurrency != nil {
+		in, out := &in.AdaptiveConcurrency, &out.AdaptiveConcurrency
+		*out = new(AdaptiveConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UseClientProtocol != nil {
 		in, out := &in.UseClientProtocol, &out.UseClientProtocol
 		*out = new(bool)
@@ -864,6 +974,11 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 			}
 		}
 	}
+	if in.Decompression != nil {
+		in, out := &in.Decompression, &out.Decompression
+		*out = new(Decompression)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResponseOverride != nil {
 		in, out := &in.ResponseOverride, &out.ResponseOverride
 		*out = make([]*ResponseOverride, len(*in))
@@ -891,6 +1006,11 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 		*out = new(RequestBuffer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Telemetry != nil {
 		in, out := &in.Telemetry, &out.Telemetry
 		*out = new(BackendTelemetry)
@@ -1182,6 +1302,83 @@ func (in *CSRF) DeepCopy() *CSRF {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cache) DeepCopyInto(out *Cache) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxEntrySize != nil {
+		in, out := &in.MaxEntrySize, &out.MaxEntrySize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AllowedVaryHeaders != nil {
+		in, out := &in.AllowedVaryHeaders, &out.AllowedVaryHeaders
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(CacheKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IgnoreRequestCacheControl != nil {
+		in, out := &in.IgnoreRequestCacheControl, &out.IgnoreRequestCacheControl
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
+	if in == nil {
+		return nil
+	}
+	out := new(Cache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheKey) DeepCopyInto(out *CacheKey) {
+	*out = *in
+	if in.ExcludeScheme != nil {
+		in, out := &in.ExcludeScheme, &out.ExcludeScheme
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExcludeHost != nil {
+		in, out := &in.ExcludeHost, &out.ExcludeHost
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludedQueryParameters != nil {
+		in, out := &in.IncludedQueryParameters, &out.IncludedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedQueryParameters != nil {
+		in, out := &in.ExcludedQueryParameters, &out.ExcludedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKey.
+func (in *CacheKey) DeepCopy() *CacheKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -1247,6 +1444,40 @@ func (in *ClaimToHeader) DeepCopy() *ClaimToHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertificatePrincipal) DeepCopyInto(out *ClientCertificatePrincipal) {
+	*out = *in
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(StringMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.URIs != nil {
+		in, out := &in.URIs, &out.URIs
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertificatePrincipal.
+func (in *ClientCertificatePrincipal) DeepCopy() *ClientCertificatePrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertificatePrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnection) DeepCopyInto(out *ClientConnection) {
 	*out = *in
@@ -2123,6 +2354,11 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 		*out = new(DNSLookupFamily)
 		**out = **in
 	}
+	if in.Resolver != nil {
+		in, out := &in.Resolver, &out.Resolver
+		*out = new(DNSResolver)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNS.
@@ -2135,6 +2371,87 @@ func (in *DNS) DeepCopy() *DNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSResolver) DeepCopyInto(out *DNSResolver) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(DNSResolverType)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]IPEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.UseTCP != nil {
+		in, out := &in.UseTCP, &out.UseTCP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NoDefaultSearchDomain != nil {
+		in, out := &in.NoDefaultSearchDomain, &out.NoDefaultSearchDomain
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSResolver.
+func (in *DNSResolver) DeepCopy() *DNSResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Decompression) DeepCopyInto(out *Decompression) {
+	*out = *in
+	if in.Decompressor != nil {
+		in, out := &in.Decompressor, &out.Decompressor
+		*out = make([]*Decompressor, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Decompressor)
+				**out = **in
+			}
+		}
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Decompression.
+func (in *Decompression) DeepCopy() *Decompression {
+	if in == nil {
+		return nil
+	}
+	out := new(Decompression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Decompressor) DeepCopyInto(out *Decompressor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Decompressor.
+func (in *Decompressor) DeepCopy() *Decompressor {
+	if in == nil {
+		return nil
+	}
+	out := new(Decompressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectSourceIPSettings) DeepCopyInto(out *DirectSourceIPSettings) {
 	*out = *in
@@ -2414,6 +2731,11 @@ func (in *EnvoyExtensionPolicySpec) DeepCopyInto(out *EnvoyExtensionPolicySpec)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GRPCJSONTranscoder != nil {
+		in, out := &in.GRPCJSONTranscoder, &out.GRPCJSONTranscoder
+		*out = new(GRPCJSONTranscoder)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyExtensionPolicySpec.
@@ -2459,6 +2781,16 @@ func (in *EnvoyGatewayAdmin) DeepCopyInto(out *EnvoyGatewayAdmin) {
 		*out = new(EnvoyGatewayAdminAddress)
 		**out = **in
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(EnvoyGatewayAdminTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(EnvoyGatewayAdminAuth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayAdmin.
@@ -2487,60 +2819,190 @@ func (in *EnvoyGatewayAdminAddress) DeepCopy() *EnvoyGatewayAdminAddress {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyGatewayCustomProvider) DeepCopyInto(out *EnvoyGatewayCustomProvider) {
+func (in *EnvoyGatewayAdminAuth) DeepCopyInto(out *EnvoyGatewayAdminAuth) {
 	*out = *in
-	in.Resource.DeepCopyInto(&out.Resource)
-	if in.Infrastructure != nil {
-		in, out := &in.Infrastructure, &out.Infrastructure
-		*out = new(EnvoyGatewayInfrastructureProvider)
+	if in.TokenReview != nil {
+		in, out := &in.TokenReview, &out.TokenReview
+		*out = new(EnvoyGatewayAdminTokenReview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Htpasswd != nil {
+		in, out := &in.Htpasswd, &out.Htpasswd
+		*out = new(EnvoyGatewayAdminHtpasswd)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]EnvoyGatewayAdminRoleBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayCustomProvider.
-func (in *EnvoyGatewayCustomProvider) DeepCopy() *EnvoyGatewayCustomProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayAdminAuth.
+func (in *EnvoyGatewayAdminAuth) DeepCopy() *EnvoyGatewayAdminAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvoyGatewayCustomProvider)
+	out := new(EnvoyGatewayAdminAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyGatewayFileResourceProvider) DeepCopyInto(out *EnvoyGatewayFileResourceProvider) {
+func (in *EnvoyGatewayAdminClientValidation) DeepCopyInto(out *EnvoyGatewayAdminClientValidation) {
 	*out = *in
-	if in.Paths != nil {
-		in, out := &in.Paths, &out.Paths
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.CACertificateRef.DeepCopyInto(&out.CACertificateRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayFileResourceProvider.
-func (in *EnvoyGatewayFileResourceProvider) DeepCopy() *EnvoyGatewayFileResourceProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayAdminClientValidation.
+func (in *EnvoyGatewayAdminClientValidation) DeepCopy() *EnvoyGatewayAdminClientValidation {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvoyGatewayFileResourceProvider)
+	out := new(EnvoyGatewayAdminClientValidation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyGatewayHostInfrastructureProvider) DeepCopyInto(out *EnvoyGatewayHostInfrastructureProvider) {
+func (in *EnvoyGatewayAdminHtpasswd) DeepCopyInto(out *EnvoyGatewayAdminHtpasswd) {
 	*out = *in
-	if in.ConfigHome != nil {
-		in, out := &in.ConfigHome, &out.ConfigHome
-		*out = new(string)
-		**out = **in
-	}
-	if in.DataHome != nil {
-		in, out := &in.DataHome, &out.DataHome
-		*out = new(string)
-		**out = **in
+	in.UsersRef.DeepCopyInto(&out.UsersRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayAdminHtpasswd.
+func (in *EnvoyGatewayAdminHtpasswd) DeepCopy() *EnvoyGatewayAdminHtpasswd {
+	if in == nil {
+		return nil
 	}
-	if in.StateHome != nil {
+	out := new(EnvoyGatewayAdminHtpasswd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayAdminRoleBinding) DeepCopyInto(out *EnvoyGatewayAdminRoleBinding) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayAdminRoleBinding.
+func (in *EnvoyGatewayAdminRoleBinding) DeepCopy() *EnvoyGatewayAdminRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayAdminRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayAdminTLS) DeepCopyInto(out *EnvoyGatewayAdminTLS) {
+	*out = *in
+	in.CertificateRef.DeepCopyInto(&out.CertificateRef)
+	if in.ClientValidation != nil {
+		in, out := &in.ClientValidation, &out.ClientValidation
+		*out = new(EnvoyGatewayAdminClientValidation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayAdminTLS.
+func (in *EnvoyGatewayAdminTLS) DeepCopy() *EnvoyGatewayAdminTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayAdminTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayAdminTokenReview) DeepCopyInto(out *EnvoyGatewayAdminTokenReview) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayAdminTokenReview.
+func (in *EnvoyGatewayAdminTokenReview) DeepCopy() *EnvoyGatewayAdminTokenReview {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayAdminTokenReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayCustomProvider) DeepCopyInto(out *EnvoyGatewayCustomProvider) {
+	*out = *in
+	in.Resource.DeepCopyInto(&out.Resource)
+	if in.Infrastructure != nil {
+		in, out := &in.Infrastructure, &out.Infrastructure
+		*out = new(EnvoyGatewayInfrastructureProvider)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayCustomProvider.
+func (in *EnvoyGatewayCustomProvider) DeepCopy() *EnvoyGatewayCustomProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayCustomProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayFileResourceProvider) DeepCopyInto(out *EnvoyGatewayFileResourceProvider) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayFileResourceProvider.
+func (in *EnvoyGatewayFileResourceProvider) DeepCopy() *EnvoyGatewayFileResourceProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayFileResourceProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayHostInfrastructureProvider) DeepCopyInto(out *EnvoyGatewayHostInfrastructureProvider) {
+	*out = *in
+	if in.ConfigHome != nil {
+		in, out := &in.ConfigHome, &out.ConfigHome
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataHome != nil {
+		in, out := &in.DataHome, &out.DataHome
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateHome != nil {
 		in, out := &in.StateHome, &out.StateHome
 		*out = new(string)
 		**out = **in
@@ -2922,6 +3384,11 @@ func (in *EnvoyGatewaySpec) DeepCopyInto(out *EnvoyGatewaySpec) {
 		*out = new(RateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimitQuota != nil {
+		in, out := &in.RateLimitQuota, &out.RateLimitQuota
+		*out = new(RateLimitQuota)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtensionManager != nil {
 		in, out := &in.ExtensionManager, &out.ExtensionManager
 		*out = new(ExtensionManager)
@@ -3236,6 +3703,33 @@ func (in *EnvoyProxyHostProvider) DeepCopy() *EnvoyProxyHostProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyProxyIPTagging) DeepCopyInto(out *EnvoyProxyIPTagging) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]IPTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(IPTagsFile)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyProxyIPTagging.
+func (in *EnvoyProxyIPTagging) DeepCopy() *EnvoyProxyIPTagging {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyProxyIPTagging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvoyProxyKubernetesProvider) DeepCopyInto(out *EnvoyProxyKubernetesProvider) {
 	*out = *in
@@ -3436,6 +3930,16 @@ func (in *EnvoyProxySpec) DeepCopyInto(out *EnvoyProxySpec) {
 		*out = new(EnvoyProxyGeoIP)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPTagging != nil {
+		in, out := &in.IPTagging, &out.IPTagging
+		*out = new(EnvoyProxyIPTagging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSResolver != nil {
+		in, out := &in.DNSResolver, &out.DNSResolver
+		*out = new(DNSResolver)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MergeType != nil {
 		in, out := &in.MergeType, &out.MergeType
 		*out = new(MergeType)
@@ -3580,6 +4084,11 @@ func (in *ExtProc) DeepCopyInto(out *ExtProc) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(ExtensionMatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtProc.
@@ -3727,6 +4236,43 @@ func (in *ExtensionManager) DeepCopy() *ExtensionManager {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionMatch) DeepCopyInto(out *ExtensionMatch) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]AuthorizationHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(PathMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]v1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.CEL != nil {
+		in, out := &in.CEL, &out.CEL
+		*out = new(CELExpression)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionMatch.
+func (in *ExtensionMatch) DeepCopy() *ExtensionMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtensionService) DeepCopyInto(out *ExtensionService) {
 	*out = *in
@@ -4050,6 +4596,72 @@ func (in *GRPCExtAuthService) DeepCopy() *GRPCExtAuthService {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoder) DeepCopyInto(out *GRPCJSONTranscoder) {
+	*out = *in
+	out.ProtoDescriptorRef = in.ProtoDescriptorRef
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrintOptions != nil {
+		in, out := &in.PrintOptions, &out.PrintOptions
+		*out = new(GRPCJSONTranscoderPrintOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnknownQueryParameters != nil {
+		in, out := &in.UnknownQueryParameters, &out.UnknownQueryParameters
+		*out = new(GRPCJSONTranscoderUnknownQueryParametersAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoder.
+func (in *GRPCJSONTranscoder) DeepCopy() *GRPCJSONTranscoder {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoderPrintOptions) DeepCopyInto(out *GRPCJSONTranscoderPrintOptions) {
+	*out = *in
+	if in.AddWhitespace != nil {
+		in, out := &in.AddWhitespace, &out.AddWhitespace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlwaysPrintPrimitiveFields != nil {
+		in, out := &in.AlwaysPrintPrimitiveFields, &out.AlwaysPrintPrimitiveFields
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlwaysPrintEnumsAsInts != nil {
+		in, out := &in.AlwaysPrintEnumsAsInts, &out.AlwaysPrintEnumsAsInts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreserveProtoFieldNames != nil {
+		in, out := &in.PreserveProtoFieldNames, &out.PreserveProtoFieldNames
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoderPrintOptions.
+func (in *GRPCJSONTranscoderPrintOptions) DeepCopy() *GRPCJSONTranscoderPrintOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoderPrintOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GRPCSettings) DeepCopyInto(out *GRPCSettings) {
 	*out = *in
@@ -4070,6 +4682,26 @@ func (in *GRPCSettings) DeepCopy() *GRPCSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCStatsService) DeepCopyInto(out *GRPCStatsService) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCStatsService.
+func (in *GRPCStatsService) DeepCopy() *GRPCStatsService {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCStatsService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GRPCSuccessCriteria) DeepCopyInto(out *GRPCSuccessCriteria) {
 	*out = *in
@@ -5126,6 +5758,26 @@ func (in *HealthCheckSettings) DeepCopy() *HealthCheckSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HedgePolicy) DeepCopyInto(out *HedgePolicy) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]v1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HedgePolicy.
+func (in *HedgePolicy) DeepCopy() *HedgePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HedgePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostSettings) DeepCopyInto(out *HostSettings) {
 	*out = *in
@@ -5177,16 +5829,56 @@ func (in *IPEndpoint) DeepCopy() *IPEndpoint {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageWasmCodeSource) DeepCopyInto(out *ImageWasmCodeSource) {
+func (in *IPTag) DeepCopyInto(out *IPTag) {
 	*out = *in
-	if in.SHA256 != nil {
-		in, out := &in.SHA256, &out.SHA256
-		*out = new(string)
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValueRef != nil {
+		in, out := &in.ValueRef, &out.ValueRef
+		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
-	if in.PullSecretRef != nil {
-		in, out := &in.PullSecretRef, &out.PullSecretRef
-		*out = new(v1.SecretObjectReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTag.
+func (in *IPTag) DeepCopy() *IPTag {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTagsFile) DeepCopyInto(out *IPTagsFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTagsFile.
+func (in *IPTagsFile) DeepCopy() *IPTagsFile {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTagsFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageWasmCodeSource) DeepCopyInto(out *ImageWasmCodeSource) {
+	*out = *in
+	if in.SHA256 != nil {
+		in, out := &in.SHA256, &out.SHA256
+		*out = new(string)
+		**out = **in
+	}
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(v1.SecretObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TLS != nil {
@@ -6169,6 +6861,11 @@ func (in *Lua) DeepCopyInto(out *Lua) {
 		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(ExtensionMatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lua.
@@ -6206,6 +6903,26 @@ func (in *LuaValidationConfig) DeepCopy() *LuaValidationConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemcachedTLSSettings) DeepCopyInto(out *MemcachedTLSSettings) {
+	*out = *in
+	if in.CertificateRef != nil {
+		in, out := &in.CertificateRef, &out.CertificateRef
+		*out = new(v1.SecretObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemcachedTLSSettings.
+func (in *MemcachedTLSSettings) DeepCopy() *MemcachedTLSSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MemcachedTLSSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MergeBackendsConfig) DeepCopyInto(out *MergeBackendsConfig) {
 	*out = *in
@@ -6916,6 +7633,16 @@ func (in *Principal) DeepCopyInto(out *Principal) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClientIPTags != nil {
+		in, out := &in.ClientIPTags, &out.ClientIPTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(ClientCertificatePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Principal.
@@ -7131,6 +7858,33 @@ func (in *ProxyBootstrap) DeepCopy() *ProxyBootstrap {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyGRPCStats) DeepCopyInto(out *ProxyGRPCStats) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]GRPCStatsService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnableUpstreamStats != nil {
+		in, out := &in.EnableUpstreamStats, &out.EnableUpstreamStats
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyGRPCStats.
+func (in *ProxyGRPCStats) DeepCopy() *ProxyGRPCStats {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyGRPCStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyHealthCheckLog) DeepCopyInto(out *ProxyHealthCheckLog) {
 	*out = *in
@@ -7262,6 +8016,11 @@ func (in *ProxyMetrics) DeepCopyInto(out *ProxyMetrics) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.GRPCStats != nil {
+		in, out := &in.GRPCStats, &out.GRPCStats
+		*out = new(ProxyGRPCStats)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClusterStatName != nil {
 		in, out := &in.ClusterStatName, &out.ClusterStatName
 		*out = new(string)
@@ -7484,6 +8243,70 @@ func (in *QueryParamMatch) DeepCopy() *QueryParamMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaRateLimit) DeepCopyInto(out *QuotaRateLimit) {
+	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]QuotaRateLimitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReportingInterval != nil {
+		in, out := &in.ReportingInterval, &out.ReportingInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FallbackAction != nil {
+		in, out := &in.FallbackAction, &out.FallbackAction
+		*out = new(QuotaFallbackAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaRateLimit.
+func (in *QuotaRateLimit) DeepCopy() *QuotaRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaRateLimitRule) DeepCopyInto(out *QuotaRateLimitRule) {
+	*out = *in
+	if in.ClientSelectors != nil {
+		in, out := &in.ClientSelectors, &out.ClientSelectors
+		*out = make([]RateLimitSelectCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Shared != nil {
+		in, out := &in.Shared, &out.Shared
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaRateLimitRule.
+func (in *QuotaRateLimitRule) DeepCopy() *QuotaRateLimitRule {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaRateLimitRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 	*out = *in
@@ -7583,6 +8406,16 @@ func (in *RateLimitDatabaseBackend) DeepCopyInto(out *RateLimitDatabaseBackend)
 		*out = new(RateLimitRedisSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Memcached != nil {
+		in, out := &in.Memcached, &out.Memcached
+		*out = new(RateLimitMemcachedSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(RateLimitLocalSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDatabaseBackend.
@@ -7595,6 +8428,71 @@ func (in *RateLimitDatabaseBackend) DeepCopy() *RateLimitDatabaseBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitLocalSettings) DeepCopyInto(out *RateLimitLocalSettings) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitLocalSettings.
+func (in *RateLimitLocalSettings) DeepCopy() *RateLimitLocalSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitLocalSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitMemcachedSettings) DeepCopyInto(out *RateLimitMemcachedSettings) {
+	*out = *in
+	if in.HostPorts != nil {
+		in, out := &in.HostPorts, &out.HostPorts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SRV != nil {
+		in, out := &in.SRV, &out.SRV
+		*out = new(string)
+		**out = **in
+	}
+	if in.SRVRefresh != nil {
+		in, out := &in.SRVRefresh, &out.SRVRefresh
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxIdleConnections != nil {
+		in, out := &in.MaxIdleConnections, &out.MaxIdleConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(MemcachedTLSSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitMemcachedSettings.
+func (in *RateLimitMemcachedSettings) DeepCopy() *RateLimitMemcachedSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitMemcachedSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitMetrics) DeepCopyInto(out *RateLimitMetrics) {
 	*out = *in
@@ -7630,6 +8528,82 @@ func (in *RateLimitMetricsPrometheusProvider) DeepCopy() *RateLimitMetricsPromet
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitQuota) DeepCopyInto(out *RateLimitQuota) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(RateLimitQuotaTLSSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitQuota.
+func (in *RateLimitQuota) DeepCopy() *RateLimitQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitQuotaTLSSettings) DeepCopyInto(out *RateLimitQuotaTLSSettings) {
+	*out = *in
+	if in.CACertificateRef != nil {
+		in, out := &in.CACertificateRef, &out.CACertificateRef
+		*out = new(v1.SecretObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertificateRef != nil {
+		in, out := &in.ClientCertificateRef, &out.ClientCertificateRef
+		*out = new(v1.SecretObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitQuotaTLSSettings.
+func (in *RateLimitQuotaTLSSettings) DeepCopy() *RateLimitQuotaTLSSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitQuotaTLSSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitRedisPerSecondSettings) DeepCopyInto(out *RateLimitRedisPerSecondSettings) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(RedisTLSSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RedisConnectionSettings.DeepCopyInto(&out.RedisConnectionSettings)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRedisPerSecondSettings.
+func (in *RateLimitRedisPerSecondSettings) DeepCopy() *RateLimitRedisPerSecondSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitRedisPerSecondSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitRedisSettings) DeepCopyInto(out *RateLimitRedisSettings) {
 	*out = *in
@@ -7648,6 +8622,12 @@ func (in *RateLimitRedisSettings) DeepCopyInto(out *RateLimitRedisSettings) {
 		*out = new(RedisTLSSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	in.RedisConnectionSettings.DeepCopyInto(&out.RedisConnectionSettings)
+	if in.PerSecond != nil {
+		in, out := &in.PerSecond, &out.PerSecond
+		*out = new(RateLimitRedisPerSecondSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRedisSettings.
@@ -7737,6 +8717,11 @@ func (in *RateLimitSelectCondition) DeepCopyInto(out *RateLimitSelectCondition)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClientIPTags != nil {
+		in, out := &in.ClientIPTags, &out.ClientIPTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSelectCondition.
@@ -7767,6 +8752,11 @@ func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
 		*out = new(LocalRateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(QuotaRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
@@ -7884,6 +8874,91 @@ func (in *RateLimitValueMetadata) DeepCopy() *RateLimitValueMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisClusterSettings) DeepCopyInto(out *RedisClusterSettings) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisClusterSettings.
+func (in *RedisClusterSettings) DeepCopy() *RedisClusterSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisClusterSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisConnectionSettings) DeepCopyInto(out *RedisConnectionSettings) {
+	*out = *in
+	if in.Sentinel != nil {
+		in, out := &in.Sentinel, &out.Sentinel
+		*out = new(RedisSentinelSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(RedisClusterSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PoolSize != nil {
+		in, out := &in.PoolSize, &out.PoolSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PipelineWindow != nil {
+		in, out := &in.PipelineWindow, &out.PipelineWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PipelineLimit != nil {
+		in, out := &in.PipelineLimit, &out.PipelineLimit
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisConnectionSettings.
+func (in *RedisConnectionSettings) DeepCopy() *RedisConnectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisConnectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisSentinelSettings) DeepCopyInto(out *RedisSentinelSettings) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthRef != nil {
+		in, out := &in.AuthRef, &out.AuthRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSentinelSettings.
+func (in *RedisSentinelSettings) DeepCopy() *RedisSentinelSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisSentinelSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisTLSSettings) DeepCopyInto(out *RedisTLSSettings) {
 	*out = *in
@@ -8106,6 +9181,16 @@ func (in *Retry) DeepCopyInto(out *Retry) {
 		*out = new(PerRetryPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Hedge != nil {
+		in, out := &in.Hedge, &out.Hedge
+		*out = new(HedgePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostSelection != nil {
+		in, out := &in.HostSelection, &out.HostSelection
+		*out = new(RetryHostSelection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
@@ -8139,6 +9224,31 @@ func (in *RetryBudget) DeepCopy() *RetryBudget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryHostSelection) DeepCopyInto(out *RetryHostSelection) {
+	*out = *in
+	if in.AvoidPreviousHosts != nil {
+		in, out := &in.AvoidPreviousHosts, &out.AvoidPreviousHosts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryHostSelection.
+func (in *RetryHostSelection) DeepCopy() *RetryHostSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryHostSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryOn) DeepCopyInto(out *RetryOn) {
 	*out = *in
@@ -8337,6 +9447,11 @@ func (in *SecurityPolicySpec) DeepCopyInto(out *SecurityPolicySpec) {
 		*out = new(Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WAF != nil {
+		in, out := &in.WAF, &out.WAF
+		*out = new(WAF)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicySpec.
@@ -9033,6 +10148,26 @@ func (in *TranslationConfig) DeepCopy() *TranslationConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDPTimeout) DeepCopyInto(out *UDPTimeout) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPTimeout.
+func (in *UDPTimeout) DeepCopy() *UDPTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(UDPTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnixSocket) DeepCopyInto(out *UnixSocket) {
 	*out = *in
@@ -9048,6 +10183,114 @@ func (in *UnixSocket) DeepCopy() *UnixSocket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAF) DeepCopyInto(out *WAF) {
+	*out = *in
+	in.Engine.DeepCopyInto(&out.Engine)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(WAFMode)
+		**out = **in
+	}
+	if in.RuleSets != nil {
+		in, out := &in.RuleSets, &out.RuleSets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]WAFRuleExclusion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAF.
+func (in *WAF) DeepCopy() *WAF {
+	if in == nil {
+		return nil
+	}
+	out := new(WAF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFDynamicModuleEngine) DeepCopyInto(out *WAFDynamicModuleEngine) {
+	*out = *in
+	if in.FilterName != nil {
+		in, out := &in.FilterName, &out.FilterName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFDynamicModuleEngine.
+func (in *WAFDynamicModuleEngine) DeepCopy() *WAFDynamicModuleEngine {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFDynamicModuleEngine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFEngine) DeepCopyInto(out *WAFEngine) {
+	*out = *in
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(WasmCodeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DynamicModule != nil {
+		in, out := &in.DynamicModule, &out.DynamicModule
+		*out = new(WAFDynamicModuleEngine)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFEngine.
+func (in *WAFEngine) DeepCopy() *WAFEngine {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFEngine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFRuleExclusion) DeepCopyInto(out *WAFRuleExclusion) {
+	*out = *in
+	if in.RuleIDs != nil {
+		in, out := &in.RuleIDs, &out.RuleIDs
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+	if in.RuleTags != nil {
+		in, out := &in.RuleTags, &out.RuleTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFRuleExclusion.
+func (in *WAFRuleExclusion) DeepCopy() *WAFRuleExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFRuleExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Wasm) DeepCopyInto(out *Wasm) {
 	*out = *in
@@ -9077,6 +10320,11 @@ func (in *Wasm) DeepCopyInto(out *Wasm) {
 		*out = new(WasmEnv)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(ExtensionMatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Wasm.
@@ -9188,6 +10436,11 @@ func (in *XDSServer) DeepCopyInto(out *XDSServer) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.SnapshotCheckpoint != nil {
+		in, out := &in.SnapshotCheckpoint, &out.SnapshotCheckpoint
+		*out = new(XDSSnapshotCheckpoint)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XDSServer.
@@ -9200,6 +10453,71 @@ func (in *XDSServer) DeepCopy() *XDSServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XDSSnapshotCheckpoint) DeepCopyInto(out *XDSSnapshotCheckpoint) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(XDSSnapshotCheckpointFile)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(XDSSnapshotCheckpointSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WarmStartTimeout != nil {
+		in, out := &in.WarmStartTimeout, &out.WarmStartTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XDSSnapshotCheckpoint.
+func (in *XDSSnapshotCheckpoint) DeepCopy() *XDSSnapshotCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(XDSSnapshotCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XDSSnapshotCheckpointFile) DeepCopyInto(out *XDSSnapshotCheckpointFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XDSSnapshotCheckpointFile.
+func (in *XDSSnapshotCheckpointFile) DeepCopy() *XDSSnapshotCheckpointFile {
+	if in == nil {
+		return nil
+	}
+	out := new(XDSSnapshotCheckpointFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XDSSnapshotCheckpointSecret) DeepCopyInto(out *XDSSnapshotCheckpointSecret) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XDSSnapshotCheckpointSecret.
+func (in *XDSSnapshotCheckpointSecret) DeepCopy() *XDSSnapshotCheckpointSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(XDSSnapshotCheckpointSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *XDSTranslatorHooks) DeepCopyInto(out *XDSTranslatorHooks) {
 	*out = *in
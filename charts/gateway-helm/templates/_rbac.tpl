@@ -275,6 +275,19 @@ verbs:
   - watch
 {{- end }}
 
+{{- define "eg.rbac.controllernamespace.xdssnapshots" -}}
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - create
+  - get
+  - list
+  - update
+  - delete
+{{- end }}
+
 {{- define "eg.rbac.infra.tokenreview" -}}
 - apiGroups:
   - authentication.k8s.io
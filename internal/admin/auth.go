@@ -0,0 +1,293 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package admin
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/kubernetes"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/xds/server/kubejwt"
+)
+
+const (
+	// htpasswdKey is the key of the htpasswd file in the users Secret.
+	htpasswdKey = ".htpasswd"
+	// tokenReviewCacheTTL is how long a successful token review is cached, to
+	// avoid calling the TokenReview API on every request of the console.
+	tokenReviewCacheTTL = time.Minute
+	// tokenReviewNegativeCacheTTL is how long a rejected token is cached, so that
+	// retrying an invalid token doesn't call the TokenReview API again.
+	tokenReviewNegativeCacheTTL = 10 * time.Second
+	// tokenReviewRate and tokenReviewBurst limit the calls to the TokenReview API,
+	// so that the requests with random tokens can't flood the API server.
+	tokenReviewRate  = 10
+	tokenReviewBurst = 20
+)
+
+// errTooManyRequests is returned when an authenticator is rate limited.
+var errTooManyRequests = errors.New("too many authentication requests")
+
+// role is the privilege level of an admin user, a higher value grants more privileges.
+type role int
+
+const (
+	roleNone role = iota
+	roleReadOnly
+	roleDebug
+)
+
+func toRole(r egv1a1.EnvoyGatewayAdminRole) role {
+	switch r {
+	case egv1a1.EnvoyGatewayAdminRoleReadOnly:
+		return roleReadOnly
+	case egv1a1.EnvoyGatewayAdminRoleDebug:
+		return roleDebug
+	default:
+		return roleNone
+	}
+}
+
+// user is an authenticated admin user.
+type user struct {
+	name   string
+	groups []string
+}
+
+// authenticator authenticates the requests to the admin server.
+type authenticator interface {
+	// authenticate returns the user of the request, or nil if the request isn't authenticated.
+	authenticate(r *http.Request) (*user, error)
+	// challenge is the value of the WWW-Authenticate header of the unauthenticated responses.
+	challenge() string
+}
+
+// authHandler authenticates and authorizes the requests before passing them to the
+// next handler.
+type authHandler struct {
+	next          http.Handler
+	authenticator authenticator
+	bindings      []egv1a1.EnvoyGatewayAdminRoleBinding
+	logger        logging.Logger
+}
+
+func newAuthHandler(next http.Handler, authn authenticator, bindings []egv1a1.EnvoyGatewayAdminRoleBinding, logger logging.Logger) *authHandler {
+	return &authHandler{
+		next:          next,
+		authenticator: authn,
+		bindings:      bindings,
+		logger:        logger,
+	}
+}
+
+func (h *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	u, err := h.authenticator.authenticate(r)
+	if errors.Is(err, errTooManyRequests) {
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+		return
+	}
+	if err != nil {
+		h.logger.Error(err, "failed to authenticate admin request", "path", r.URL.Path)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if u == nil {
+		w.Header().Set("WWW-Authenticate", h.authenticator.challenge())
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	if h.roleOf(u) < requiredRole(r.URL.Path) {
+		h.logger.Info("admin request forbidden", "user", u.name, "path", r.URL.Path)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	h.next.ServeHTTP(w, r)
+}
+
+// roleOf returns the most privileged role granted to the user.
+func (h *authHandler) roleOf(u *user) role {
+	granted := roleNone
+	for _, binding := range h.bindings {
+		if !slices.Contains(binding.Users, u.name) &&
+			!slices.ContainsFunc(binding.Groups, func(g string) bool { return slices.Contains(u.groups, g) }) {
+			continue
+		}
+		granted = max(granted, toRole(binding.Role))
+	}
+	return granted
+}
+
+// requiredRole returns the role required to access the path.
+// The pprof endpoints and the pprof page of the console require the Debug role,
+// everything else is read-only.
+func requiredRole(path string) role {
+	if strings.HasPrefix(path, "/debug/pprof") || path == "/pprof" {
+		return roleDebug
+	}
+	return roleReadOnly
+}
+
+// tokenReviewAuthenticator authenticates the bearer tokens with the Kubernetes TokenReview API.
+type tokenReviewAuthenticator struct {
+	client    kubernetes.Interface
+	audiences []string
+	limiter   *rate.Limiter
+
+	mu    sync.Mutex
+	cache map[string]cachedUser
+	now   func() time.Time
+}
+
+// cachedUser is the result of a token review, user is nil if the token was rejected.
+type cachedUser struct {
+	user    *user
+	expires time.Time
+}
+
+func newTokenReviewAuthenticator(client kubernetes.Interface, cfg *egv1a1.EnvoyGatewayAdminTokenReview) *tokenReviewAuthenticator {
+	a := &tokenReviewAuthenticator{
+		client:  client,
+		limiter: rate.NewLimiter(tokenReviewRate, tokenReviewBurst),
+		cache:   make(map[string]cachedUser),
+		now:     time.Now,
+	}
+	if cfg != nil {
+		a.audiences = cfg.Audiences
+	}
+	return a
+}
+
+func (a *tokenReviewAuthenticator) authenticate(r *http.Request) (*user, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, nil
+	}
+
+	a.mu.Lock()
+	cached, found := a.cache[token]
+	a.mu.Unlock()
+	if found && a.now().Before(cached.expires) {
+		return cached.user, nil
+	}
+
+	if !a.limiter.Allow() {
+		return nil, errTooManyRequests
+	}
+	status, err := kubejwt.ReviewToken(r.Context(), a.client, token, a.audiences)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call TokenReview API: %w", err)
+	}
+
+	var u *user
+	ttl := tokenReviewNegativeCacheTTL
+	if status.Authenticated && status.Error == "" {
+		u = &user{name: status.User.Username, groups: status.User.Groups}
+		ttl = tokenReviewCacheTTL
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// Drop the expired entries so the cache doesn't grow with the rotated tokens.
+	now := a.now()
+	for k, v := range a.cache {
+		if !now.Before(v.expires) {
+			delete(a.cache, k)
+		}
+	}
+	a.cache[token] = cachedUser{user: u, expires: now.Add(ttl)}
+	return u, nil
+}
+
+func (a *tokenReviewAuthenticator) challenge() string {
+	return `Bearer realm="envoy-gateway-admin"`
+}
+
+// htpasswdAuthenticator authenticates the users with HTTP basic authentication.
+type htpasswdAuthenticator struct {
+	// users maps the user names to the password hashes.
+	users map[string]string
+}
+
+// newHtpasswdAuthenticator parses the htpasswd file. Only the bcrypt password
+// hashes are supported, the unsalted {SHA} and the weaker MD5 hashes are rejected.
+func newHtpasswdAuthenticator(data []byte) (*htpasswdAuthenticator, error) {
+	users := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		name, hash, ok := strings.Cut(entry, ":")
+		if !ok || name == "" || hash == "" {
+			return nil, fmt.Errorf("line %d: invalid htpasswd entry", line)
+		}
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return nil, fmt.Errorf("line %d: unsupported password hash for user %s, only bcrypt is supported", line, name)
+		}
+		users[name] = hash
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("no users found in htpasswd")
+	}
+	return &htpasswdAuthenticator{users: users}, nil
+}
+
+func (a *htpasswdAuthenticator) authenticate(r *http.Request) (*user, error) {
+	name, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, nil
+	}
+	hash, found := a.users[name]
+	if !found || bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+		return nil, nil
+	}
+	return &user{name: name}, nil
+}
+
+func (a *htpasswdAuthenticator) challenge() string {
+	return `Basic realm="envoy-gateway-admin"`
+}
+
+// newAuthenticator creates the authenticator for the auth settings of the admin server.
+func newAuthenticator(ctx context.Context, auth *egv1a1.EnvoyGatewayAdminAuth, client kubernetes.Interface, namespace string) (authenticator, error) {
+	switch auth.Type {
+	case egv1a1.EnvoyGatewayAdminAuthTypeTokenReview:
+		return newTokenReviewAuthenticator(client, auth.TokenReview), nil
+	case egv1a1.EnvoyGatewayAdminAuthTypeHtpasswd:
+		if auth.Htpasswd == nil {
+			return nil, fmt.Errorf("htpasswd is unspecified")
+		}
+		secret, err := getSecret(ctx, client, &auth.Htpasswd.UsersRef, namespace)
+		if err != nil {
+			return nil, err
+		}
+		data, ok := secret.Data[htpasswdKey]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in Secret %s/%s", htpasswdKey, secret.Namespace, secret.Name)
+		}
+		return newHtpasswdAuthenticator(data)
+	default:
+		return nil, fmt.Errorf("unsupported auth type %s", auth.Type)
+	}
+}
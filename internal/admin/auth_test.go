@@ -0,0 +1,289 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+func TestNewHtpasswdAuthenticator(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    string
+		users   []string
+		wantErr string
+	}{
+		{
+			name:  "bcrypt",
+			data:  "# comment\n\nalice:" + string(bcryptHash) + "\nbob:" + string(bcryptHash) + "\n",
+			users: []string{"alice", "bob"},
+		},
+		{
+			name:    "invalid entry",
+			data:    "alice",
+			wantErr: "line 1: invalid htpasswd entry",
+		},
+		{
+			name:    "unsupported hash",
+			data:    "alice:" + string(bcryptHash) + "\nbob:$apr1$salt$hash",
+			wantErr: "line 2: unsupported password hash for user bob",
+		},
+		{
+			name: "sha hash",
+			// "5en6G6MezRroT3XKqkdPOmY/BfQ=" is the SHA1 of "secret".
+			data:    "alice:{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=",
+			wantErr: "line 1: unsupported password hash for user alice, only bcrypt is supported",
+		},
+		{
+			name:    "no users",
+			data:    "# comment only",
+			wantErr: "no users found in htpasswd",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := newHtpasswdAuthenticator([]byte(tc.data))
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, u := range tc.users {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				req.SetBasicAuth(u, "secret")
+				got, err := a.authenticate(req)
+				require.NoError(t, err)
+				require.Equal(t, &user{name: u}, got)
+
+				req.SetBasicAuth(u, "wrong")
+				got, err = a.authenticate(req)
+				require.NoError(t, err)
+				require.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestTokenReviewAuthenticator(t *testing.T) {
+	client := fake.NewClientset()
+	reviews := 0
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case "valid":
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				Audiences:     review.Spec.Audiences,
+				User: authenticationv1.UserInfo{
+					Username: "system:serviceaccount:monitoring:prometheus",
+					Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:monitoring"},
+				},
+			}
+		case "broken":
+			return true, nil, errors.New("api server unavailable")
+		default:
+			review.Status = authenticationv1.TokenReviewStatus{Error: "invalid token"}
+		}
+		return true, review, nil
+	})
+
+	a := newTokenReviewAuthenticator(client, &egv1a1.EnvoyGatewayAdminTokenReview{Audiences: []string{"envoy-gateway"}})
+	now := time.Now()
+	a.now = func() time.Time { return now }
+
+	authenticate := func(token string) (*user, error) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return a.authenticate(req)
+	}
+
+	got, err := authenticate("")
+	require.NoError(t, err)
+	require.Nil(t, got)
+	require.Equal(t, 0, reviews)
+
+	got, err = authenticate("invalid")
+	require.NoError(t, err)
+	require.Nil(t, got)
+	require.Equal(t, 1, reviews)
+
+	// The rejected tokens are cached too, for a shorter time.
+	got, err = authenticate("invalid")
+	require.NoError(t, err)
+	require.Nil(t, got)
+	require.Equal(t, 1, reviews)
+
+	_, err = authenticate("broken")
+	require.ErrorContains(t, err, "api server unavailable")
+
+	want := &user{
+		name:   "system:serviceaccount:monitoring:prometheus",
+		groups: []string{"system:serviceaccounts", "system:serviceaccounts:monitoring"},
+	}
+	got, err = authenticate("valid")
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	require.Equal(t, 3, reviews)
+
+	// The review is cached until the TTL expires.
+	got, err = authenticate("valid")
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	require.Equal(t, 3, reviews)
+
+	now = now.Add(tokenReviewCacheTTL)
+	_, err = authenticate("valid")
+	require.NoError(t, err)
+	require.Equal(t, 4, reviews)
+
+	_, err = authenticate("invalid")
+	require.NoError(t, err)
+	require.Equal(t, 5, reviews)
+	now = now.Add(tokenReviewNegativeCacheTTL)
+	_, err = authenticate("invalid")
+	require.NoError(t, err)
+	require.Equal(t, 6, reviews)
+}
+
+func TestTokenReviewAuthenticatorRateLimit(t *testing.T) {
+	client := fake.NewClientset()
+	reviews := 0
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		review.Status = authenticationv1.TokenReviewStatus{Error: "invalid token"}
+		return true, review, nil
+	})
+	a := newTokenReviewAuthenticator(client, nil)
+	authn := newAuthHandler(http.NotFoundHandler(), a, nil, logging.DefaultLogger(os.Stdout, egv1a1.LogLevelInfo))
+
+	codes := map[int]int{}
+	for i := range tokenReviewBurst + 1 {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer random-"+strconv.Itoa(i))
+		rec := httptest.NewRecorder()
+		authn.ServeHTTP(rec, req)
+		codes[rec.Code]++
+	}
+
+	// Each random token is reviewed until the burst is exhausted.
+	require.Equal(t, tokenReviewBurst, reviews)
+	require.Equal(t, map[int]int{http.StatusUnauthorized: tokenReviewBurst, http.StatusTooManyRequests: 1}, codes)
+}
+
+func TestAuthHandler(t *testing.T) {
+	// The password of all the users is "secret".
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	authn, err := newHtpasswdAuthenticator([]byte(
+		"viewer:" + string(hash) + "\n" +
+			"debugger:" + string(hash) + "\n" +
+			"nobody:" + string(hash) + "\n"))
+	require.NoError(t, err)
+
+	bindings := []egv1a1.EnvoyGatewayAdminRoleBinding{
+		{Role: egv1a1.EnvoyGatewayAdminRoleReadOnly, Users: []string{"viewer", "debugger"}},
+		{Role: egv1a1.EnvoyGatewayAdminRoleDebug, Users: []string{"debugger"}},
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := newAuthHandler(next, authn, bindings, logging.DefaultLogger(os.Stdout, egv1a1.LogLevelInfo))
+
+	tests := []struct {
+		name             string
+		user             string
+		path             string
+		wantStatus       int
+		wantAuthenticate string
+	}{
+		{
+			name:             "unauthenticated",
+			path:             "/api/config_dump",
+			wantStatus:       http.StatusUnauthorized,
+			wantAuthenticate: `Basic realm="envoy-gateway-admin"`,
+		},
+		{
+			name:       "no role",
+			user:       "nobody",
+			path:       "/api/config_dump",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "read-only console",
+			user:       "viewer",
+			path:       "/api/config_dump",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "read-only pprof",
+			user:       "viewer",
+			path:       "/debug/pprof/heap",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "read-only console pprof page",
+			user:       "viewer",
+			path:       "/pprof",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "debug pprof",
+			user:       "debugger",
+			path:       "/debug/pprof/heap",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.user != "" {
+				req.SetBasicAuth(tc.user, "secret")
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.wantStatus, rec.Code)
+			require.Equal(t, tc.wantAuthenticate, rec.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
+
+func TestAuthHandlerGroups(t *testing.T) {
+	handler := &authHandler{
+		bindings: []egv1a1.EnvoyGatewayAdminRoleBinding{
+			{Role: egv1a1.EnvoyGatewayAdminRoleDebug, Groups: []string{"system:serviceaccounts:debug"}},
+			{Role: egv1a1.EnvoyGatewayAdminRoleReadOnly, Groups: []string{"system:serviceaccounts:monitoring"}},
+		},
+	}
+
+	require.Equal(t, roleReadOnly, handler.roleOf(&user{name: "a", groups: []string{"system:serviceaccounts:monitoring"}}))
+	require.Equal(t, roleDebug, handler.roleOf(&user{name: "b", groups: []string{"system:serviceaccounts:monitoring", "system:serviceaccounts:debug"}}))
+	require.Equal(t, roleNone, handler.roleOf(&user{name: "c", groups: []string{"system:serviceaccounts"}}))
+}
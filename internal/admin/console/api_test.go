@@ -36,7 +36,7 @@ func TestHandleAPIInfo(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/info", nil)
 	w := httptest.NewRecorder()
@@ -64,7 +64,7 @@ func TestHandleAPIInfoMethodNotAllowed(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/api/info", nil)
 	w := httptest.NewRecorder()
@@ -80,7 +80,7 @@ func TestHandleAPIServerInfo(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/server_info", nil)
 	w := httptest.NewRecorder()
@@ -126,7 +126,7 @@ func TestHandleAPIConfigDump(t *testing.T) {
 	// Create a mock provider resources
 	providerResources := &message.ProviderResources{}
 
-	handler := NewHandler(cfg, providerResources)
+	handler := NewHandler(cfg, providerResources, nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/config_dump", nil)
 	w := httptest.NewRecorder()
@@ -161,7 +161,7 @@ func TestLoadConfigDumpWithData(t *testing.T) {
 	// Skip storing to avoid watchable copy issues
 	// providerResources.Store("test", providerRes)
 
-	handler := NewHandler(cfg, providerRes)
+	handler := NewHandler(cfg, providerRes, nil, nil, nil)
 
 	configDump := handler.loadConfigDump()
 
@@ -325,7 +325,7 @@ func TestHandleAPIConfigDumpWithResourceAll(t *testing.T) {
 	}
 
 	providerRes := &message.ProviderResources{}
-	handler := NewHandler(cfg, providerRes)
+	handler := NewHandler(cfg, providerRes, nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/config_dump?resource=all", nil)
 	resp := httptest.NewRecorder()
@@ -403,7 +403,7 @@ func TestHandleAPIConfigDumpWithResourceAllRedactsSecrets(t *testing.T) {
 		Context:   context.Background(),
 	})
 
-	handler := NewHandler(cfg, providerRes)
+	handler := NewHandler(cfg, providerRes, nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/config_dump?resource=all", nil)
 	resp := httptest.NewRecorder()
@@ -636,7 +636,7 @@ func TestHandleAPIConfigDumpWithResourceFilter(t *testing.T) {
 		Context:   context.Background(),
 	})
 
-	handler := NewHandler(cfg, providerRes)
+	handler := NewHandler(cfg, providerRes, nil, nil, nil)
 
 	testCases := []struct {
 		name              string
@@ -705,7 +705,7 @@ func TestHandleAPIConfigDumpWithInvalidResourceFilter(t *testing.T) {
 	cfg := &config.Server{
 		Logger: logging.NewLogger(os.Stdout, egv1a1.DefaultEnvoyGatewayLogging()),
 	}
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/config_dump?resource=invalid", nil)
 	resp := httptest.NewRecorder()
@@ -720,7 +720,7 @@ func TestHandleAPIConfigDumpWithResourceAllMethodNotAllowed(t *testing.T) {
 		Logger: logging.NewLogger(os.Stdout, egv1a1.DefaultEnvoyGatewayLogging()),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/api/config_dump?resource=all", nil)
 	resp := httptest.NewRecorder()
@@ -735,7 +735,7 @@ func TestHandleAPIMetrics(t *testing.T) {
 		Logger: logging.NewLogger(os.Stdout, egv1a1.DefaultEnvoyGatewayLogging()),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/metrics", nil)
 	resp := httptest.NewRecorder()
@@ -760,7 +760,7 @@ func TestHandleAPIMetricsMethodNotAllowed(t *testing.T) {
 		Logger: logging.NewLogger(os.Stdout, egv1a1.DefaultEnvoyGatewayLogging()),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/api/metrics", nil)
 	resp := httptest.NewRecorder()
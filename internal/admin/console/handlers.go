@@ -22,14 +22,22 @@ type Handler struct {
 	cfg               *config.Server
 	templates         map[string]*template.Template
 	providerResources *message.ProviderResources
+	xdsIR             *message.XdsIR
+	infraIR           *message.InfraIR
+	xdsStatus         XdsStatusProvider
 }
 
 // NewHandler creates a new console handler
-func NewHandler(cfg *config.Server, providerResources *message.ProviderResources) *Handler {
+func NewHandler(cfg *config.Server, providerResources *message.ProviderResources,
+	xdsIR *message.XdsIR, infraIR *message.InfraIR, xdsStatus XdsStatusProvider,
+) *Handler {
 	return &Handler{
 		cfg:               cfg,
 		templates:         make(map[string]*template.Template),
 		providerResources: providerResources,
+		xdsIR:             xdsIR,
+		infraIR:           infraIR,
+		xdsStatus:         xdsStatus,
 	}
 }
 
@@ -41,12 +49,14 @@ func (h *Handler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/server_info", h.handleServerInfo)
 	mux.HandleFunc("/config_dump", h.handleConfigDump)
 	mux.HandleFunc("/stats", h.handleStats)
+	mux.HandleFunc("/translation", h.handleTranslation)
 
 	// API endpoints
 	mux.HandleFunc("/api/info", h.handleAPIInfo)
 	mux.HandleFunc("/api/server_info", h.handleAPIServerInfo)
 	mux.HandleFunc("/api/config_dump", h.handleAPIConfigDump)
 	mux.HandleFunc("/api/metrics", h.handleAPIMetrics)
+	mux.HandleFunc("/api/translation", h.handleAPITranslation)
 
 	// Static files
 	staticFS, err := fs.Sub(staticFiles, "static")
@@ -200,6 +210,7 @@ func (h *Handler) loadTemplates() error {
 		"config_dump.html": "config-dump-content",
 		"stats.html":       "stats-content",
 		"pprof.html":       "pprof-content",
+		"translation.html": "translation-content",
 	}
 
 	// Create individual templates for each page
@@ -27,7 +27,7 @@ func TestNewHandler(t *testing.T) {
 
 	providerResources := &message.ProviderResources{}
 
-	handler := NewHandler(cfg, providerResources)
+	handler := NewHandler(cfg, providerResources, nil, nil, nil)
 
 	assert.NotNil(t, handler)
 	assert.Equal(t, cfg, handler.cfg)
@@ -40,7 +40,7 @@ func TestHandleIndex(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	w := httptest.NewRecorder()
@@ -58,7 +58,7 @@ func TestHandleIndexMethodNotAllowed(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, nil)
+	handler := NewHandler(cfg, nil, nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/", nil)
 	w := httptest.NewRecorder()
@@ -99,7 +99,7 @@ func TestHandlePprof(t *testing.T) {
 				Logger: logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 			}
 
-			handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+			handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 			req := httptest.NewRequest(http.MethodGet, "/pprof", nil)
 			w := httptest.NewRecorder()
@@ -119,7 +119,7 @@ func TestHandleServerInfo(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/server_info", nil)
 	w := httptest.NewRecorder()
@@ -137,7 +137,7 @@ func TestHandleConfigDump(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/config_dump", nil)
 	w := httptest.NewRecorder()
@@ -155,7 +155,7 @@ func TestHandleStats(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/stats", nil)
 	w := httptest.NewRecorder()
@@ -173,7 +173,7 @@ func TestRegisterRoutes(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 	mux := http.NewServeMux()
 
 	// This should not panic
@@ -191,11 +191,13 @@ func TestRegisterRoutes(t *testing.T) {
 		{"/server_info", http.StatusOK},
 		{"/config_dump", http.StatusOK},
 		{"/stats", http.StatusOK},
+		{"/translation", http.StatusOK},
 		{"/api/info", http.StatusOK},
 		{"/api/server_info", http.StatusOK},
 		{"/api/config_dump", http.StatusOK},
 		{"/api/config_dump?resource=all", http.StatusOK},
 		{"/api/metrics", http.StatusOK}, // Now uses Prometheus registry directly
+		{"/api/translation", http.StatusOK},
 	}
 
 	for _, tc := range testCases {
@@ -11,7 +11,8 @@ window.EnvoyGatewayAdmin = {
     // State
     state: {
         autoRefresh: false,
-        currentPage: null
+        currentPage: null,
+        selectedGateway: null
     },
     
     // Initialize the application
@@ -62,6 +63,8 @@ window.EnvoyGatewayAdmin = {
             this.state.currentPage = 'stats';
         } else if (path === '/pprof') {
             this.state.currentPage = 'pprof';
+        } else if (path === '/translation') {
+            this.state.currentPage = 'translation';
         }
     },
     
@@ -77,6 +80,9 @@ window.EnvoyGatewayAdmin = {
             case 'config_dump':
                 this.loadConfigDump();
                 break;
+            case 'translation':
+                this.loadTranslation();
+                break;
         }
     },
     
@@ -633,6 +639,160 @@ window.EnvoyGatewayAdmin = {
         container.innerHTML = summary;
     },
     
+    // Load translation summary
+    loadTranslation: function() {
+        this.showLoading('translation-summary');
+        this.apiCall('/api/translation', (error, data) => {
+            if (error) {
+                this.showError('translation-summary', 'Failed to load translation summary: ' + error.message);
+                return;
+            }
+            this.updateTranslationSummary(data);
+            if (this.state.selectedGateway) {
+                this.loadTranslationDetail(this.state.selectedGateway);
+            }
+        });
+    },
+
+    // Update translation summary display
+    updateTranslationSummary: function(data) {
+        const container = document.getElementById('translation-summary');
+        if (!container) return;
+
+        if (!data.gateways || data.gateways.length === 0) {
+            container.innerHTML = '<div class="info-box">No translation results available</div>';
+            return;
+        }
+
+        let rowsHtml = '';
+        data.gateways.forEach(gateway => {
+            const acked = gateway.proxies.filter(p => !p.nacked || Object.keys(p.nacked).length === 0).length;
+            const nacked = gateway.proxies.length - acked;
+            const proxyStatus = nacked > 0
+                ? `<span class="status error">${nacked} NACK'd</span>`
+                : `<span class="status running">${acked} connected</span>`;
+            rowsHtml += `
+                <tr>
+                    <td>${this.escapeHTML(gateway.name)}</td>
+                    <td>${gateway.hasXdsIR ? '✅' : '—'}</td>
+                    <td>${gateway.hasInfraIR ? '✅' : '—'}</td>
+                    <td>${gateway.httpListeners} / ${gateway.tcpListeners} / ${gateway.udpListeners}</td>
+                    <td>${gateway.routes}</td>
+                    <td><code>${this.escapeHTML(gateway.snapshotVersion || 'N/A')}</code></td>
+                    <td>${gateway.proxies.length > 0 ? proxyStatus : '—'}</td>
+                    <td>
+                        <button class="btn btn-secondary" onclick="EnvoyGatewayAdmin.loadTranslationDetail(this.dataset.gateway)" data-gateway="${this.escapeHTML(gateway.name)}">
+                            View
+                        </button>
+                    </td>
+                </tr>
+            `;
+        });
+
+        container.innerHTML = `
+            <table class="table">
+                <thead>
+                    <tr>
+                        <th>Name</th>
+                        <th>xDS IR</th>
+                        <th>Infra IR</th>
+                        <th>Listeners (HTTP / TCP / UDP)</th>
+                        <th>Routes</th>
+                        <th>Snapshot Version</th>
+                        <th>Proxies</th>
+                        <th></th>
+                    </tr>
+                </thead>
+                <tbody>
+                    ${rowsHtml}
+                </tbody>
+            </table>
+            <small>Last updated: ${new Date(data.lastUpdated).toLocaleString()}</small>
+        `;
+    },
+
+    // Load translation detail of a Gateway
+    loadTranslationDetail: function(name) {
+        this.state.selectedGateway = name;
+        this.showLoading('translation-detail');
+        this.apiCall('/api/translation?gateway=' + encodeURIComponent(name), (error, data) => {
+            if (error) {
+                this.showError('translation-detail', 'Failed to load translation detail: ' + error.message);
+                return;
+            }
+            this.updateTranslationDetail(data);
+        });
+    },
+
+    // Update translation detail display
+    updateTranslationDetail: function(data) {
+        const container = document.getElementById('translation-detail');
+        if (!container) return;
+
+        let proxiesHtml = '';
+        data.proxies.forEach(proxy => {
+            const types = new Set([...Object.keys(proxy.acked || {}), ...Object.keys(proxy.nacked || {})]);
+            let typesHtml = '';
+            types.forEach(typeURL => {
+                const shortType = typeURL.split('.').pop();
+                if (proxy.nacked && proxy.nacked[typeURL]) {
+                    typesHtml += `<span class="status error" title="${this.escapeHTML(proxy.nacked[typeURL].message)}">${shortType}: NACK ${this.escapeHTML(proxy.nacked[typeURL].version || "")}</span> `;
+                } else {
+                    typesHtml += `<span class="status running">${shortType}: ${this.escapeHTML(proxy.acked[typeURL])}</span> `;
+                }
+            });
+            proxiesHtml += `
+                <tr>
+                    <td>${this.escapeHTML(proxy.nodeID)}</td>
+                    <td>${proxy.streams}</td>
+                    <td><code>${this.escapeHTML(proxy.snapshotVersion || 'N/A')}</code></td>
+                    <td>${typesHtml || '—'}</td>
+                </tr>
+            `;
+        });
+
+        container.innerHTML = `
+            <div class="info-box">
+                <div>
+                    <strong>Name:</strong> ${this.escapeHTML(data.name)}<br>
+                    <strong>Snapshot Version:</strong> <code>${this.escapeHTML(data.snapshotVersion || 'N/A')}</code>
+                </div>
+                <div>
+                    <a href="/api/translation?gateway=${encodeURIComponent(data.name)}" class="btn btn-secondary" target="_blank">View JSON</a>
+                </div>
+            </div>
+            <h3>Proxies</h3>
+            ${data.proxies.length === 0 ? '<p>No proxies are connected.</p>' : `
+            <table class="table">
+                <thead>
+                    <tr>
+                        <th>Node ID</th>
+                        <th>Streams</th>
+                        <th>Snapshot Version</th>
+                        <th>ACK Status</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    ${proxiesHtml}
+                </tbody>
+            </table>`}
+            <h3>xDS IR</h3>
+            <div class="json-code">${data.xdsIR ? this.formatJSON(data.xdsIR) : 'N/A'}</div>
+            <h3>Infra IR</h3>
+            <div class="json-code">${data.infraIR ? this.formatJSON(data.infraIR) : 'N/A'}</div>
+        `;
+    },
+
+    // Escape HTML special characters
+    escapeHTML: function(value) {
+        return String(value)
+            .replace(/&/g, '&amp;')
+            .replace(/</g, '&lt;')
+            .replace(/>/g, '&gt;')
+            .replace(/"/g, '&quot;')
+            .replace(/'/g, '&#39;');
+    },
+
     // Show loading indicator
     showLoading: function(containerId) {
         const container = document.getElementById(containerId);
@@ -24,7 +24,7 @@ func TestStaticFiles(t *testing.T) {
 		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
 	}
 
-	handler := NewHandler(cfg, (*message.ProviderResources)(nil))
+	handler := NewHandler(cfg, (*message.ProviderResources)(nil), nil, nil, nil)
 	mux := http.NewServeMux()
 	handler.RegisterRoutes(mux)
 
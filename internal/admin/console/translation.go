@@ -0,0 +1,217 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package console
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/xds/cache"
+)
+
+// XdsStatusProvider provides the xDS snapshot versions and the status of the
+// proxies connected to the xDS server.
+type XdsStatusProvider interface {
+	// GetSnapshotVersions returns the version of the last snapshot of each IR key.
+	GetSnapshotVersions() map[string]string
+	// GetNodeStatuses returns the status of the connected proxies.
+	GetNodeStatuses() []cache.NodeStatus
+}
+
+// TranslationInfo represents the translation summary of all the Gateways
+type TranslationInfo struct {
+	Gateways    []TranslationSummary `json:"gateways"`
+	LastUpdated time.Time            `json:"lastUpdated"`
+}
+
+// TranslationSummary represents the translation summary of a GatewayClass or a Gateway,
+// depending on whether the Gateways are merged.
+type TranslationSummary struct {
+	// Name is the IR key, the GatewayClass name if the Gateways are merged,
+	// otherwise the Gateway namespace/name.
+	Name            string             `json:"name"`
+	HasXdsIR        bool               `json:"hasXdsIR"`
+	HasInfraIR      bool               `json:"hasInfraIR"`
+	HTTPListeners   int                `json:"httpListeners"`
+	TCPListeners    int                `json:"tcpListeners"`
+	UDPListeners    int                `json:"udpListeners"`
+	Routes          int                `json:"routes"`
+	SnapshotVersion string             `json:"snapshotVersion,omitempty"`
+	Proxies         []cache.NodeStatus `json:"proxies"`
+}
+
+// TranslationDetail represents the translated IR and the xDS status of a GatewayClass or a Gateway
+type TranslationDetail struct {
+	Name            string             `json:"name"`
+	XdsIR           *ir.Xds            `json:"xdsIR,omitempty"`
+	InfraIR         *ir.Infra          `json:"infraIR,omitempty"`
+	SnapshotVersion string             `json:"snapshotVersion,omitempty"`
+	Proxies         []cache.NodeStatus `json:"proxies"`
+	LastUpdated     time.Time          `json:"lastUpdated"`
+}
+
+// handleTranslation serves the translation page
+func (h *Handler) handleTranslation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data := struct {
+		Title string
+	}{
+		Title: "Translation",
+	}
+
+	if err := h.renderTemplate(w, "translation.html", data); err != nil {
+		// Content-Type is already set by renderTemplate
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("Internal server error"))
+		return
+	}
+}
+
+// handleAPITranslation returns the translation summary of all the Gateways, or the
+// translation detail of the one specified with the gateway query parameter.
+func (h *Handler) handleAPITranslation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var response any
+	if name := r.URL.Query().Get("gateway"); name != "" {
+		detail := h.loadTranslationDetail(name)
+		if detail == nil {
+			http.Error(w, "Gateway not found: "+name, http.StatusNotFound)
+			return
+		}
+		response = detail
+	} else {
+		response = h.loadTranslationInfo()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// loadTranslationInfo loads the translation summary of all the IR keys known to
+// the xDS IR, the infra IR or the xDS cache.
+func (h *Handler) loadTranslationInfo() TranslationInfo {
+	summaries := make(map[string]*TranslationSummary)
+	summary := func(name string) *TranslationSummary {
+		if s, ok := summaries[name]; ok {
+			return s
+		}
+		s := &TranslationSummary{Name: name, Proxies: []cache.NodeStatus{}}
+		summaries[name] = s
+		return s
+	}
+
+	if h.xdsIR != nil {
+		for name, x := range h.xdsIR.LoadAll() {
+			s := summary(name)
+			if x == nil || x.XdsIR == nil {
+				continue
+			}
+			s.HasXdsIR = true
+			s.HTTPListeners = len(x.XdsIR.HTTP)
+			s.TCPListeners = len(x.XdsIR.TCP)
+			s.UDPListeners = len(x.XdsIR.UDP)
+			for _, l := range x.XdsIR.HTTP {
+				s.Routes += len(l.Routes)
+			}
+			for _, l := range x.XdsIR.TCP {
+				s.Routes += len(l.Routes)
+			}
+			for _, l := range x.XdsIR.UDP {
+				if l.Route != nil {
+					s.Routes++
+				}
+			}
+		}
+	}
+
+	if h.infraIR != nil {
+		for name, infra := range h.infraIR.LoadAll() {
+			summary(name).HasInfraIR = infra != nil
+		}
+	}
+
+	if h.xdsStatus != nil {
+		for name, version := range h.xdsStatus.GetSnapshotVersions() {
+			summary(name).SnapshotVersion = version
+		}
+		for _, node := range h.xdsStatus.GetNodeStatuses() {
+			s := summary(node.IRKey)
+			s.Proxies = append(s.Proxies, node)
+		}
+	}
+
+	info := TranslationInfo{
+		Gateways:    make([]TranslationSummary, 0, len(summaries)),
+		LastUpdated: time.Now(),
+	}
+	for _, s := range summaries {
+		info.Gateways = append(info.Gateways, *s)
+	}
+	sort.Slice(info.Gateways, func(i, j int) bool {
+		return info.Gateways[i].Name < info.Gateways[j].Name
+	})
+
+	return info
+}
+
+// loadTranslationDetail loads the translation detail of the IR key, it returns nil
+// if the key is unknown.
+func (h *Handler) loadTranslationDetail(name string) *TranslationDetail {
+	detail := &TranslationDetail{
+		Name:        name,
+		Proxies:     []cache.NodeStatus{},
+		LastUpdated: time.Now(),
+	}
+	found := false
+
+	if h.xdsIR != nil {
+		if x, ok := h.xdsIR.Load(name); ok {
+			found = true
+			if x != nil {
+				detail.XdsIR = x.XdsIR
+			}
+		}
+	}
+
+	if h.infraIR != nil {
+		if infra, ok := h.infraIR.Load(name); ok {
+			found = true
+			detail.InfraIR = infra
+		}
+	}
+
+	if h.xdsStatus != nil {
+		if version, ok := h.xdsStatus.GetSnapshotVersions()[name]; ok {
+			found = true
+			detail.SnapshotVersion = version
+		}
+		for _, node := range h.xdsStatus.GetNodeStatuses() {
+			if node.IRKey == name {
+				found = true
+				detail.Proxies = append(detail.Proxies, node)
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+	return detail
+}
@@ -0,0 +1,167 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/xds/cache"
+)
+
+type fakeXdsStatus struct {
+	versions map[string]string
+	nodes    []cache.NodeStatus
+}
+
+func (f *fakeXdsStatus) GetSnapshotVersions() map[string]string { return f.versions }
+
+func (f *fakeXdsStatus) GetNodeStatuses() []cache.NodeStatus { return f.nodes }
+
+func newTranslationTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	cfg := &config.Server{
+		EnvoyGateway: egv1a1.DefaultEnvoyGateway(),
+		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
+	}
+
+	xdsIR := new(message.XdsIR)
+	xdsIR.Store("envoy-gateway/gateway-1", &message.XdsIRWithContext{
+		XdsIR: &ir.Xds{
+			HTTP: []*ir.HTTPListener{{
+				CoreListenerDetails: ir.CoreListenerDetails{Name: "envoy-gateway/gateway-1/http", Port: 10080},
+				Routes: []*ir.HTTPRoute{
+					{Name: "httproute/default/backend/rule/0/match/0"},
+					{Name: "httproute/default/backend/rule/1/match/0"},
+				},
+			}},
+			TCP: []*ir.TCPListener{{
+				CoreListenerDetails: ir.CoreListenerDetails{Name: "envoy-gateway/gateway-1/tcp", Port: 10090},
+			}},
+		},
+		Context: context.Background(),
+	})
+	infraIR := new(message.InfraIR)
+	infraIR.Store("envoy-gateway/gateway-1", &ir.Infra{
+		Proxy: &ir.ProxyInfra{Name: "envoy-gateway/gateway-1"},
+	})
+	t.Cleanup(func() {
+		xdsIR.Close()
+		infraIR.Close()
+	})
+
+	xdsStatus := &fakeXdsStatus{
+		versions: map[string]string{"envoy-gateway/gateway-1": "3"},
+		nodes: []cache.NodeStatus{
+			{
+				NodeID:          "envoy-gateway-1-abc",
+				IRKey:           "envoy-gateway/gateway-1",
+				Streams:         1,
+				SnapshotVersion: "3",
+				ACKed:           map[string]string{"type.googleapis.com/envoy.config.listener.v3.Listener": "3"},
+			},
+			{
+				NodeID:  "envoy-stale-xyz",
+				IRKey:   "envoy-gateway/stale",
+				Streams: 1,
+			},
+		},
+	}
+
+	return NewHandler(cfg, nil, xdsIR, infraIR, xdsStatus)
+}
+
+func TestHandleAPITranslation(t *testing.T) {
+	handler := newTranslationTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/translation", nil)
+	w := httptest.NewRecorder()
+	handler.handleAPITranslation(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var info TranslationInfo
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+	require.Len(t, info.Gateways, 2)
+
+	gateway := info.Gateways[0]
+	assert.Equal(t, "envoy-gateway/gateway-1", gateway.Name)
+	assert.True(t, gateway.HasXdsIR)
+	assert.True(t, gateway.HasInfraIR)
+	assert.Equal(t, 1, gateway.HTTPListeners)
+	assert.Equal(t, 1, gateway.TCPListeners)
+	assert.Equal(t, 2, gateway.Routes)
+	assert.Equal(t, "3", gateway.SnapshotVersion)
+	require.Len(t, gateway.Proxies, 1)
+	assert.Equal(t, "envoy-gateway-1-abc", gateway.Proxies[0].NodeID)
+
+	// A proxy of an IR that no longer exists is still listed.
+	stale := info.Gateways[1]
+	assert.Equal(t, "envoy-gateway/stale", stale.Name)
+	assert.False(t, stale.HasXdsIR)
+	require.Len(t, stale.Proxies, 1)
+}
+
+func TestHandleAPITranslationDetail(t *testing.T) {
+	handler := newTranslationTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/translation?gateway=envoy-gateway/gateway-1", nil)
+	w := httptest.NewRecorder()
+	handler.handleAPITranslation(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var detail TranslationDetail
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &detail))
+	assert.Equal(t, "envoy-gateway/gateway-1", detail.Name)
+	require.NotNil(t, detail.XdsIR)
+	require.Len(t, detail.XdsIR.HTTP, 1)
+	assert.Equal(t, "envoy-gateway/gateway-1/http", detail.XdsIR.HTTP[0].Name)
+	require.NotNil(t, detail.InfraIR)
+	assert.Equal(t, "envoy-gateway/gateway-1", detail.InfraIR.Proxy.Name)
+	assert.Equal(t, "3", detail.SnapshotVersion)
+	require.Len(t, detail.Proxies, 1)
+	assert.Equal(t, "3", detail.Proxies[0].ACKed["type.googleapis.com/envoy.config.listener.v3.Listener"])
+}
+
+func TestHandleAPITranslationNotFound(t *testing.T) {
+	handler := newTranslationTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/translation?gateway=unknown", nil)
+	w := httptest.NewRecorder()
+	handler.handleAPITranslation(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandleAPITranslationWithoutSources(t *testing.T) {
+	cfg := &config.Server{
+		EnvoyGateway: egv1a1.DefaultEnvoyGateway(),
+		Logger:       logging.DefaultLogger(nil, egv1a1.LogLevelInfo),
+	}
+	handler := NewHandler(cfg, nil, nil, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/translation", nil)
+	w := httptest.NewRecorder()
+	handler.handleAPITranslation(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var info TranslationInfo
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+	assert.Empty(t, info.Gateways)
+}
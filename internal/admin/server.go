@@ -7,20 +7,43 @@ package admin
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"sync/atomic"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/admin/console"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/xds/server/kubejwt"
 )
 
+// caCertKey is the key of the CA certificates in the client validation Secret.
+const caCertKey = "ca.crt"
+
+// tlsReloadInterval is how often the TLS Secrets of the admin server are read again,
+// so that a rotated certificate is served without restarting Envoy Gateway.
+var tlsReloadInterval = time.Minute
+
 type Config struct {
 	Server            config.Server
 	ProviderResources *message.ProviderResources
+	XdsIR             *message.XdsIR
+	InfraIR           *message.InfraIR
+	XdsStatus         console.XdsStatusProvider
 	RunnerErrors      *message.RunnerErrors
 }
 
@@ -28,23 +51,32 @@ type Runner struct {
 	cfg               *config.Server
 	server            *http.Server
 	providerResources *message.ProviderResources
+	xdsIR             *message.XdsIR
+	infraIR           *message.InfraIR
+	xdsStatus         console.XdsStatusProvider
+	// client is used to read the Secrets and review the tokens if TLS or
+	// authentication is enabled. It's created from the in-cluster config if nil.
+	client kubernetes.Interface
 }
 
 func New(cfg *Config) *Runner {
 	return &Runner{
 		cfg:               &cfg.Server,
 		providerResources: cfg.ProviderResources,
+		xdsIR:             cfg.XdsIR,
+		infraIR:           cfg.InfraIR,
+		xdsStatus:         cfg.XdsStatus,
 	}
 }
 
-func (r *Runner) Start(_ context.Context) error {
+func (r *Runner) Start(ctx context.Context) error {
 	if r.cfg.EnvoyGateway.GetEnvoyGatewayAdmin().EnableDumpConfig {
 		spewConfig := spew.NewDefaultConfig()
 		spewConfig.DisableMethods = true
 		spewConfig.Dump(r.cfg)
 	}
 
-	return r.start()
+	return r.start(ctx)
 }
 
 func (r *Runner) Name() string {
@@ -60,17 +92,18 @@ func (r *Runner) Close() error {
 	return nil
 }
 
-func (r *Runner) start() error {
+func (r *Runner) start(ctx context.Context) error {
 	handlers := http.NewServeMux()
 	address := r.cfg.EnvoyGateway.GetEnvoyGatewayAdminAddress()
 	adminConfig := r.cfg.EnvoyGateway.GetEnvoyGatewayAdmin()
 	enablePprof := adminConfig.EnablePprof
 
 	adminLogger := r.cfg.Logger.WithName("admin")
-	adminLogger.Info("starting admin server", "address", address, "enablePprof", enablePprof, "enableConsole", true)
+	adminLogger.Info("starting admin server", "address", address, "enablePprof", enablePprof, "enableConsole", true,
+		"enableTLS", adminConfig.TLS != nil, "enableAuth", adminConfig.Auth != nil)
 
 	// Register console handlers (always enabled)
-	consoleHandler := console.NewHandler(r.cfg, r.providerResources)
+	consoleHandler := console.NewHandler(r.cfg, r.providerResources, r.xdsIR, r.infraIR, r.xdsStatus)
 	consoleHandler.RegisterRoutes(handlers)
 
 	if enablePprof {
@@ -82,9 +115,40 @@ func (r *Runner) start() error {
 		handlers.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
 	}
 
+	var handler http.Handler = handlers
+	var tlsConfig *tls.Config
+	if adminConfig.TLS != nil || adminConfig.Auth != nil {
+		if r.cfg.EnvoyGateway.Provider == nil || !r.cfg.EnvoyGateway.Provider.IsRunningOnKubernetes() {
+			return errors.New("admin server TLS and authentication are only supported with the Kubernetes provider")
+		}
+		if r.client == nil {
+			client, err := kubejwt.GetKubernetesClient()
+			if err != nil {
+				return err
+			}
+			r.client = client
+		}
+
+		if adminConfig.TLS != nil {
+			var err error
+			if tlsConfig, err = r.buildTLSConfig(ctx, adminConfig.TLS, adminLogger); err != nil {
+				return fmt.Errorf("failed to configure admin server TLS: %w", err)
+			}
+		}
+
+		if adminConfig.Auth != nil {
+			authn, err := newAuthenticator(ctx, adminConfig.Auth, r.client, r.cfg.ControllerNamespace)
+			if err != nil {
+				return fmt.Errorf("failed to configure admin server authentication: %w", err)
+			}
+			handler = newAuthHandler(handlers, authn, adminConfig.Auth.RoleBindings, adminLogger)
+		}
+	}
+
 	r.server = &http.Server{
-		Handler:           handlers,
+		Handler:           handler,
 		Addr:              address,
+		TLSConfig:         tlsConfig,
 		ReadTimeout:       5 * time.Second,
 		ReadHeaderTimeout: 5 * time.Second,
 		WriteTimeout:      10 * time.Second,
@@ -93,10 +157,108 @@ func (r *Runner) start() error {
 
 	// Listen And Serve Admin Server.
 	go func() {
-		if err := r.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if tlsConfig != nil {
+			// The certificate is already loaded in the TLS config.
+			err = r.server.ListenAndServeTLS("", "")
+		} else {
+			err = r.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			adminLogger.Error(err, "start admin server failed")
 		}
 	}()
 
 	return nil
 }
+
+// buildTLSConfig returns the TLS config of the admin server. The server certificate
+// and the client CA certificates are loaded from the referenced Secrets, and reloaded
+// every tlsReloadInterval until the context is done. If a reload fails, the previous
+// certificates are kept.
+func (r *Runner) buildTLSConfig(ctx context.Context, cfg *egv1a1.EnvoyGatewayAdminTLS, logger logging.Logger) (*tls.Config, error) {
+	var current atomic.Pointer[tls.Config]
+	tlsConfig, err := r.loadTLSConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	current.Store(tlsConfig)
+
+	ticker := time.NewTicker(tlsReloadInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			tlsConfig, err := r.loadTLSConfig(ctx, cfg)
+			if err != nil {
+				logger.Error(err, "failed to reload admin server TLS, keeping the previous certificates")
+				continue
+			}
+			current.Store(tlsConfig)
+		}
+	}()
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return current.Load(), nil
+		},
+	}, nil
+}
+
+// loadTLSConfig loads the server certificate and the client CA certificates from
+// the referenced Secrets.
+func (r *Runner) loadTLSConfig(ctx context.Context, cfg *egv1a1.EnvoyGatewayAdminTLS) (*tls.Config, error) {
+	secret, err := getSecret(ctx, r.client, &cfg.CertificateRef, r.cfg.ControllerNamespace)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate in Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cv := cfg.ClientValidation; cv != nil {
+		caSecret, err := getSecret(ctx, r.client, &cv.CACertificateRef, r.cfg.ControllerNamespace)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caSecret.Data[caCertKey]) {
+			return nil, fmt.Errorf("no valid CA certificate found in key %s of Secret %s/%s", caCertKey, caSecret.Namespace, caSecret.Name)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		if cv.Optional {
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// getSecret gets the referenced Secret, the namespace is used if the reference
+// doesn't specify one.
+func getSecret(ctx context.Context, client kubernetes.Interface, ref *gwapiv1.SecretObjectReference, namespace string) (*corev1.Secret, error) {
+	if (ref.Group != nil && *ref.Group != corev1.GroupName) ||
+		(ref.Kind != nil && *ref.Kind != resource.KindSecret) {
+		return nil, errors.New("unsupported secret reference group/kind")
+	}
+	if ref.Namespace != nil && *ref.Namespace != "" {
+		namespace = string(*ref.Namespace)
+	}
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, string(ref.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get Secret %s in namespace %s: %w", ref.Name, namespace, err)
+	}
+	return secret, nil
+}
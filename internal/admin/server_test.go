@@ -6,13 +6,26 @@
 package admin
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/crypto"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/logging"
 )
@@ -35,3 +48,160 @@ func TestInitAdminServer(t *testing.T) {
 	err = runner.Close()
 	require.NoError(t, err)
 }
+
+func TestAdminServerTLSAndAuth(t *testing.T) {
+	svrConfig, err := config.New(os.Stdout, os.Stderr)
+	require.NoError(t, err)
+	certs, err := crypto.GenerateCerts(svrConfig)
+	require.NoError(t, err)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	svrConfig.EnvoyGateway.Admin = &egv1a1.EnvoyGatewayAdmin{
+		Address: &egv1a1.EnvoyGatewayAdminAddress{Host: "127.0.0.1", Port: port},
+		TLS: &egv1a1.EnvoyGatewayAdminTLS{
+			CertificateRef: gwapiv1.SecretObjectReference{Name: "admin-tls"},
+			ClientValidation: &egv1a1.EnvoyGatewayAdminClientValidation{
+				CACertificateRef: gwapiv1.SecretObjectReference{Name: "admin-ca"},
+			},
+		},
+		Auth: &egv1a1.EnvoyGatewayAdminAuth{
+			Type: egv1a1.EnvoyGatewayAdminAuthTypeHtpasswd,
+			Htpasswd: &egv1a1.EnvoyGatewayAdminHtpasswd{
+				UsersRef: gwapiv1.SecretObjectReference{Name: "admin-users"},
+			},
+			RoleBindings: []egv1a1.EnvoyGatewayAdminRoleBinding{
+				{Role: egv1a1.EnvoyGatewayAdminRoleReadOnly, Users: []string{"viewer"}},
+			},
+		},
+	}
+
+	// The password of viewer is "secret".
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	interval := tlsReloadInterval
+	tlsReloadInterval = 100 * time.Millisecond
+	t.Cleanup(func() { tlsReloadInterval = interval })
+
+	ns := svrConfig.ControllerNamespace
+	runner := New(&Config{Server: *svrConfig})
+	runner.client = fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin-tls", Namespace: ns},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       certs.EnvoyGatewayCertificate,
+				corev1.TLSPrivateKeyKey: certs.EnvoyGatewayPrivateKey,
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin-ca", Namespace: ns},
+			Data:       map[string][]byte{caCertKey: certs.CACertificate},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin-users", Namespace: ns},
+			Data:       map[string][]byte{htpasswdKey: []byte("viewer:" + string(hash) + "\n")},
+		},
+	)
+	require.NoError(t, runner.Start(t.Context()))
+	t.Cleanup(func() { require.NoError(t, runner.Close()) })
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(certs.CACertificate))
+	clientCert, err := tls.X509KeyPair(certs.EnvoyCertificate, certs.EnvoyPrivateKey)
+	require.NoError(t, err)
+
+	get := func(clientCerts []tls.Certificate, path string, basicAuth bool) (int, error) {
+		client := &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs:      roots,
+					Certificates: clientCerts,
+					ServerName:   "envoy-gateway",
+					MinVersion:   tls.VersionTLS12,
+				},
+			},
+		}
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://127.0.0.1:%d%s", port, path), nil)
+		if err != nil {
+			return 0, err
+		}
+		if basicAuth {
+			req.SetBasicAuth("viewer", "secret")
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode, nil
+	}
+
+	require.Eventually(t, func() bool {
+		code, err := get([]tls.Certificate{clientCert}, "/api/info", true)
+		return err == nil && code == http.StatusOK
+	}, 5*time.Second, 100*time.Millisecond)
+
+	code, err := get([]tls.Certificate{clientCert}, "/api/info", false)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	code, err = get([]tls.Certificate{clientCert}, "/pprof", true)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, code)
+
+	// The client certificate is required.
+	_, err = get(nil, "/api/info", true)
+	require.Error(t, err)
+
+	// A rotated certificate is served without restarting the server.
+	rotated, err := crypto.GenerateCerts(svrConfig)
+	require.NoError(t, err)
+	_, err = runner.client.CoreV1().Secrets(ns).Update(t.Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin-tls", Namespace: ns},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       rotated.EnvoyGatewayCertificate,
+			corev1.TLSPrivateKeyKey: rotated.EnvoyGatewayPrivateKey,
+		},
+	}, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	rotatedCert, err := tls.X509KeyPair(rotated.EnvoyGatewayCertificate, rotated.EnvoyGatewayPrivateKey)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		conn, err := tls.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port), &tls.Config{
+			Certificates:       []tls.Certificate{clientCert},
+			InsecureSkipVerify: true, //nolint:gosec // only the served certificate is checked
+			MinVersion:         tls.VersionTLS12,
+		})
+		if err != nil {
+			return false
+		}
+		defer conn.Close()
+		peer := conn.ConnectionState().PeerCertificates
+		return len(peer) > 0 && bytes.Equal(peer[0].Raw, rotatedCert.Certificate[0])
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestAdminServerTLSRequiresKubernetes(t *testing.T) {
+	svrConfig := &config.Server{
+		EnvoyGateway: &egv1a1.EnvoyGateway{
+			EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+				Provider: &egv1a1.EnvoyGatewayProvider{Type: egv1a1.ProviderTypeCustom},
+				Admin: &egv1a1.EnvoyGatewayAdmin{
+					TLS: &egv1a1.EnvoyGatewayAdminTLS{
+						CertificateRef: gwapiv1.SecretObjectReference{Name: "admin-tls"},
+					},
+				},
+			},
+		},
+	}
+	svrConfig.Logger = logging.NewLogger(os.Stdout, egv1a1.DefaultEnvoyGatewayLogging())
+
+	runner := New(&Config{Server: *svrConfig})
+	err := runner.Start(context.Background())
+	require.ErrorContains(t, err, "only supported with the Kubernetes provider")
+}
@@ -175,7 +175,7 @@ func checkEnableGlobalRateLimit(cli kubernetes.CLIClient) (bool, error) {
 		return false, err
 	}
 
-	if eg.RateLimit == nil || eg.RateLimit.Backend.Redis == nil {
+	if eg.RateLimit == nil {
 		return false, nil
 	}
 
@@ -0,0 +1,600 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	"github.com/envoyproxy/gateway/internal/ir"
+	xds_types "github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const textOutput = "text"
+
+const (
+	diffChangeAdded    = "Added"
+	diffChangeRemoved  = "Removed"
+	diffChangeModified = "Modified"
+)
+
+const (
+	diffKindListener    = "listener"
+	diffKindRoute       = "route"
+	diffKindVirtualHost = "virtualHost"
+	diffKindCluster     = "cluster"
+	diffKindEndpoint    = "endpoint"
+	diffKindSecret      = "secret"
+)
+
+// DiffResult is the semantic diff between the translations of two revisions
+// of Gateway API resources.
+type DiffResult struct {
+	Gateways []GatewayDiff `json:"gateways,omitempty"`
+}
+
+// GatewayDiff holds the changes of the IR and xDS of a Gateway.
+type GatewayDiff struct {
+	Gateway string         `json:"gateway"`
+	IR      []ResourceDiff `json:"ir,omitempty"`
+	Xds     []ResourceDiff `json:"xds,omitempty"`
+}
+
+// ResourceDiff is a resource that was added, removed or modified. Resources
+// are matched by kind and name. A route can be attached to several listeners,
+// so the name of a route is prefixed with the name of its listener in the IR,
+// and of its route configuration in xDS.
+type ResourceDiff struct {
+	Kind   string      `json:"kind"`
+	Name   string      `json:"name"`
+	Change string      `json:"change"`
+	Fields []FieldDiff `json:"fields,omitempty"`
+}
+
+// FieldDiff is a field of a resource that was added, removed or modified.
+// The values of secrets are never reported.
+type FieldDiff struct {
+	Path   string `json:"path"`
+	Change string `json:"change"`
+	Old    any    `json:"old,omitempty"`
+	New    any    `json:"new,omitempty"`
+}
+
+func newDiffCommand() *cobra.Command {
+	var (
+		fromFile, toFile, output, namespace, dnsDomain string
+		addMissingResources                            bool
+	)
+
+	diffCommand := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare the translations of two revisions of Gateway API resources",
+		Long: `Translate two revisions of Gateway API resources into IR and xDS, and print the semantic diff.
+Listeners, routes, clusters and secrets are matched by name, and the diff reports the fields of
+each resource that were added, removed or modified.`,
+		Example: `  # Compare the IR and xDS of two revisions of Gateway API resources.
+  egctl experimental diff --from-file <old input file> --to-file <new input file>
+
+  # Compare the revisions in a human readable format, with short syntax.
+  egctl x diff --from-file <old input file> --to-file <new input file> -o text
+
+  # Compare the revisions in JSON output, with dummy resources added.
+  egctl x diff --from-file <old input file> --to-file <new input file> --add-missing-resources -o json
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runDiff(cmd.OutOrStdout(), fromFile, toFile, output, addMissingResources, namespace, dnsDomain)
+		},
+	}
+
+	diffCommand.PersistentFlags().StringVarP(&fromFile, "from-file", "", "", "Location of the input file of the old revision.")
+	if err := diffCommand.MarkPersistentFlagRequired("from-file"); err != nil {
+		return nil
+	}
+	diffCommand.PersistentFlags().StringVarP(&toFile, "to-file", "", "", "Location of the input file of the new revision.")
+	if err := diffCommand.MarkPersistentFlagRequired("to-file"); err != nil {
+		return nil
+	}
+	diffCommand.PersistentFlags().StringVarP(&output, "output", "o", yamlOutput, "One of 'yaml', 'json' or 'text'")
+	diffCommand.PersistentFlags().BoolVarP(&addMissingResources, "add-missing-resources", "", false, "Provides dummy resources if missed")
+	diffCommand.PersistentFlags().StringVarP(&dnsDomain, "dns-domain", "", "cluster.local", "DNS domain used by k8s services, default is cluster.local")
+	diffCommand.PersistentFlags().StringVarP(&namespace, "namespace", "n", "envoy-gateway-system", "Namespace where envoy gateway is installed.")
+
+	return diffCommand
+}
+
+func runDiff(w io.Writer, fromFile, toFile, output string, addMissingResources bool, namespace, dnsDomain string) error {
+	if fromFile == "-" && toFile == "-" {
+		return fmt.Errorf("only one of --from-file and --to-file can be read from stdin")
+	}
+
+	from, err := loadDiffRevision(fromFile, addMissingResources, namespace, dnsDomain)
+	if err != nil {
+		return fmt.Errorf("failed to translate %s: %w", fromFile, err)
+	}
+	to, err := loadDiffRevision(toFile, addMissingResources, namespace, dnsDomain)
+	if err != nil {
+		return fmt.Errorf("failed to translate %s: %w", toFile, err)
+	}
+
+	result := diffRevisions(from, to)
+
+	var out []byte
+	switch output {
+	case textOutput:
+		out = []byte(result.String())
+	case jsonOutput:
+		out, err = json.MarshalIndent(result, "", "  ")
+	default:
+		out, err = yaml.Marshal(result)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// diffRevision holds the IR and xDS resources of each Gateway of a revision,
+// keyed by kind and then by name, in their generic JSON form.
+type diffRevision map[string]*diffGateway
+
+type diffGateway struct {
+	ir  map[string]map[string]any
+	xds map[string]map[string]any
+}
+
+func loadDiffRevision(inFile string, addMissingResources bool, namespace, dnsDomain string) (diffRevision, error) {
+	inBytes, err := getInputBytes(inFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read input file: %w", err)
+	}
+	resources, err := resource.LoadResourcesFromYAMLBytes(inBytes, addMissingResources, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal input: %w", err)
+	}
+	res, err := translateGatewayAPIToIR(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	rev := make(diffRevision, len(res.XdsIR))
+	for key, xdsIR := range res.XdsIR {
+		tCtx, err := translateXdsIRToXds(resources, namespace, dnsDomain, xdsIR)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate xds ir for key %s: %w", key, err)
+		}
+		gw := &diffGateway{}
+		if gw.ir, err = collectIRDiffResources(xdsIR); err != nil {
+			return nil, err
+		}
+		if gw.xds, err = collectXdsDiffResources(tCtx); err != nil {
+			return nil, err
+		}
+		rev[key] = gw
+	}
+	return rev, nil
+}
+
+// collectIRDiffResources splits the IR into listeners without their routes,
+// routes, clusters (route destinations) and secrets (TLS certificates).
+func collectIRDiffResources(xdsIR *ir.Xds) (map[string]map[string]any, error) {
+	c := newDiffCollector()
+	addTLS := func(tls *ir.TLSConfig) {
+		if tls == nil {
+			return
+		}
+		for _, cert := range tls.Certificates {
+			c.add(diffKindSecret, cert.Name, cert)
+		}
+		for _, cert := range tls.ClientCertificates {
+			c.add(diffKindSecret, cert.Name, cert)
+		}
+		if tls.CACertificate != nil {
+			c.add(diffKindSecret, tls.CACertificate.Name, tls.CACertificate)
+		}
+	}
+	addDestination := func(dest *ir.RouteDestination) {
+		if dest != nil {
+			c.add(diffKindCluster, dest.Name, dest)
+		}
+	}
+
+	for _, l := range xdsIR.HTTP {
+		listener := *l
+		listener.Routes = nil
+		c.add(diffKindListener, l.Name, &listener)
+		addTLS(l.TLS)
+		for _, r := range l.Routes {
+			c.add(diffKindRoute, scopedDiffName(l.Name, r.Name), r)
+			addDestination(r.Destination)
+			for _, m := range r.Mirrors {
+				addDestination(m.Destination)
+			}
+		}
+	}
+	for _, l := range xdsIR.TCP {
+		listener := *l
+		listener.Routes = nil
+		c.add(diffKindListener, l.Name, &listener)
+		addTLS(l.TLS)
+		for _, r := range l.Routes {
+			c.add(diffKindRoute, scopedDiffName(l.Name, r.Name), r)
+			addDestination(r.Destination)
+		}
+	}
+	for _, l := range xdsIR.UDP {
+		listener := *l
+		listener.Route = nil
+		c.add(diffKindListener, l.Name, &listener)
+		if l.Route != nil {
+			c.add(diffKindRoute, scopedDiffName(l.Name, l.Route.Name), l.Route)
+			addDestination(l.Route.Destination)
+		}
+	}
+
+	return c.resources, c.err
+}
+
+// collectXdsDiffResources splits the route configurations into virtual hosts
+// without their routes and routes, so that a change of a route is reported
+// on the route itself.
+func collectXdsDiffResources(tCtx *xds_types.ResourceVersionTable) (map[string]map[string]any, error) {
+	c := newDiffCollector()
+	for _, r := range tCtx.XdsResources[resourcev3.ListenerType] {
+		if l, ok := r.(*listenerv3.Listener); ok {
+			c.add(diffKindListener, l.Name, l)
+		}
+	}
+	for _, r := range tCtx.XdsResources[resourcev3.RouteType] {
+		rc, ok := r.(*routev3.RouteConfiguration)
+		if !ok {
+			continue
+		}
+		for _, vh := range rc.VirtualHosts {
+			vhost := proto.Clone(vh).(*routev3.VirtualHost)
+			vhost.Routes = nil
+			c.add(diffKindVirtualHost, vh.Name, vhost)
+			for _, route := range vh.Routes {
+				c.add(diffKindRoute, scopedDiffName(rc.Name, route.Name), route)
+			}
+		}
+	}
+	for _, r := range tCtx.XdsResources[resourcev3.ClusterType] {
+		if cluster, ok := r.(*clusterv3.Cluster); ok {
+			c.add(diffKindCluster, cluster.Name, cluster)
+		}
+	}
+	for _, r := range tCtx.XdsResources[resourcev3.EndpointType] {
+		if cla, ok := r.(*endpointv3.ClusterLoadAssignment); ok {
+			c.add(diffKindEndpoint, cla.ClusterName, cla)
+		}
+	}
+	for _, r := range tCtx.XdsResources[resourcev3.SecretType] {
+		if secret, ok := r.(*tlsv3.Secret); ok {
+			c.add(diffKindSecret, secret.Name, secret)
+		}
+	}
+
+	return c.resources, c.err
+}
+
+// scopedDiffName returns the name of a resource prefixed with the name of the
+// listener or route configuration it belongs to.
+func scopedDiffName(scope, name string) string {
+	return scope + "/" + name
+}
+
+type diffCollector struct {
+	resources map[string]map[string]any
+	err       error
+}
+
+func newDiffCollector() *diffCollector {
+	return &diffCollector{resources: map[string]map[string]any{}}
+}
+
+// add stores the generic JSON form of the resource, so that IR and xDS
+// resources are compared in the same way.
+func (c *diffCollector) add(kind, name string, obj any) {
+	if c.err != nil {
+		return
+	}
+
+	var (
+		b   []byte
+		err error
+	)
+	if m, ok := obj.(proto.Message); ok {
+		b, err = protojson.Marshal(m)
+	} else {
+		b, err = json.Marshal(obj)
+	}
+	if err != nil {
+		c.err = fmt.Errorf("failed to marshal %s %s: %w", kind, name, err)
+		return
+	}
+	var v any
+	if err = json.Unmarshal(b, &v); err != nil {
+		c.err = fmt.Errorf("failed to unmarshal %s %s: %w", kind, name, err)
+		return
+	}
+
+	if c.resources[kind] == nil {
+		c.resources[kind] = map[string]any{}
+	}
+	c.resources[kind][name] = v
+}
+
+func diffRevisions(from, to diffRevision) *DiffResult {
+	empty := &diffGateway{}
+	result := &DiffResult{}
+	for _, key := range sortedUnion(from, to) {
+		f, t := from[key], to[key]
+		if f == nil {
+			f = empty
+		}
+		if t == nil {
+			t = empty
+		}
+		gw := GatewayDiff{
+			Gateway: key,
+			IR:      diffResources(f.ir, t.ir),
+			Xds:     diffResources(f.xds, t.xds),
+		}
+		if len(gw.IR) > 0 || len(gw.Xds) > 0 {
+			result.Gateways = append(result.Gateways, gw)
+		}
+	}
+	return result
+}
+
+var diffKindOrder = []string{
+	diffKindListener,
+	diffKindVirtualHost,
+	diffKindRoute,
+	diffKindCluster,
+	diffKindEndpoint,
+	diffKindSecret,
+}
+
+func diffResources(from, to map[string]map[string]any) []ResourceDiff {
+	var diffs []ResourceDiff
+	for _, kind := range diffKindOrder {
+		f, t := from[kind], to[kind]
+		for _, name := range sortedUnion(f, t) {
+			oldObj, inOld := f[name]
+			newObj, inNew := t[name]
+			switch {
+			case !inOld:
+				diffs = append(diffs, ResourceDiff{Kind: kind, Name: name, Change: diffChangeAdded})
+			case !inNew:
+				diffs = append(diffs, ResourceDiff{Kind: kind, Name: name, Change: diffChangeRemoved})
+			default:
+				var fields []FieldDiff
+				diffValues("", oldObj, newObj, &fields)
+				if len(fields) == 0 {
+					continue
+				}
+				if kind == diffKindSecret {
+					for i := range fields {
+						fields[i].Old, fields[i].New = nil, nil
+					}
+				}
+				diffs = append(diffs, ResourceDiff{Kind: kind, Name: name, Change: diffChangeModified, Fields: fields})
+			}
+		}
+	}
+	return diffs
+}
+
+// diffValues compares two generic JSON values. Lists of objects with unique
+// names are compared by name, other lists are compared by index. The values of
+// added or removed objects and lists are not reported, only their path.
+func diffValues(path string, oldVal, newVal any, out *[]FieldDiff) {
+	switch o := oldVal.(type) {
+	case map[string]any:
+		if n, ok := newVal.(map[string]any); ok {
+			for _, key := range sortedUnion(o, n) {
+				ov, inOld := o[key]
+				nv, inNew := n[key]
+				diffPresence(joinDiffPath(path, key), ov, nv, inOld, inNew, true, out)
+			}
+			return
+		}
+	case []any:
+		if n, ok := newVal.([]any); ok {
+			oldNamed, oldOK := namedDiffItems(o)
+			newNamed, newOK := namedDiffItems(n)
+			if oldOK && newOK {
+				for _, name := range sortedUnion(oldNamed, newNamed) {
+					ov, inOld := oldNamed[name]
+					nv, inNew := newNamed[name]
+					diffPresence(path+"["+name+"]", ov, nv, inOld, inNew, false, out)
+				}
+				return
+			}
+			for i := 0; i < max(len(o), len(n)); i++ {
+				var ov, nv any
+				if i < len(o) {
+					ov = o[i]
+				}
+				if i < len(n) {
+					nv = n[i]
+				}
+				diffPresence(path+"["+strconv.Itoa(i)+"]", ov, nv, i < len(o), i < len(n), false, out)
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(oldVal, newVal) {
+		d := FieldDiff{Path: path, Change: diffChangeModified}
+		if isDiffScalar(oldVal) && isDiffScalar(newVal) {
+			d.Old, d.New = oldVal, newVal
+		}
+		*out = append(*out, d)
+	}
+}
+
+// diffPresence reports a field that only exists on one side. If expand is
+// true, an added or removed object is reported by its fields, one level deep,
+// so that the diff says which feature was added, for example
+// `typedPerFilterConfig["envoy.filters.http.cors"]` rather than `typedPerFilterConfig`.
+// Items of named lists are already identified by their name and aren't expanded.
+func diffPresence(path string, oldVal, newVal any, inOld, inNew, expand bool, out *[]FieldDiff) {
+	switch {
+	case !inOld:
+		if obj, ok := newVal.(map[string]any); ok && expand && len(obj) > 0 {
+			for _, key := range sortedUnion(obj, nil) {
+				*out = append(*out, FieldDiff{Path: joinDiffPath(path, key), Change: diffChangeAdded, New: diffScalar(obj[key])})
+			}
+			return
+		}
+		*out = append(*out, FieldDiff{Path: path, Change: diffChangeAdded, New: diffScalar(newVal)})
+	case !inNew:
+		if obj, ok := oldVal.(map[string]any); ok && expand && len(obj) > 0 {
+			for _, key := range sortedUnion(obj, nil) {
+				*out = append(*out, FieldDiff{Path: joinDiffPath(path, key), Change: diffChangeRemoved, Old: diffScalar(obj[key])})
+			}
+			return
+		}
+		*out = append(*out, FieldDiff{Path: path, Change: diffChangeRemoved, Old: diffScalar(oldVal)})
+	default:
+		diffValues(path, oldVal, newVal, out)
+	}
+}
+
+// diffScalar returns the value if it's a scalar, and nil otherwise.
+func diffScalar(v any) any {
+	if isDiffScalar(v) {
+		return v
+	}
+	return nil
+}
+
+// namedDiffItems returns the items of a list keyed by their name, and false if
+// the items aren't objects with unique names.
+func namedDiffItems(items []any) (map[string]any, bool) {
+	named := make(map[string]any, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := obj["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		if _, dup := named[name]; dup {
+			return nil, false
+		}
+		named[name] = item
+	}
+	return named, true
+}
+
+func isDiffScalar(v any) bool {
+	switch v.(type) {
+	case map[string]any, []any, nil:
+		return false
+	default:
+		return true
+	}
+}
+
+func joinDiffPath(path, key string) string {
+	if strings.ContainsAny(key, "./[]") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedUnion[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// String returns the diff in a human readable format, for example:
+//
+//	Gateway default/eg
+//	  xds route default/eg/http/httproute/default/backend/rule/0/match/0/www_example_com: Modified
+//	    + typedPerFilterConfig["envoy.filters.http.ext_authz/securitypolicy/default/policy"]
+func (r *DiffResult) String() string {
+	if len(r.Gateways) == 0 {
+		return "No differences found."
+	}
+
+	var b strings.Builder
+	for i, gw := range r.Gateways {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "Gateway %s\n", gw.Gateway)
+		writeResourceDiffs(&b, "ir", gw.IR)
+		writeResourceDiffs(&b, "xds", gw.Xds)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func writeResourceDiffs(b *strings.Builder, form string, diffs []ResourceDiff) {
+	for _, d := range diffs {
+		fmt.Fprintf(b, "  %s %s %s: %s\n", form, d.Kind, d.Name, d.Change)
+		for _, f := range d.Fields {
+			switch f.Change {
+			case diffChangeAdded:
+				fmt.Fprintf(b, "    + %s%s\n", f.Path, formatDiffValue(" = ", f.New))
+			case diffChangeRemoved:
+				fmt.Fprintf(b, "    - %s%s\n", f.Path, formatDiffValue(" = ", f.Old))
+			default:
+				if f.Old == nil && f.New == nil {
+					fmt.Fprintf(b, "    ~ %s\n", f.Path)
+				} else {
+					fmt.Fprintf(b, "    ~ %s: %s -> %s\n", f.Path, formatDiffValue("", f.Old), formatDiffValue("", f.New))
+				}
+			}
+		}
+	}
+}
+
+func formatDiffValue(prefix string, v any) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return prefix + fmt.Sprint(v)
+	}
+	return prefix + string(b)
+}
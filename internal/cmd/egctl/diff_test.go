@@ -0,0 +1,89 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/internal/utils/file"
+	"github.com/envoyproxy/gateway/internal/utils/test"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		name      string
+		from      string
+		to        string
+		output    string
+		expectErr string
+	}{
+		{
+			name:   "diff",
+			from:   "base",
+			to:     "head",
+			output: yamlOutput,
+		},
+		{
+			name:   "diff-text",
+			from:   "base",
+			to:     "head",
+			output: textOutput,
+		},
+		{
+			name:   "no-diff",
+			from:   "base",
+			to:     "base",
+			output: textOutput,
+		},
+		{
+			name:   "diff-multi-listener",
+			from:   "multi-listener-base",
+			to:     "multi-listener-head",
+			output: textOutput,
+		},
+		{
+			name:      "missing-file",
+			from:      "base",
+			to:        "missing",
+			expectErr: "unable to read input file",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := bytes.NewBufferString("")
+			root := newDiffCommand()
+			root.SetOut(b)
+			root.SetErr(b)
+			root.SetArgs([]string{
+				"--from-file", filepath.Join("testdata", "diff", "in", tc.from+".yaml"),
+				"--to-file", filepath.Join("testdata", "diff", "in", tc.to+".yaml"),
+				"--output", tc.output,
+			})
+
+			err := root.ExecuteContext(context.Background())
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			fn := filepath.Join("testdata", "diff", "out", tc.name+".out")
+			if test.OverrideTestData() {
+				require.NoError(t, file.Write(b.String(), fn))
+			}
+			want, err := os.ReadFile(fn)
+			require.NoError(t, err)
+			require.Equal(t, string(want), b.String())
+		})
+	}
+}
@@ -23,6 +23,8 @@ func newExperimentalCommand() *cobra.Command {
 	}
 
 	experimentalCommand.AddCommand(newTranslateCommand())
+	experimentalCommand.AddCommand(newTraceCommand())
+	experimentalCommand.AddCommand(newDiffCommand())
 	experimentalCommand.AddCommand(newStatsCommand())
 	experimentalCommand.AddCommand(newStatusCommand())
 	experimentalCommand.AddCommand(newDashboardCommand())
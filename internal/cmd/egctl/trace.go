@@ -0,0 +1,900 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	"github.com/envoyproxy/gateway/internal/ir"
+	xds_types "github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+// TraceRequest is the synthetic request simulated by the trace command.
+type TraceRequest struct {
+	Method   string            `json:"method"`
+	Host     string            `json:"host"`
+	Path     string            `json:"path"`
+	Port     uint32            `json:"port"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	SourceIP string            `json:"sourceIP,omitempty"`
+	SNI      string            `json:"sni,omitempty"`
+}
+
+// TraceResult describes how the translated configuration handles a request.
+type TraceResult struct {
+	Request       *TraceRequest       `json:"request"`
+	Gateway       string              `json:"gateway"`
+	Listener      *TraceListener      `json:"listener"`
+	VirtualHost   string              `json:"virtualHost"`
+	Route         *TraceRoute         `json:"route"`
+	Filters       []string            `json:"filters,omitempty"`
+	Policies      []TracePolicy       `json:"policies,omitempty"`
+	Authorization *TraceAuthorization `json:"authorization,omitempty"`
+	Backends      []TraceBackend      `json:"backends,omitempty"`
+}
+
+// TraceListener is the listener that accepted the request.
+type TraceListener struct {
+	// Name is the name of the IR listener, which maps to a Gateway listener.
+	Name string `json:"name"`
+	// XdsListener is the name of the xDS listener.
+	XdsListener string `json:"xdsListener"`
+	// FilterChain is the name of the filter chain of the xDS listener.
+	FilterChain string `json:"filterChain"`
+	// RouteConfig is the name of the xDS route configuration.
+	RouteConfig string `json:"routeConfig"`
+}
+
+// TraceRoute is the route that matched the request.
+type TraceRoute struct {
+	Name     string               `json:"name"`
+	Hostname string               `json:"hostname"`
+	Metadata *ir.ResourceMetadata `json:"metadata,omitempty"`
+	// Action is one of Forward, DirectResponse or Redirect.
+	Action string `json:"action"`
+}
+
+// TracePolicy is a policy that contributed to the matched route.
+type TracePolicy struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// TraceAuthorization is the result of evaluating the authorization rules of
+// the matched route against the request.
+type TraceAuthorization struct {
+	// Action is Allow or Deny, or Unknown if a rule can't be evaluated offline,
+	// for example, a rule matching JWT claims.
+	Action string `json:"action"`
+	// Rule is the name of the matched rule, empty if the default action applies.
+	Rule string `json:"rule,omitempty"`
+}
+
+// TraceBackend is a backend of the matched route.
+type TraceBackend struct {
+	Name      string               `json:"name"`
+	Weight    uint32               `json:"weight"`
+	Protocol  string               `json:"protocol,omitempty"`
+	Metadata  *ir.ResourceMetadata `json:"metadata,omitempty"`
+	Endpoints []string             `json:"endpoints,omitempty"`
+}
+
+const (
+	traceActionForward        = "Forward"
+	traceActionDirectResponse = "DirectResponse"
+	traceActionRedirect       = "Redirect"
+	traceAuthorizationUnknown = "Unknown"
+)
+
+func newTraceCommand() *cobra.Command {
+	var (
+		inFile, output, namespace, dnsDomain string
+		addMissingResources                  bool
+		headers                              []string
+		request                              TraceRequest
+	)
+
+	traceCommand := &cobra.Command{
+		Use:   "trace",
+		Short: "Trace a request through the configuration translated from Gateway API resources",
+		Example: `  # Trace a GET request to http://www.example.com/foo on port 80.
+  egctl experimental trace --file <input file> --host www.example.com --path /foo
+
+  # Trace a POST request with headers to an HTTPS listener on port 443.
+  egctl experimental trace --file <input file> --method POST --host www.example.com --path /api \
+    --port 443 --sni www.example.com --header x-user=alice --header x-env=prod
+
+  # Trace a request from a client IP address in JSON output, with short syntax.
+  egctl x trace -f <input file> --host www.example.com --path / --source-ip 10.0.1.1 -o json
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			h, err := parseTraceHeaders(headers)
+			if err != nil {
+				return err
+			}
+			request.Headers = h
+			return runTrace(cmd.OutOrStdout(), inFile, output, addMissingResources, namespace, dnsDomain, &request)
+		},
+	}
+
+	traceCommand.PersistentFlags().StringVarP(&inFile, "file", "f", "", "Location of input file.")
+	if err := traceCommand.MarkPersistentFlagRequired("file"); err != nil {
+		return nil
+	}
+	traceCommand.PersistentFlags().StringVarP(&output, "output", "o", yamlOutput, "One of 'yaml' or 'json'")
+	traceCommand.PersistentFlags().BoolVarP(&addMissingResources, "add-missing-resources", "", false, "Provides dummy resources if missed")
+	traceCommand.PersistentFlags().StringVarP(&dnsDomain, "dns-domain", "", "cluster.local", "DNS domain used by k8s services, default is cluster.local")
+	traceCommand.PersistentFlags().StringVarP(&namespace, "namespace", "n", "envoy-gateway-system", "Namespace where envoy gateway is installed.")
+	traceCommand.PersistentFlags().StringVarP(&request.Method, "method", "", "GET", "HTTP method of the request.")
+	traceCommand.PersistentFlags().StringVarP(&request.Host, "host", "", "", "Host header of the request.")
+	traceCommand.PersistentFlags().StringVarP(&request.Path, "path", "", "/", "Path of the request, including the query string.")
+	traceCommand.PersistentFlags().Uint32VarP(&request.Port, "port", "", 80, "Gateway listener port the request is sent to.")
+	traceCommand.PersistentFlags().StringArrayVarP(&headers, "header", "", nil, "Header of the request in the form of name=value, can be repeated.")
+	traceCommand.PersistentFlags().StringVarP(&request.SourceIP, "source-ip", "", "", "IP address of the client.")
+	traceCommand.PersistentFlags().StringVarP(&request.SNI, "sni", "", "", "TLS server name of the request, the request is sent over TLS if set.")
+
+	return traceCommand
+}
+
+func parseTraceHeaders(headers []string) (map[string]string, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	h := make(map[string]string, len(headers))
+	for _, header := range headers {
+		name, value, found := strings.Cut(header, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid header %q, must be in the form of name=value", header)
+		}
+		h[strings.ToLower(name)] = value
+	}
+	return h, nil
+}
+
+func runTrace(w io.Writer, inFile, output string, addMissingResources bool, namespace, dnsDomain string, request *TraceRequest) error {
+	if request.Host == "" {
+		return fmt.Errorf("--host must be specified")
+	}
+	if !strings.HasPrefix(request.Path, "/") {
+		return fmt.Errorf("--path must start with /")
+	}
+	if request.SourceIP != "" {
+		if _, err := netip.ParseAddr(request.SourceIP); err != nil {
+			return fmt.Errorf("invalid --source-ip: %w", err)
+		}
+	}
+
+	inBytes, err := getInputBytes(inFile)
+	if err != nil {
+		return fmt.Errorf("unable to read input file: %w", err)
+	}
+	resources, err := resource.LoadResourcesFromYAMLBytes(inBytes, addMissingResources, nil)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal input: %w", err)
+	}
+
+	result, err := traceRequest(resources, namespace, dnsDomain, request)
+	if err != nil {
+		return err
+	}
+
+	var out []byte
+	switch output {
+	case jsonOutput:
+		out, err = json.MarshalIndent(result, "", "  ")
+	default:
+		out, err = yaml.Marshal(result)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// traceRequest translates the resources into IR and xDS, and walks the xDS of
+// each Gateway to find the first one that routes the request.
+func traceRequest(resources *resource.Resources, namespace, dnsDomain string, request *TraceRequest) (*TraceResult, error) {
+	res, err := translateGatewayAPIToIR(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(res.XdsIR))
+	for key := range res.XdsIR {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs error
+	for _, key := range keys {
+		tCtx, err := translateXdsIRToXds(resources, namespace, dnsDomain, res.XdsIR[key])
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate xds ir for key %s: %w", key, err)
+		}
+
+		result, err := traceGateway(res, res.XdsIR[key], tCtx, request)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+		result.Gateway = key
+		return result, nil
+	}
+
+	if errs == nil {
+		return nil, errors.New("no Gateway found in the input")
+	}
+	return nil, fmt.Errorf("no route matches the request:\n%w", errs)
+}
+
+func traceGateway(res *gatewayapi.TranslateResult, xdsIR *ir.Xds, tCtx *xds_types.ResourceVersionTable, request *TraceRequest) (*TraceResult, error) {
+	// The request is sent to the Gateway port, which is mapped to the container
+	// port of the xDS listener.
+	var port uint32
+	for _, l := range xdsIR.HTTP {
+		if l.ExternalPort == request.Port {
+			port = l.Port
+			break
+		}
+	}
+	if port == 0 {
+		return nil, fmt.Errorf("no HTTP listener on port %d", request.Port)
+	}
+
+	xdsListener := findTraceXdsListener(tCtx, port)
+	if xdsListener == nil {
+		return nil, fmt.Errorf("no xDS listener on port %d", port)
+	}
+	filterChain := matchTraceFilterChain(xdsListener, request.SNI)
+	if filterChain == nil {
+		return nil, fmt.Errorf("no filter chain of listener %s matches server name %q", xdsListener.Name, request.SNI)
+	}
+	hcm, err := findTraceHCM(filterChain)
+	if err != nil {
+		return nil, err
+	}
+	routeConfigName := hcm.GetRds().GetRouteConfigName()
+	routeConfig := findTraceRouteConfig(tCtx, routeConfigName)
+	if routeConfig == nil {
+		return nil, fmt.Errorf("route configuration %q of filter chain %s not found", routeConfigName, filterChain.Name)
+	}
+
+	vhost := matchTraceVirtualHost(routeConfig.VirtualHosts, request.Host)
+	if vhost == nil {
+		return nil, fmt.Errorf("no virtual host of route configuration %s matches host %q", routeConfigName, request.Host)
+	}
+	var xdsRoute *routev3.Route
+	for _, r := range vhost.Routes {
+		if matchTraceRoute(r.Match, request) {
+			xdsRoute = r
+			break
+		}
+	}
+	if xdsRoute == nil {
+		return nil, fmt.Errorf("no route of virtual host %s matches %s %s", vhost.Name, request.Method, request.Path)
+	}
+
+	irListener, irRoute := findTraceIRRoute(xdsIR, xdsRoute.Name)
+	if irRoute == nil {
+		return nil, fmt.Errorf("xDS route %s has no IR route", xdsRoute.Name)
+	}
+
+	result := &TraceResult{
+		Request: request,
+		Listener: &TraceListener{
+			Name:        irListener.Name,
+			XdsListener: xdsListener.Name,
+			FilterChain: filterChain.Name,
+			RouteConfig: routeConfigName,
+		},
+		VirtualHost: vhost.Name,
+		Route: &TraceRoute{
+			Name:     irRoute.Name,
+			Hostname: irRoute.Hostname,
+			Metadata: traceRouteMetadata(irRoute.Metadata),
+			Action:   traceRouteAction(irRoute),
+		},
+		Filters:  traceHTTPFilters(hcm, vhost, xdsRoute),
+		Policies: tracePolicies(res, irListener, irRoute),
+		Backends: traceBackends(irRoute),
+	}
+	if irRoute.Security != nil && irRoute.Security.Authorization != nil {
+		result.Authorization = traceAuthorization(irRoute.Security.Authorization, request)
+	}
+
+	return result, nil
+}
+
+func findTraceXdsListener(tCtx *xds_types.ResourceVersionTable, port uint32) *listenerv3.Listener {
+	for _, r := range tCtx.XdsResources[resourcev3.ListenerType] {
+		l, ok := r.(*listenerv3.Listener)
+		if !ok || l.GetAddress().GetSocketAddress().GetPortValue() != port {
+			continue
+		}
+		// Skip the QUIC listener which shares the port with the TCP listener.
+		if l.GetAddress().GetSocketAddress().GetProtocol() != corev3.SocketAddress_TCP {
+			continue
+		}
+		return l
+	}
+	return nil
+}
+
+// matchTraceFilterChain selects the filter chain by server name in the same
+// way as Envoy: exact names first, then the longest wildcard suffix, then the
+// chains without server names, and finally the default filter chain.
+func matchTraceFilterChain(l *listenerv3.Listener, sni string) *listenerv3.FilterChain {
+	if sni == "" {
+		return l.DefaultFilterChain
+	}
+
+	sni = strings.ToLower(sni)
+	var (
+		wildcard    *listenerv3.FilterChain
+		wildcardLen int
+		fallback    *listenerv3.FilterChain
+	)
+	for _, fc := range l.FilterChains {
+		names := fc.GetFilterChainMatch().GetServerNames()
+		if len(names) == 0 && fallback == nil {
+			fallback = fc
+		}
+		for _, name := range names {
+			name = strings.ToLower(name)
+			if name == sni {
+				return fc
+			}
+			if strings.HasPrefix(name, "*.") && strings.HasSuffix(sni, name[1:]) && len(name) > wildcardLen {
+				wildcard, wildcardLen = fc, len(name)
+			}
+		}
+	}
+	if wildcard != nil {
+		return wildcard
+	}
+	if fallback != nil {
+		return fallback
+	}
+	return l.DefaultFilterChain
+}
+
+func findTraceHCM(fc *listenerv3.FilterChain) (*hcmv3.HttpConnectionManager, error) {
+	for _, filter := range fc.Filters {
+		if filter.Name != wellknown.HTTPConnectionManager {
+			continue
+		}
+		hcm := new(hcmv3.HttpConnectionManager)
+		if err := filter.GetTypedConfig().UnmarshalTo(hcm); err != nil {
+			return nil, err
+		}
+		return hcm, nil
+	}
+	return nil, fmt.Errorf("filter chain %s has no HTTP connection manager", fc.Name)
+}
+
+func findTraceRouteConfig(tCtx *xds_types.ResourceVersionTable, name string) *routev3.RouteConfiguration {
+	for _, r := range tCtx.XdsResources[resourcev3.RouteType] {
+		if rc, ok := r.(*routev3.RouteConfiguration); ok && rc.Name == name {
+			return rc
+		}
+	}
+	return nil
+}
+
+// matchTraceVirtualHost selects the virtual host in the same way as Envoy:
+// exact domains first, then the longest suffix wildcard, then the longest
+// prefix wildcard, and finally the catch-all domain.
+func matchTraceVirtualHost(vhosts []*routev3.VirtualHost, host string) *routev3.VirtualHost {
+	host = strings.ToLower(host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	var (
+		suffix, prefix, catchAll *routev3.VirtualHost
+		suffixLen, prefixLen     int
+	)
+	for _, vhost := range vhosts {
+		for _, domain := range vhost.Domains {
+			domain = strings.ToLower(domain)
+			switch {
+			case domain == host:
+				return vhost
+			case domain == "*":
+				if catchAll == nil {
+					catchAll = vhost
+				}
+			case strings.HasPrefix(domain, "*"):
+				if strings.HasSuffix(host, domain[1:]) && len(host) > len(domain)-1 && len(domain) > suffixLen {
+					suffix, suffixLen = vhost, len(domain)
+				}
+			case strings.HasSuffix(domain, "*"):
+				if strings.HasPrefix(host, domain[:len(domain)-1]) && len(host) > len(domain)-1 && len(domain) > prefixLen {
+					prefix, prefixLen = vhost, len(domain)
+				}
+			}
+		}
+	}
+
+	switch {
+	case suffix != nil:
+		return suffix
+	case prefix != nil:
+		return prefix
+	default:
+		return catchAll
+	}
+}
+
+func matchTraceRoute(match *routev3.RouteMatch, request *TraceRequest) bool {
+	if match == nil {
+		return false
+	}
+
+	path, rawQuery, _ := strings.Cut(request.Path, "?")
+	caseSensitive := match.GetCaseSensitive() == nil || match.GetCaseSensitive().GetValue()
+	hasPrefix := func(s, prefix string) bool {
+		if caseSensitive {
+			return strings.HasPrefix(s, prefix)
+		}
+		return strings.HasPrefix(strings.ToLower(s), strings.ToLower(prefix))
+	}
+
+	switch spec := match.PathSpecifier.(type) {
+	case *routev3.RouteMatch_Prefix:
+		if !hasPrefix(path, spec.Prefix) {
+			return false
+		}
+	case *routev3.RouteMatch_Path:
+		if caseSensitive && path != spec.Path || !caseSensitive && !strings.EqualFold(path, spec.Path) {
+			return false
+		}
+	case *routev3.RouteMatch_PathSeparatedPrefix:
+		if !hasPrefix(path, spec.PathSeparatedPrefix) {
+			return false
+		}
+		if rest := path[len(spec.PathSeparatedPrefix):]; rest != "" && !strings.HasPrefix(rest, "/") {
+			return false
+		}
+	case *routev3.RouteMatch_SafeRegex:
+		if !matchTraceRegex(spec.SafeRegex.GetRegex(), path) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if match.GetGrpc() != nil && !strings.HasPrefix(request.Headers["content-type"], "application/grpc") {
+		return false
+	}
+
+	for _, hm := range match.Headers {
+		if !matchTraceHeader(hm, request) {
+			return false
+		}
+	}
+
+	if len(match.QueryParameters) > 0 {
+		query, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			return false
+		}
+		for _, qm := range match.QueryParameters {
+			values, ok := query[qm.Name]
+			if !ok {
+				return false
+			}
+			if qm.GetStringMatch() != nil && !matchTraceString(qm.GetStringMatch(), values[0]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchTraceHeader follows the semantics of the Envoy header matcher, including
+// the handling of missing headers.
+func matchTraceHeader(hm *routev3.HeaderMatcher, request *TraceRequest) bool {
+	value, ok := traceHeaderValue(request, strings.ToLower(hm.Name))
+	if !ok && !hm.TreatMissingHeaderAsEmpty {
+		if pm, isPresentMatch := hm.HeaderMatchSpecifier.(*routev3.HeaderMatcher_PresentMatch); isPresentMatch {
+			return !pm.PresentMatch != hm.InvertMatch
+		}
+		return hm.InvertMatch
+	}
+
+	var matched bool
+	switch spec := hm.HeaderMatchSpecifier.(type) {
+	case *routev3.HeaderMatcher_StringMatch:
+		matched = matchTraceString(spec.StringMatch, value)
+	case *routev3.HeaderMatcher_PresentMatch:
+		matched = spec.PresentMatch
+	case *routev3.HeaderMatcher_ExactMatch: //nolint:staticcheck
+		matched = value == spec.ExactMatch
+	case *routev3.HeaderMatcher_PrefixMatch: //nolint:staticcheck
+		matched = strings.HasPrefix(value, spec.PrefixMatch)
+	case *routev3.HeaderMatcher_SuffixMatch: //nolint:staticcheck
+		matched = strings.HasSuffix(value, spec.SuffixMatch)
+	case *routev3.HeaderMatcher_ContainsMatch: //nolint:staticcheck
+		matched = strings.Contains(value, spec.ContainsMatch)
+	case *routev3.HeaderMatcher_SafeRegexMatch: //nolint:staticcheck
+		matched = matchTraceRegex(spec.SafeRegexMatch.GetRegex(), value)
+	default:
+		matched = true
+	}
+	return matched != hm.InvertMatch
+}
+
+func traceHeaderValue(request *TraceRequest, name string) (string, bool) {
+	switch name {
+	case ":method":
+		return request.Method, true
+	case ":authority", "host":
+		return request.Host, true
+	case ":path":
+		return request.Path, true
+	case ":scheme":
+		if request.SNI != "" {
+			return "https", true
+		}
+		return "http", true
+	}
+	value, ok := request.Headers[name]
+	return value, ok
+}
+
+func matchTraceString(sm *matcherv3.StringMatcher, value string) bool {
+	target := value
+	if sm.IgnoreCase {
+		target = strings.ToLower(value)
+	}
+	lower := func(s string) string {
+		if sm.IgnoreCase {
+			return strings.ToLower(s)
+		}
+		return s
+	}
+
+	switch p := sm.MatchPattern.(type) {
+	case *matcherv3.StringMatcher_Exact:
+		return target == lower(p.Exact)
+	case *matcherv3.StringMatcher_Prefix:
+		return strings.HasPrefix(target, lower(p.Prefix))
+	case *matcherv3.StringMatcher_Suffix:
+		return strings.HasSuffix(target, lower(p.Suffix))
+	case *matcherv3.StringMatcher_Contains:
+		return strings.Contains(target, lower(p.Contains))
+	case *matcherv3.StringMatcher_SafeRegex:
+		return matchTraceRegex(p.SafeRegex.GetRegex(), value)
+	default:
+		return false
+	}
+}
+
+// matchTraceRegex returns true if the regex matches the whole value, Envoy and
+// Go both use the RE2 syntax.
+func matchTraceRegex(expr, value string) bool {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return false
+	}
+	return re.MatchString(value)
+}
+
+func findTraceIRRoute(xdsIR *ir.Xds, name string) (*ir.HTTPListener, *ir.HTTPRoute) {
+	for _, l := range xdsIR.HTTP {
+		for _, r := range l.Routes {
+			if r.Name == name {
+				return l, r
+			}
+		}
+	}
+	return nil, nil
+}
+
+// traceRouteMetadata returns the metadata of the route without the policies,
+// which are reported separately.
+func traceRouteMetadata(md *ir.ResourceMetadata) *ir.ResourceMetadata {
+	if md == nil {
+		return nil
+	}
+	return &ir.ResourceMetadata{
+		Kind:        md.Kind,
+		Name:        md.Name,
+		Namespace:   md.Namespace,
+		SectionName: md.SectionName,
+	}
+}
+
+func traceRouteAction(r *ir.HTTPRoute) string {
+	switch {
+	case r.DirectResponse != nil:
+		return traceActionDirectResponse
+	case r.Redirect != nil:
+		return traceActionRedirect
+	default:
+		return traceActionForward
+	}
+}
+
+// traceHTTPFilters returns the HTTP filters of the HCM, in order, that are
+// enabled for the route. A filter disabled in the HCM is enabled by a per-route
+// config of the route or the virtual host, and vice versa.
+func traceHTTPFilters(hcm *hcmv3.HttpConnectionManager, vhost *routev3.VirtualHost, route *routev3.Route) []string {
+	filters := make([]string, 0, len(hcm.HttpFilters))
+	for _, filter := range hcm.HttpFilters {
+		enabled := !filter.Disabled
+		perFilterConfig, ok := route.TypedPerFilterConfig[filter.Name]
+		if !ok {
+			perFilterConfig, ok = vhost.TypedPerFilterConfig[filter.Name]
+		}
+		if ok {
+			enabled = true
+			fc := new(routev3.FilterConfig)
+			if perFilterConfig.MessageIs(fc) && perFilterConfig.UnmarshalTo(fc) == nil {
+				enabled = !fc.Disabled
+			}
+		}
+		if enabled {
+			filters = append(filters, filter.Name)
+		}
+	}
+	return filters
+}
+
+// tracePolicies returns the policies that contributed to the route.
+// The BackendTrafficPolicies are recorded in the route metadata during the
+// translation. For the SecurityPolicies and EnvoyExtensionPolicies, the most
+// specific accepted policies are reported: the ones targeting the route rule,
+// then the route, then the listener, and finally the Gateway.
+func tracePolicies(res *gatewayapi.TranslateResult, irListener *ir.HTTPListener, irRoute *ir.HTTPRoute) []TracePolicy {
+	var policies []TracePolicy
+	if irRoute.Metadata != nil {
+		for _, p := range irRoute.Metadata.Policies {
+			policies = append(policies, TracePolicy{Kind: p.Kind, Name: p.Name, Namespace: p.Namespace})
+		}
+	}
+
+	target := newTracePolicyTarget(res, irListener, irRoute)
+	sps := make([]tracePolicyCandidate, 0, len(res.SecurityPolicies))
+	for _, sp := range res.SecurityPolicies {
+		sps = append(sps, tracePolicyCandidate{
+			Object:     sp,
+			Kind:       egv1a1.KindSecurityPolicy,
+			TargetRefs: sp.Spec.PolicyTargetReferences,
+			Ancestors:  sp.Status.Ancestors,
+		})
+	}
+	policies = append(policies, target.mostSpecific(sps)...)
+
+	eeps := make([]tracePolicyCandidate, 0, len(res.EnvoyExtensionPolicies))
+	for _, eep := range res.EnvoyExtensionPolicies {
+		eeps = append(eeps, tracePolicyCandidate{
+			Object:     eep,
+			Kind:       egv1a1.KindEnvoyExtensionPolicy,
+			TargetRefs: eep.Spec.PolicyTargetReferences,
+			Ancestors:  eep.Status.Ancestors,
+		})
+	}
+	policies = append(policies, target.mostSpecific(eeps)...)
+
+	return policies
+}
+
+type tracePolicyCandidate struct {
+	metav1.Object
+	Kind       string
+	TargetRefs egv1a1.PolicyTargetReferences
+	Ancestors  []gwapiv1.PolicyAncestorStatus
+}
+
+type tracePolicyTarget struct {
+	route, gateway             *ir.ResourceMetadata
+	routeLabels, gatewayLabels map[string]string
+}
+
+func newTracePolicyTarget(res *gatewayapi.TranslateResult, irListener *ir.HTTPListener, irRoute *ir.HTTPRoute) *tracePolicyTarget {
+	t := &tracePolicyTarget{route: irRoute.Metadata, gateway: irListener.Metadata}
+	if t.route != nil {
+		for _, r := range res.HTTPRoutes {
+			if t.route.Kind == resource.KindHTTPRoute && r.Namespace == t.route.Namespace && r.Name == t.route.Name {
+				t.routeLabels = r.Labels
+			}
+		}
+		for _, r := range res.GRPCRoutes {
+			if t.route.Kind == resource.KindGRPCRoute && r.Namespace == t.route.Namespace && r.Name == t.route.Name {
+				t.routeLabels = r.Labels
+			}
+		}
+	}
+	if t.gateway != nil {
+		for _, g := range res.Gateways {
+			if g.Namespace == t.gateway.Namespace && g.Name == t.gateway.Name {
+				t.gatewayLabels = g.Labels
+			}
+		}
+	}
+	return t
+}
+
+const (
+	tracePolicyLevelRouteRule = iota
+	tracePolicyLevelRoute
+	tracePolicyLevelListener
+	tracePolicyLevelGateway
+	tracePolicyLevelNone
+)
+
+// level returns how specific the policy target is for the traced route.
+func (t *tracePolicyTarget) level(p *tracePolicyCandidate) int {
+	level := tracePolicyLevelNone
+	match := func(md *ir.ResourceMetadata, kind, name string, sectionName *gwapiv1.SectionName, sectionLevel, objectLevel int) {
+		if md == nil || md.Kind != kind || md.Namespace != p.GetNamespace() || md.Name != name {
+			return
+		}
+		switch {
+		case sectionName == nil:
+			level = min(level, objectLevel)
+		case string(*sectionName) == md.SectionName:
+			level = min(level, sectionLevel)
+		}
+	}
+
+	for _, ref := range p.TargetRefs.GetTargetRefs() {
+		match(t.route, string(ref.Kind), string(ref.Name), ref.SectionName, tracePolicyLevelRouteRule, tracePolicyLevelRoute)
+		match(t.gateway, string(ref.Kind), string(ref.Name), ref.SectionName, tracePolicyLevelListener, tracePolicyLevelGateway)
+	}
+	for _, selector := range p.TargetRefs.TargetSelectors {
+		s, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+			MatchLabels:      selector.MatchLabels,
+			MatchExpressions: selector.MatchExpressions,
+		})
+		if err != nil {
+			continue
+		}
+		if t.route != nil && string(selector.Kind) == t.route.Kind && s.Matches(labels.Set(t.routeLabels)) {
+			match(t.route, t.route.Kind, t.route.Name, nil, tracePolicyLevelRouteRule, tracePolicyLevelRoute)
+		}
+		if t.gateway != nil && string(selector.Kind) == t.gateway.Kind && s.Matches(labels.Set(t.gatewayLabels)) {
+			match(t.gateway, t.gateway.Kind, t.gateway.Name, nil, tracePolicyLevelListener, tracePolicyLevelGateway)
+		}
+	}
+	return level
+}
+
+func (t *tracePolicyTarget) mostSpecific(candidates []tracePolicyCandidate) []TracePolicy {
+	var (
+		policies []TracePolicy
+		best     = tracePolicyLevelNone
+	)
+	for i := range candidates {
+		p := &candidates[i]
+		if !tracePolicyAccepted(p.Ancestors) {
+			continue
+		}
+		level := t.level(p)
+		if level == tracePolicyLevelNone || level > best {
+			continue
+		}
+		if level < best {
+			best, policies = level, nil
+		}
+		policies = append(policies, TracePolicy{Kind: p.Kind, Name: p.GetName(), Namespace: p.GetNamespace()})
+	}
+	return policies
+}
+
+func tracePolicyAccepted(ancestors []gwapiv1.PolicyAncestorStatus) bool {
+	return slices.ContainsFunc(ancestors, func(a gwapiv1.PolicyAncestorStatus) bool {
+		return meta.IsStatusConditionTrue(a.Conditions, string(gwapiv1.PolicyConditionAccepted))
+	})
+}
+
+// traceAuthorization evaluates the authorization rules in order, the first
+// matching rule decides the action.
+func traceAuthorization(authz *ir.Authorization, request *TraceRequest) *TraceAuthorization {
+	for _, rule := range authz.Rules {
+		matched, known := traceAuthorizationRuleMatches(rule, request)
+		if !known {
+			return &TraceAuthorization{Action: traceAuthorizationUnknown, Rule: rule.Name}
+		}
+		if matched {
+			return &TraceAuthorization{Action: string(rule.Action), Rule: rule.Name}
+		}
+	}
+	return &TraceAuthorization{Action: string(authz.DefaultAction)}
+}
+
+// traceAuthorizationRuleMatches returns whether the rule matches the request,
+// and false for known if the rule depends on information that the request
+// doesn't provide, or on criteria that the trace can't evaluate.
+func traceAuthorizationRuleMatches(rule *ir.AuthorizationRule, request *TraceRequest) (matched, known bool) {
+	if rule.CEL != nil || (rule.Operation != nil && rule.Operation.Path != nil) ||
+		rule.Principal.JWT != nil || len(rule.Principal.ClientIPGeoLocations) > 0 ||
+		len(rule.Principal.ClientIPTags) > 0 || rule.Principal.ClientCertificate != nil {
+		return false, false
+	}
+
+	if rule.Operation != nil && len(rule.Operation.Methods) > 0 &&
+		!slices.Contains(rule.Operation.Methods, gwapiv1.HTTPMethod(strings.ToUpper(request.Method))) {
+		return false, true
+	}
+
+	for _, h := range rule.Principal.Headers {
+		value, ok := request.Headers[strings.ToLower(h.Name)]
+		if !ok || !slices.Contains(h.Values, value) {
+			return false, true
+		}
+	}
+
+	if len(rule.Principal.ClientCIDRs) > 0 {
+		if request.SourceIP == "" {
+			return false, false
+		}
+		addr := netip.MustParseAddr(request.SourceIP)
+		if !slices.ContainsFunc(rule.Principal.ClientCIDRs, func(c *ir.CIDRMatch) bool {
+			prefix, err := netip.ParsePrefix(c.CIDR)
+			return err == nil && prefix.Contains(addr) != c.Invert
+		}) {
+			return false, true
+		}
+	}
+
+	return true, true
+}
+
+func traceBackends(r *ir.HTTPRoute) []TraceBackend {
+	if r.Destination == nil {
+		return nil
+	}
+	backends := make([]TraceBackend, 0, len(r.Destination.Settings))
+	for _, ds := range r.Destination.Settings {
+		b := TraceBackend{
+			Name:     ds.Name,
+			Weight:   1,
+			Protocol: string(ds.Protocol),
+			Metadata: ds.Metadata,
+		}
+		if ds.Weight != nil {
+			b.Weight = *ds.Weight
+		}
+		for _, ep := range ds.Endpoints {
+			b.Endpoints = append(b.Endpoints, net.JoinHostPort(ep.Host, fmt.Sprint(ep.Port)))
+		}
+		backends = append(backends, b)
+	}
+	return backends
+}
@@ -0,0 +1,136 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/file"
+	"github.com/envoyproxy/gateway/internal/utils/test"
+)
+
+func TestTrace(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr string
+	}{
+		{
+			name: "weighted-backends",
+			args: []string{"--host", "www.example.com", "--path", "/api/users?page=1"},
+		},
+		{
+			name: "header-match",
+			args: []string{"--host", "www.example.com:80", "--path", "/api/users", "--header", "X-Canary=true"},
+		},
+		{
+			name: "authorization-allow",
+			args: []string{"--host", "www.example.com", "--path", "/api", "--source-ip", "10.1.2.3"},
+		},
+		{
+			name: "catch-all",
+			args: []string{"--host", "foo.example.com", "--path", "/api", "--method", "POST"},
+		},
+		{
+			name:      "no-listener",
+			args:      []string{"--host", "www.example.com", "--port", "8080"},
+			expectErr: "no HTTP listener on port 8080",
+		},
+		{
+			name:      "invalid-header",
+			args:      []string{"--host", "www.example.com", "--header", "x-canary"},
+			expectErr: `invalid header "x-canary"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := bytes.NewBufferString("")
+			root := newTraceCommand()
+			root.SetOut(b)
+			root.SetErr(b)
+			root.SetArgs(append([]string{"--file", "testdata/trace/in/trace.yaml"}, tc.args...))
+
+			err := root.ExecuteContext(context.Background())
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			fn := filepath.Join("testdata", "trace", "out", tc.name+".yaml")
+			if test.OverrideTestData() {
+				require.NoError(t, file.Write(b.String(), fn))
+			}
+			want, err := os.ReadFile(fn)
+			require.NoError(t, err)
+			require.Equal(t, string(want), b.String())
+		})
+	}
+}
+
+func TestTraceAuthorizationRuleMatches(t *testing.T) {
+	request := &TraceRequest{Method: "GET", Path: "/api", SourceIP: "10.1.2.3"}
+	clientCIDRs := []*ir.CIDRMatch{{CIDR: "10.0.0.0/8"}}
+
+	testCases := []struct {
+		name    string
+		rule    *ir.AuthorizationRule
+		matched bool
+		known   bool
+	}{
+		{
+			name:    "client cidr",
+			rule:    &ir.AuthorizationRule{Principal: ir.Principal{ClientCIDRs: clientCIDRs}},
+			matched: true,
+			known:   true,
+		},
+		{
+			name: "method",
+			rule: &ir.AuthorizationRule{
+				Operation: &egv1a1.Operation{Methods: []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodPost}},
+				Principal: ir.Principal{ClientCIDRs: clientCIDRs},
+			},
+			known: true,
+		},
+		{
+			name: "path",
+			rule: &ir.AuthorizationRule{
+				Operation: &egv1a1.Operation{Path: &egv1a1.PathMatch{Value: "/api"}},
+				Principal: ir.Principal{ClientCIDRs: clientCIDRs},
+			},
+		},
+		{
+			name: "cel",
+			rule: &ir.AuthorizationRule{CEL: new("request.method == 'GET'")},
+		},
+		{
+			name: "client ip tags",
+			rule: &ir.AuthorizationRule{Principal: ir.Principal{ClientIPTags: []string{"internal"}}},
+		},
+		{
+			name: "client certificate",
+			rule: &ir.AuthorizationRule{Principal: ir.Principal{ClientCertificate: &ir.ClientCertificateMatch{}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, known := traceAuthorizationRuleMatches(tc.rule, request)
+			require.Equal(t, tc.matched, matched)
+			require.Equal(t, tc.known, known)
+		})
+	}
+}
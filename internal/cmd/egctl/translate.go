@@ -29,6 +29,7 @@ import (
 	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
 	"github.com/envoyproxy/gateway/internal/gatewayapi/status"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/ratelimit"
+	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/internal/xds/bootstrap"
 	"github.com/envoyproxy/gateway/internal/xds/translator"
@@ -380,17 +381,7 @@ func TranslateGatewayAPIToXds(namespace, dnsDomain, resourceType string, resourc
 	result := make(map[string]interface{})
 	for _, key := range keys {
 		val := gRes.XdsIR[key]
-		xTranslator := &translator.Translator{
-			// Set some default settings for translation
-			GlobalRateLimit: &translator.GlobalRateLimitSettings{
-				ServiceURL: ratelimit.GetServiceURL(namespace, dnsDomain),
-			},
-			Logger: logging.DefaultLogger(io.Discard, egv1a1.LogLevelInfo),
-		}
-		if resources.EnvoyProxyForGatewayClass != nil {
-			xTranslator.FilterOrder = resources.EnvoyProxyForGatewayClass.Spec.FilterOrder
-		}
-		xRes, err := xTranslator.Translate(val)
+		xRes, err := translateXdsIRToXds(resources, namespace, dnsDomain, val)
 		if err != nil {
 			return nil, fmt.Errorf("failed to translate xds ir for key %s value %+v, error:%w", key, val, err)
 		}
@@ -428,6 +419,21 @@ func TranslateGatewayAPIToXds(namespace, dnsDomain, resourceType string, resourc
 	return result, nil
 }
 
+// translateXdsIRToXds translates the Xds IR of a Gateway into xDS resources.
+func translateXdsIRToXds(resources *resource.Resources, namespace, dnsDomain string, xdsIR *ir.Xds) (*xds_types.ResourceVersionTable, error) {
+	xTranslator := &translator.Translator{
+		// Set some default settings for translation
+		GlobalRateLimit: &translator.GlobalRateLimitSettings{
+			ServiceURL: ratelimit.GetServiceURL(namespace, dnsDomain),
+		},
+		Logger: logging.DefaultLogger(io.Discard, egv1a1.LogLevelInfo),
+	}
+	if resources.EnvoyProxyForGatewayClass != nil {
+		xTranslator.FilterOrder = resources.EnvoyProxyForGatewayClass.Spec.FilterOrder
+	}
+	return xTranslator.Translate(xdsIR)
+}
+
 // printOutput prints the echo-backed gateway API and xDS output
 func printOutput(w io.Writer, result *TranslationResult, output string) error {
 	var (
@@ -196,6 +196,18 @@ func startRunners(ctx context.Context, cfg *config.Server, runnerErrors *message
 		return err
 	}
 
+	// Start the Xds Service
+	// It subscribes to the xdsIR, translates it into xds Resources
+	// and publishes it into the xDS Cache.
+	// It also computes the EnvoyPatchPolicy statuses and publishes it.
+	xdsRunner := xdsrunner.New(&xdsrunner.Config{
+		Server:            *cfg,
+		XdsIR:             channels.xdsIR,
+		ExtensionManager:  extMgr,
+		ProviderResources: channels.pResources,
+		RunnerErrors:      runnerErrors,
+	})
+
 	runners := []struct {
 		runner Runner
 	}{
@@ -233,17 +245,7 @@ func startRunners(ctx context.Context, cfg *config.Server, runnerErrors *message
 			}),
 		},
 		{
-			// Start the Xds Service
-			// It subscribes to the xdsIR, translates it into xds Resources
-			// and publishes it into the xDS Cache.
-			// It also computes the EnvoyPatchPolicy statuses and publishes it.
-			runner: xdsrunner.New(&xdsrunner.Config{
-				Server:            *cfg,
-				XdsIR:             channels.xdsIR,
-				ExtensionManager:  extMgr,
-				ProviderResources: channels.pResources,
-				RunnerErrors:      runnerErrors,
-			}),
+			runner: xdsRunner,
 		},
 		{
 			// Start the Infra Manager Runner
@@ -257,10 +259,14 @@ func startRunners(ctx context.Context, cfg *config.Server, runnerErrors *message
 		},
 		{
 			// Start the Admin Server
-			// It provides admin endpoints including pprof for debugging.
+			// It provides admin endpoints including pprof for debugging, and
+			// the views of the translated IR and the xDS status of the proxies.
 			runner: admin.New(&admin.Config{
 				Server:            *cfg,
 				ProviderResources: channels.pResources,
+				XdsIR:             channels.xdsIR,
+				InfraIR:           channels.infraIR,
+				XdsStatus:         xdsRunner,
 				RunnerErrors:      runnerErrors,
 			}),
 		},
@@ -48,6 +48,15 @@ func validateBackend(backend *egv1a1.Backend, backendTLSPolicies []*gwapiv1.Back
 				status.RouteReasonInvalidBackendRef,
 			)
 		}
+	} else if backend.Spec.DNSResolver != nil {
+		for _, ep := range backend.Spec.Endpoints {
+			if ep.FQDN == nil {
+				return status.NewRouteStatusError(
+					fmt.Errorf("dnsResolver can only be used with FQDN endpoints or the DynamicResolver type"),
+					status.RouteReasonInvalidBackendRef,
+				)
+			}
+		}
 	}
 
 	// Validate CACert is specified if InsecureSkipVerify is false
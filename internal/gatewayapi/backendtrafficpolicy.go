@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +39,8 @@ const (
 	MaxConsistentHashTableSize = 5000011 // https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/cluster.proto#config-cluster-v3-cluster-maglevlbconfig
 	// ResponseBodyConfigMapKey is the key used in ConfigMaps to store custom response body data
 	ResponseBodyConfigMapKey = "response.body"
+	// defaultQuotaReportingInterval is the default interval to report the bucket usage to the rate limit quota service.
+	defaultQuotaReportingInterval = 10 * time.Second
 )
 
 // BTPRoutingTypeIndex holds RoutingType values from BackendTrafficPolicies, keyed by attachment
@@ -277,6 +281,115 @@ func deprecatedFieldsUsedInBackendTrafficPolicy(policy *egv1a1.BackendTrafficPol
 	return deprecatedFields
 }
 
+// udpRouteApplicableFields are the BackendTrafficPolicy spec fields that apply to UDPRoutes.
+var udpRouteApplicableFields = sets.New(
+	"targetRef", "targetRefs", "targetSelectors", "mergeType",
+	"loadBalancer", "circuitBreaker", "healthCheck", "udpTimeout", "dns",
+)
+
+// notApplicableFieldsForUDPRoute returns the sorted list of the fields set in the policy
+// that are ignored when the policy applies to a UDPRoute.
+func notApplicableFieldsForUDPRoute(policy *egv1a1.BackendTrafficPolicy) []string {
+	var fields []string
+	for _, name := range setJSONFields(reflect.ValueOf(policy.Spec)) {
+		if !udpRouteApplicableFields.Has(name) {
+			fields = append(fields, "spec."+name)
+		}
+	}
+
+	spec := policy.Spec
+	if lb := spec.LoadBalancer; lb != nil && lb.ConsistentHash != nil &&
+		lb.ConsistentHash.Type != egv1a1.SourceIPConsistentHashType {
+		fields = append(fields, "spec.loadBalancer.consistentHash.type")
+	}
+	if spec.CircuitBreaker != nil {
+		for _, name := range setJSONFields(reflect.ValueOf(*spec.CircuitBreaker)) {
+			if name != "maxConnections" {
+				fields = append(fields, "spec.circuitBreaker."+name)
+			}
+		}
+	}
+	if spec.HealthCheck != nil && spec.HealthCheck.Active != nil {
+		fields = append(fields, "spec.healthCheck.active")
+	}
+
+	slices.Sort(fields)
+	return fields
+}
+
+// notApplicableFieldsForTCPRoute returns the sorted list of the rate limit fields set in the policy
+// that are ignored when the policy applies to a TCPRoute or TLSRoute. Only the local rate limit applies,
+// with the rules matching the connections from a source CIDR, the other client selectors can't match a connection.
+func notApplicableFieldsForTCPRoute(policy *egv1a1.BackendTrafficPolicy) []string {
+	fields := notApplicableFieldsForNonUDPRoute(policy)
+	rateLimit := policy.Spec.RateLimit
+	if rateLimit == nil {
+		return fields
+	}
+
+	if rateLimit.Global != nil {
+		fields = append(fields, "spec.rateLimit.global")
+	}
+	if rateLimit.Quota != nil {
+		fields = append(fields, "spec.rateLimit.quota")
+	}
+	if rateLimit.Local != nil {
+		for i, rule := range rateLimit.Local.Rules {
+			if len(rule.ClientSelectors) > 0 && !isTCPRateLimitRule(rule) {
+				fields = append(fields, fmt.Sprintf("spec.rateLimit.local.rules[%d]", i))
+			}
+		}
+	}
+
+	slices.Sort(fields)
+	return fields
+}
+
+// notApplicableFieldsForNonUDPRoute returns the fields set in the policy that only apply to UDPRoutes.
+func notApplicableFieldsForNonUDPRoute(policy *egv1a1.BackendTrafficPolicy) []string {
+	if policy.Spec.UDPTimeout != nil {
+		return []string{"spec.udpTimeout"}
+	}
+	return nil
+}
+
+// isTCPRateLimitRule returns true if the client selectors of the rule only match the source CIDR
+// of the connections, as a whole.
+func isTCPRateLimitRule(rule egv1a1.RateLimitRule) bool {
+	hasSourceCIDR := false
+	for _, selector := range rule.ClientSelectors {
+		for _, name := range setJSONFields(reflect.ValueOf(selector)) {
+			if name != "sourceCIDR" {
+				return false
+			}
+		}
+		if sourceCIDR := selector.SourceCIDR; sourceCIDR != nil {
+			if ptr.Deref(sourceCIDR.Type, egv1a1.SourceMatchExact) == egv1a1.SourceMatchDistinct ||
+				ptr.Deref(sourceCIDR.Invert, false) {
+				return false
+			}
+			hasSourceCIDR = true
+		}
+	}
+	return hasSourceCIDR
+}
+
+// setJSONFields returns the JSON names of the non-zero fields of the struct,
+// including the fields of its inlined structs.
+func setJSONFields(v reflect.Value) []string {
+	var names []string
+	for i := range v.NumField() {
+		name, opts, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+		switch {
+		case name == "" && opts == "inline":
+			names = append(names, setJSONFields(v.Field(i))...)
+		case name != "" && name != "-" && !v.Field(i).IsZero():
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func (t *Translator) ProcessBackendTrafficPolicies(
 	resources *resource.Resources,
 	gateways []*GatewayContext,
@@ -813,6 +926,9 @@ func (t *Translator) processBackendTrafficPolicyForRoute(
 		status.SetDeprecatedFieldsWarningForPolicyAncestors(&policy.Status, ancestorRefs, t.GatewayControllerName, policy.Generation, deprecatedFields)
 	}
 
+	// Set warning for the fields that don't apply to the targeted route
+	t.setNotApplicableFieldsWarnings(policy, ancestorRefs, sets.New(string(targetedRoute.GetRouteType())))
+
 	// Check if this policy is overridden by other policies targeting at route rule levels
 	// If policy target is route rule, we can skip the check
 	if currTarget.SectionName != nil {
@@ -906,6 +1022,10 @@ func (t *Translator) processBackendTrafficPolicyForListenerSet(
 	// Set Accepted condition if it is unset
 	status.SetAcceptedForPolicyAncestor(&policy.Status, &ancestorRef, t.GatewayControllerName, policy.Generation)
 
+	// Set warning for the fields that don't apply to the routes of the targeted listeners
+	t.setNotApplicableFieldsWarnings(policy, []*gwapiv1.ParentReference{&ancestorRef},
+		t.listenersRouteKinds(gateway.GatewayContext, listenerSetPolicyTargetListeners(gateway.GatewayContext, targeted, currTarget), xdsIR))
+
 	// Determine this policy's own scope so we can look up routes merged into it
 	// and child scopes overriding it.
 	var lsParentScope policyScope
@@ -1007,6 +1127,10 @@ func (t *Translator) processBackendTrafficPolicyForGateway(
 		status.SetDeprecatedFieldsWarningForPolicyAncestor(&policy.Status, &ancestorRef, t.GatewayControllerName, policy.Generation, deprecatedFields)
 	}
 
+	// Set warning for the fields that don't apply to the routes of the targeted listeners
+	t.setNotApplicableFieldsWarnings(policy, []*gwapiv1.ParentReference{&ancestorRef},
+		t.listenersRouteKinds(targetedGateway, gatewayPolicyTargetListeners(targetedGateway, currTarget), xdsIR))
+
 	// Determine this policy's own scope so we can look up merged and overriding
 	// child scopes from the relation maps.
 	var parentScope policyScope
@@ -1046,6 +1170,63 @@ func (t *Translator) processBackendTrafficPolicyForGateway(
 	}
 }
 
+// setNotApplicableFieldsWarnings sets a warning condition listing the fields of the policy that are
+// ignored for each kind of route the policy applies to.
+func (t *Translator) setNotApplicableFieldsWarnings(
+	policy *egv1a1.BackendTrafficPolicy,
+	ancestorRefs []*gwapiv1.ParentReference,
+	routeKinds sets.Set[string],
+) {
+	for _, kind := range sets.List(routeKinds) {
+		var fields []string
+		switch kind {
+		case resource.KindUDPRoute:
+			fields = notApplicableFieldsForUDPRoute(policy)
+		case resource.KindTCPRoute, resource.KindTLSRoute:
+			fields = notApplicableFieldsForTCPRoute(policy)
+		case resource.KindHTTPRoute, resource.KindGRPCRoute:
+			fields = notApplicableFieldsForNonUDPRoute(policy)
+		}
+		if len(fields) > 0 {
+			status.SetNotApplicableFieldsWarningForPolicyAncestors(&policy.Status, ancestorRefs, t.GatewayControllerName, policy.Generation, kind, fields)
+		}
+	}
+}
+
+// listenersRouteKinds returns the kinds of the TCP, TLS and UDP routes attached to the listeners.
+func (t *Translator) listenersRouteKinds(
+	gtwCtx *GatewayContext,
+	listeners []*ListenerContext,
+	xdsIR resource.XdsIRMap,
+) sets.Set[string] {
+	kinds := sets.New[string]()
+	x, ok := xdsIR[t.getIRKey(gtwCtx.Gateway)]
+	if !ok {
+		return kinds
+	}
+
+	listenerNames := sets.New[string]()
+	for _, listener := range listeners {
+		listenerNames.Insert(irListenerName(listener))
+	}
+	for _, tcp := range x.TCP {
+		if !listenerNames.Has(tcp.Name) {
+			continue
+		}
+		for _, r := range tcp.Routes {
+			if r.Metadata != nil {
+				kinds.Insert(r.Metadata.Kind)
+			}
+		}
+	}
+	for _, udp := range x.UDP {
+		if listenerNames.Has(udp.Name) && udp.Route != nil {
+			kinds.Insert(resource.KindUDPRoute)
+		}
+	}
+	return kinds
+}
+
 func resolveBackendTrafficPolicyGatewayTargetRef(
 	target policyTargetReferenceWithSectionName,
 	gateways map[types.NamespacedName]*policyGatewayTargetContext,
@@ -1243,10 +1424,21 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 		return nil
 	}
 
-	var targetListenerName string
+	var (
+		targetListenerName string
+		listeners          []*ListenerContext
+	)
 	if policyTargetListener != nil {
 		targetListenerName = irListenerName(policyTargetListener)
+		listeners = []*ListenerContext{policyTargetListener}
+	} else {
+		for _, parentRef := range GetManagedParentReferences(route) {
+			if parentRefCtx := route.GetRouteParentContext(parentRef); parentRefCtx != nil {
+				listeners = append(listeners, parentRefCtx.listeners...)
+			}
+		}
 	}
+	errs = errors.Join(errs, validateRateLimitClientIPTags(policy.Spec.RateLimit, listeners))
 
 	// Apply IR to all relevant routes
 	for key, x := range xdsIR {
@@ -1311,6 +1503,8 @@ func (t *Translator) translateBackendTrafficPolicyForRouteWithMerge(
 	}
 	// Case 3: Only route policy has rate limits or neither has rate limits - use default behavior (tf already built from merged policy)
 
+	errs = errors.Join(errs, validateRateLimitClientIPTags(mergedPolicy.Spec.RateLimit, []*ListenerContext{policyTargetListener}))
+
 	x, ok := xdsIR[t.getIRKey(policyTargetListener.gateway.Gateway)]
 	if !ok {
 		// should not happen.
@@ -1356,6 +1550,8 @@ func (t *Translator) applyTrafficFeatureToRoute(route RouteContext,
 				setIfNil(&r.Timeout, tf.Timeout)
 				setIfNil(&r.BackendConnection, tf.BackendConnection)
 				setIfNil(&r.DNS, tf.DNS)
+				setIfNil(&r.RateLimit, buildTCPRateLimit(tf.RateLimit))
+				setIfNil(&r.BandwidthLimit, tf.BandwidthLimit)
 				setIfNil(&r.StatName, buildRouteStatName(routeStatName, r.Metadata))
 				appendTrafficPolicyMetadata(r.Metadata, policy)
 			}
@@ -1379,6 +1575,9 @@ func (t *Translator) applyTrafficFeatureToRoute(route RouteContext,
 				// specific policy
 				setIfNil(&r.LoadBalancer, tf.LoadBalancer)
 				setIfNil(&r.DNS, tf.DNS)
+				setIfNil(&r.Timeout, buildUDPTimeout(tf.Timeout))
+				setIfNil(&r.CircuitBreaker, buildUDPCircuitBreaker(tf.CircuitBreaker))
+				setIfNil(&r.HealthCheck, buildUDPHealthCheck(tf.HealthCheck))
 			}
 		}
 	}
@@ -1481,6 +1680,7 @@ func (t *Translator) buildTrafficFeatures(policy *egv1a1.BackendTrafficPolicy, o
 		cb          *ir.CircuitBreaker
 		fi          *ir.FaultInjection
 		ac          *ir.AdmissionControl
+		acc         *ir.AdaptiveConcurrency
 		to          *ir.Timeout
 		ka          *ir.TCPKeepalive
 		rt          *ir.Retry
@@ -1489,7 +1689,9 @@ func (t *Translator) buildTrafficFeatures(policy *egv1a1.BackendTrafficPolicy, o
 		h2          *ir.HTTP2Settings
 		ro          *ir.ResponseOverride
 		rb          *ir.RequestBuffer
+		ca          *ir.Cache
 		cp          []*ir.Compression
+		dc          *ir.Decompression
 		httpUpgrade []ir.HTTPUpgradeConfig
 		err, errs   error
 	)
@@ -1522,6 +1724,12 @@ func (t *Translator) buildTrafficFeatures(policy *egv1a1.BackendTrafficPolicy, o
 	if policy.Spec.AdmissionControl != nil {
 		ac = t.buildAdmissionControl(policy)
 	}
+	if policy.Spec.AdaptiveConcurrency != nil {
+		if acc, err = buildAdaptiveConcurrency(policy.Spec.AdaptiveConcurrency); err != nil {
+			err = perr.WithMessage(err, "AdaptiveConcurrency")
+			errs = errors.Join(errs, err)
+		}
+	}
 	if ka, err = buildTCPKeepAlive(&policy.Spec.ClusterSettings); err != nil {
 		err = perr.WithMessage(err, "TCPKeepalive")
 		errs = errors.Join(errs, err)
@@ -1537,6 +1745,11 @@ func (t *Translator) buildTrafficFeatures(policy *egv1a1.BackendTrafficPolicy, o
 		errs = errors.Join(errs, err)
 	}
 
+	if to, err = buildUDPSessionTimeout(policy.Spec.UDPTimeout, to); err != nil {
+		err = perr.WithMessage(err, "UDPTimeout")
+		errs = errors.Join(errs, err)
+	}
+
 	if bc, err = buildBackendConnection(&policy.Spec.ClusterSettings); err != nil {
 		err = perr.WithMessage(err, "BackendConnection")
 		errs = errors.Join(errs, err)
@@ -1557,12 +1770,21 @@ func (t *Translator) buildTrafficFeatures(policy *egv1a1.BackendTrafficPolicy, o
 		errs = errors.Join(errs, err)
 	}
 
+	if ca, err = buildCache(policy, owners); err != nil {
+		err = perr.WithMessage(err, "Cache")
+		errs = errors.Join(errs, err)
+	}
+
 	if err = validateTelemetry(policy.Spec.Telemetry); err != nil {
 		err = perr.WithMessage(err, "Telemetry")
 		errs = errors.Join(errs, err)
 	}
 
 	cp = buildCompression(policy.Spec.Compression, policy.Spec.Compressor)
+	if dc, err = buildDecompression(policy.Spec.Decompression); err != nil {
+		err = perr.WithMessage(err, "Decompression")
+		errs = errors.Join(errs, err)
+	}
 	httpUpgrade = buildHTTPProtocolUpgradeConfig(policy.Spec.HTTPUpgrade)
 	if rb != nil && len(httpUpgrade) > 0 {
 		err = errors.New("requestBuffer cannot be used together with httpUpgrade")
@@ -1585,15 +1807,18 @@ func (t *Translator) buildTrafficFeatures(policy *egv1a1.BackendTrafficPolicy, o
 			HTTP2:             h2,
 			DNS:               ds,
 		},
-		RateLimit:        rl,
-		BandwidthLimit:   bl,
-		FaultInjection:   fi,
-		Retry:            rt,
-		ResponseOverride: ro,
-		Compression:      cp,
-		HTTPUpgrade:      httpUpgrade,
-		Telemetry:        buildBackendTelemetry(policy.Spec.Telemetry),
-		RequestBuffer:    rb,
+		RateLimit:           rl,
+		BandwidthLimit:      bl,
+		FaultInjection:      fi,
+		Retry:               rt,
+		ResponseOverride:    ro,
+		Compression:         cp,
+		Decompression:       dc,
+		HTTPUpgrade:         httpUpgrade,
+		Telemetry:           buildBackendTelemetry(policy.Spec.Telemetry),
+		RequestBuffer:       rb,
+		Cache:               ca,
+		AdaptiveConcurrency: acc,
 	}, errs
 }
 
@@ -1673,6 +1898,7 @@ func (t *Translator) translateBackendTrafficPolicyForListeners(
 		// should not happen
 		return errs
 	}
+	errs = errors.Join(errs, validateRateLimitClientIPTags(policy.Spec.RateLimit, targetListeners))
 
 	routeStatName := ""
 	if tf.Telemetry != nil && tf.Telemetry.Metrics != nil {
@@ -1703,6 +1929,8 @@ func (t *Translator) translateBackendTrafficPolicyForListeners(
 			setIfNil(&r.TCPKeepalive, tf.TCPKeepalive)
 			setIfNil(&r.Timeout, tf.Timeout)
 			setIfNil(&r.DNS, tf.DNS)
+			setIfNil(&r.RateLimit, buildTCPRateLimit(tf.RateLimit))
+			setIfNil(&r.BandwidthLimit, tf.BandwidthLimit)
 			setIfNil(&r.StatName, buildRouteStatName(routeStatName, r.Metadata))
 			appendTrafficPolicyMetadata(r.Metadata, policy)
 		}
@@ -1723,6 +1951,9 @@ func (t *Translator) translateBackendTrafficPolicyForListeners(
 		// specific policy
 		setIfNil(&route.LoadBalancer, tf.LoadBalancer)
 		setIfNil(&route.DNS, tf.DNS)
+		setIfNil(&route.Timeout, buildUDPTimeout(tf.Timeout))
+		setIfNil(&route.CircuitBreaker, buildUDPCircuitBreaker(tf.CircuitBreaker))
+		setIfNil(&route.HealthCheck, buildUDPHealthCheck(tf.HealthCheck))
 	}
 
 	routesWithDirectResponse := sets.New[string]()
@@ -1806,6 +2037,8 @@ func (t *Translator) buildRateLimit(policy *egv1a1.BackendTrafficPolicy) (*ir.Ra
 			return t.buildGlobalRateLimit(policy)
 		case egv1a1.LocalRateLimitType:
 			return t.buildLocalRateLimit(policy)
+		case egv1a1.QuotaRateLimitType:
+			return t.buildQuotaRateLimit(policy)
 		}
 		return nil, fmt.Errorf("invalid rateLimit type: %s", *policy.Spec.RateLimit.Type)
 	}
@@ -1888,6 +2121,75 @@ func (t *Translator) buildLocalRateLimit(policy *egv1a1.BackendTrafficPolicy) (*
 	return rateLimit, nil
 }
 
+// buildTCPRateLimit returns the rate limit of the TCP and TLS routes, which limits the rate of
+// the new connections. Only the local rate limit is supported, with the rules matching the
+// source CIDR, as the other client selectors can't match a connection.
+func buildTCPRateLimit(rateLimit *ir.RateLimit) *ir.RateLimit {
+	if rateLimit == nil || rateLimit.Local == nil {
+		return nil
+	}
+
+	local := &ir.LocalRateLimit{
+		Default: rateLimit.Local.Default,
+	}
+	for _, rule := range rateLimit.Local.Rules {
+		if rule.CIDRMatch == nil || rule.CIDRMatch.Distinct || rule.CIDRMatch.Invert ||
+			len(rule.HeaderMatches) > 0 || rule.PathMatch != nil ||
+			len(rule.MethodMatches) > 0 || len(rule.QueryParamMatches) > 0 {
+			continue
+		}
+		local.Rules = append(local.Rules, rule.DeepCopy())
+	}
+
+	return &ir.RateLimit{Local: local}
+}
+
+// buildUDPSessionTimeout adds the idle timeout of the UDP sessions to the timeout settings.
+func buildUDPSessionTimeout(udpTimeout *egv1a1.UDPTimeout, to *ir.Timeout) (*ir.Timeout, error) {
+	if udpTimeout == nil || udpTimeout.IdleTimeout == nil {
+		return to, nil
+	}
+
+	d, err := time.ParseDuration(string(*udpTimeout.IdleTimeout))
+	if err != nil {
+		return to, fmt.Errorf("invalid IdleTimeout value %s", *udpTimeout.IdleTimeout)
+	}
+	if to == nil {
+		to = &ir.Timeout{}
+	}
+	to.UDP = &ir.UDPTimeout{
+		IdleTimeout: ir.MetaV1DurationPtr(d),
+	}
+	return to, nil
+}
+
+// buildUDPTimeout returns the timeout settings of the UDP routes, only the UDP session idle timeout applies.
+func buildUDPTimeout(timeout *ir.Timeout) *ir.Timeout {
+	if timeout == nil || timeout.UDP == nil {
+		return nil
+	}
+	return &ir.Timeout{UDP: timeout.UDP}
+}
+
+// buildUDPCircuitBreaker returns the circuit breaker of the UDP routes, only the maximum number of
+// connections applies, which limits the number of UDP sessions to the upstream cluster.
+func buildUDPCircuitBreaker(cb *ir.CircuitBreaker) *ir.CircuitBreaker {
+	if cb == nil || cb.MaxConnections == nil {
+		return nil
+	}
+	return &ir.CircuitBreaker{MaxConnections: cb.MaxConnections}
+}
+
+// buildUDPHealthCheck returns the health check of the UDP routes, active health checks don't
+// apply as Envoy has no UDP health checker, and the HTTP, gRPC and TCP ones would mark the UDP
+// backends unhealthy.
+func buildUDPHealthCheck(hc *ir.HealthCheck) *ir.HealthCheck {
+	if hc == nil || (hc.Passive == nil && hc.PanicThreshold == nil) {
+		return nil
+	}
+	return &ir.HealthCheck{Passive: hc.Passive, PanicThreshold: hc.PanicThreshold}
+}
+
 func (t *Translator) buildGlobalRateLimit(policy *egv1a1.BackendTrafficPolicy) (*ir.RateLimit, error) {
 	if policy.Spec.RateLimit.Global == nil {
 		return nil, fmt.Errorf("global configuration empty for rateLimit")
@@ -1917,10 +2219,64 @@ func (t *Translator) buildGlobalRateLimit(policy *egv1a1.BackendTrafficPolicy) (
 	return rateLimit, nil
 }
 
+func (t *Translator) buildQuotaRateLimit(policy *egv1a1.BackendTrafficPolicy) (*ir.RateLimit, error) {
+	if policy.Spec.RateLimit.Quota == nil {
+		return nil, fmt.Errorf("quota configuration empty for rateLimit")
+	}
+	if !t.RateLimitQuotaEnabled {
+		return nil, fmt.Errorf("enable RateLimitQuota in the EnvoyGateway config to configure quota rateLimit")
+	}
+
+	quota := policy.Spec.RateLimit.Quota
+	irQuota := &ir.QuotaRateLimit{
+		Domain:            ptr.Deref(quota.Domain, utils.NamespacedName(policy).String()),
+		Rules:             make([]*ir.RateLimitRule, len(quota.Rules)),
+		ReportingInterval: metav1.Duration{Duration: defaultQuotaReportingInterval},
+		FallbackDenyAll:   ptr.Deref(quota.FallbackAction, egv1a1.QuotaFallbackActionAllowAll) == egv1a1.QuotaFallbackActionDenyAll,
+	}
+
+	if quota.ReportingInterval != nil {
+		d, err := time.ParseDuration(string(*quota.ReportingInterval))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reportingInterval: %w", err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("reportingInterval must be greater than 0")
+		}
+		irQuota.ReportingInterval = metav1.Duration{Duration: d}
+	}
+
+	hasDefaultRule := false
+	for i, rule := range quota.Rules {
+		if len(rule.ClientSelectors) == 0 {
+			if hasDefaultRule {
+				return nil, fmt.Errorf("quota rateLimit can not have more than one rule without clientSelectors")
+			}
+			hasDefaultRule = true
+		}
+
+		// The limits of the buckets are assigned by the rate limit quota service,
+		// so only the client selectors of the rule are translated.
+		irRule, err := buildRateLimitRule(&egv1a1.RateLimitRule{
+			ClientSelectors: rule.ClientSelectors,
+			Shared:          rule.Shared,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Set the Name field as <policy-ns>/<policy-name>/rule/<rule-index>
+		irRule.Name = irRuleName(policy.Namespace, policy.Name, i)
+		irQuota.Rules[i] = irRule
+	}
+
+	return &ir.RateLimit{Quota: irQuota}, nil
+}
+
 func (t *Translator) buildBothRateLimit(policy *egv1a1.BackendTrafficPolicy) (*ir.RateLimit, error) {
 	var (
 		localRateLimit  *ir.RateLimit
 		globalRateLimit *ir.RateLimit
+		quotaRateLimit  *ir.RateLimit
 		err             error
 	)
 
@@ -1936,6 +2292,12 @@ func (t *Translator) buildBothRateLimit(policy *egv1a1.BackendTrafficPolicy) (*i
 			return nil, err
 		}
 	}
+	if policy.Spec.RateLimit.Quota != nil {
+		quotaRateLimit, err = t.buildQuotaRateLimit(policy)
+		if err != nil {
+			return nil, err
+		}
+	}
 	rl := &ir.RateLimit{}
 	if localRateLimit != nil && localRateLimit.Local != nil {
 		rl.Local = localRateLimit.Local
@@ -1943,6 +2305,9 @@ func (t *Translator) buildBothRateLimit(policy *egv1a1.BackendTrafficPolicy) (*i
 	if globalRateLimit != nil && globalRateLimit.Global != nil {
 		rl.Global = globalRateLimit.Global
 	}
+	if quotaRateLimit != nil && quotaRateLimit.Quota != nil {
+		rl.Quota = quotaRateLimit.Quota
+	}
 	return rl, nil
 }
 
@@ -1968,10 +2333,11 @@ func buildRateLimitRule(rule *egv1a1.RateLimitRule) (*ir.RateLimitRule, error) {
 
 	for _, match := range rule.ClientSelectors {
 		if len(match.Headers) == 0 && len(match.Methods) == 0 &&
-			match.Path == nil && match.SourceCIDR == nil && len(match.QueryParams) == 0 {
+			match.Path == nil && match.SourceCIDR == nil && len(match.QueryParams) == 0 &&
+			len(match.ClientIPTags) == 0 {
 			return nil, fmt.Errorf(
 				"unable to translate rateLimit. At least one of the" +
-					" header or method or path or sourceCIDR or queryParameters must be specified")
+					" header or method or path or sourceCIDR or queryParameters or clientIPTags must be specified")
 		}
 		for _, header := range match.Headers {
 			switch {
@@ -2011,6 +2377,14 @@ func buildRateLimitRule(rule *egv1a1.RateLimitRule) (*ir.RateLimitRule, error) {
 			}
 		}
 
+		// The IP tags of the client IP are written to a header by the IP tagging filter.
+		if len(match.ClientIPTags) > 0 {
+			irRule.HeaderMatches = append(irRule.HeaderMatches, &ir.StringMatch{
+				Name:      ir.IPTagsHeader,
+				SafeRegex: new(regex.ListElementRegex(match.ClientIPTags)),
+			})
+		}
+
 		for _, method := range match.Methods {
 			irRule.MethodMatches = append(irRule.MethodMatches, &ir.StringMatch{
 				Exact:  new(string(method.Value)),
@@ -2310,6 +2684,42 @@ func (t *Translator) buildAdmissionControl(policy *egv1a1.BackendTrafficPolicy)
 	return ac
 }
 
+func buildAdaptiveConcurrency(adaptiveConcurrency *egv1a1.AdaptiveConcurrency) (*ir.AdaptiveConcurrency, error) {
+	acc := &ir.AdaptiveConcurrency{
+		SampleAggregatePercentile: adaptiveConcurrency.SampleAggregatePercentile,
+	}
+
+	if limit := adaptiveConcurrency.ConcurrencyLimit; limit != nil {
+		if limit.Min != nil && limit.Max != nil && *limit.Min > *limit.Max {
+			return nil, fmt.Errorf("concurrencyLimit min %d is greater than max %d", *limit.Min, *limit.Max)
+		}
+		acc.MinConcurrencyLimit = limit.Min
+		acc.MaxConcurrencyLimit = limit.Max
+		if limit.UpdateInterval != nil {
+			d, err := time.ParseDuration(string(*limit.UpdateInterval))
+			if err != nil {
+				return nil, fmt.Errorf("invalid concurrencyLimit updateInterval: %w", err)
+			}
+			acc.ConcurrencyUpdateInterval = &metav1.Duration{Duration: d}
+		}
+	}
+
+	if minRTT := adaptiveConcurrency.MinRTT; minRTT != nil {
+		acc.MinRTTRequestCount = minRTT.RequestCount
+		acc.MinRTTJitterPercent = minRTT.JitterPercent
+		acc.MinRTTBufferPercent = minRTT.BufferPercent
+		if minRTT.Interval != nil {
+			d, err := time.ParseDuration(string(*minRTT.Interval))
+			if err != nil {
+				return nil, fmt.Errorf("invalid minRTT interval: %w", err)
+			}
+			acc.MinRTTInterval = &metav1.Duration{Duration: d}
+		}
+	}
+
+	return acc, nil
+}
+
 func makeIrStatusSet(in []egv1a1.HTTPStatus) []ir.HTTPStatus {
 	statusSet := sets.NewInt()
 	for _, r := range in {
@@ -2356,6 +2766,59 @@ func buildRequestBuffer(spec *egv1a1.RequestBuffer) (*ir.RequestBuffer, error) {
 	}, nil
 }
 
+// defaultCacheMaxSizeBytes is the size limit of a cache that doesn't set maxSize.
+const defaultCacheMaxSizeBytes = 256 * 1024 * 1024
+
+// buildCache builds the IR cache of a policy. The cache is named after the owner of the
+// field, so routes that inherit the cache of a parent policy share its store.
+func buildCache(policy *egv1a1.BackendTrafficPolicy, owners *backendTrafficPolicyOwners) (*ir.Cache, error) {
+	spec := policy.Spec.Cache
+	if spec == nil {
+		return nil, nil
+	}
+
+	owner := policy
+	if owners != nil && owners.cache != nil {
+		owner = owners.cache
+	}
+
+	maxSizeBytes := int64(defaultCacheMaxSizeBytes)
+	if spec.MaxSize != nil {
+		var ok bool
+		if maxSizeBytes, ok = spec.MaxSize.AsInt64(); !ok || maxSizeBytes <= 0 {
+			return nil, fmt.Errorf("invalid maxSize value %s", spec.MaxSize.String())
+		}
+	}
+
+	ca := &ir.Cache{
+		Name:                      irConfigName(owner),
+		MaxSizeBytes:              uint64(maxSizeBytes),
+		IgnoreRequestCacheControl: ptr.Deref(spec.IgnoreRequestCacheControl, false),
+	}
+	if spec.MaxEntrySize != nil {
+		maxEntrySizeBytes, ok := spec.MaxEntrySize.AsInt64()
+		if !ok || maxEntrySizeBytes <= 0 {
+			return nil, fmt.Errorf("invalid maxEntrySize value %s", spec.MaxEntrySize.String())
+		}
+		if maxEntrySizeBytes > maxSizeBytes {
+			return nil, fmt.Errorf("maxEntrySize %s is larger than the cache size", spec.MaxEntrySize.String())
+		}
+		ca.MaxEntrySizeBytes = new(uint64(maxEntrySizeBytes))
+	}
+	for _, h := range spec.AllowedVaryHeaders {
+		ca.AllowedVaryHeaders = append(ca.AllowedVaryHeaders, *irStringMatch("", h))
+	}
+	if spec.Key != nil {
+		ca.Key = &ir.CacheKey{
+			ExcludeScheme:           ptr.Deref(spec.Key.ExcludeScheme, false),
+			ExcludeHost:             ptr.Deref(spec.Key.ExcludeHost, false),
+			IncludedQueryParameters: spec.Key.IncludedQueryParameters,
+			ExcludedQueryParameters: spec.Key.ExcludedQueryParameters,
+		}
+	}
+	return ca, nil
+}
+
 func (t *Translator) buildResponseOverride(policy *egv1a1.BackendTrafficPolicy, owners *backendTrafficPolicyOwners) (*ir.ResponseOverride, error) {
 	if len(policy.Spec.ResponseOverride) == 0 {
 		return nil, nil
@@ -2509,6 +2972,7 @@ func (t *Translator) getCustomResponseBody(
 // namespace. Mirrors the field-owner pattern used for SecurityPolicy.
 type backendTrafficPolicyOwners struct {
 	responseOverride *egv1a1.BackendTrafficPolicy
+	cache            *egv1a1.BackendTrafficPolicy
 }
 
 // buildBackendTrafficPolicyOwners picks the owner of each merged field: the route policy
@@ -2518,8 +2982,13 @@ func buildBackendTrafficPolicyOwners(route, parent *egv1a1.BackendTrafficPolicy)
 	if len(route.Spec.ResponseOverride) > 0 {
 		responseOverrideOwner = route
 	}
+	cacheOwner := parent
+	if route.Spec.Cache != nil {
+		cacheOwner = route
+	}
 	return &backendTrafficPolicyOwners{
 		responseOverride: responseOverrideOwner,
+		cache:            cacheOwner,
 	}
 }
 
@@ -2584,6 +3053,25 @@ func buildCompression(compression, compressor []*egv1a1.Compression) []*ir.Compr
 	return result
 }
 
+func buildDecompression(decompression *egv1a1.Decompression) (*ir.Decompression, error) {
+	if decompression == nil {
+		return nil, nil
+	}
+
+	result := &ir.Decompression{
+		Types:    make([]egv1a1.CompressorType, 0, len(decompression.Decompressor)),
+		Response: ptr.Deref(decompression.Response, false),
+	}
+	for _, d := range decompression.Decompressor {
+		if slices.Contains(result.Types, d.Type) {
+			return nil, fmt.Errorf("duplicated decompressor type %s", d.Type)
+		}
+		result.Types = append(result.Types, d.Type)
+	}
+
+	return result, nil
+}
+
 func buildHTTPProtocolUpgradeConfig(cfgs []*egv1a1.ProtocolUpgradeConfig) []ir.HTTPUpgradeConfig {
 	if len(cfgs) == 0 {
 		return nil
@@ -3133,29 +3133,33 @@ func TestBTPClusterSettingsIndex(t *testing.T) {
 // btpSpecHasClusterScopedFields, so a new field must be explicitly classified here too.
 func TestBtpSpecHasClusterScopedFieldsExhaustive(t *testing.T) {
 	expected := map[string]bool{
-		"LoadBalancer":      true,
-		"Retry":             false,
-		"ProxyProtocol":     true,
-		"TCPKeepalive":      true,
-		"HealthCheck":       true,
-		"CircuitBreaker":    true,
-		"Timeout":           true,
-		"Connection":        true,
-		"DNS":               true,
-		"HTTP2":             true,
-		"MergeType":         false,
-		"RateLimit":         false,
-		"BandwidthLimit":    false,
-		"FaultInjection":    false,
-		"AdmissionControl":  true,
-		"UseClientProtocol": true,
-		"Compression":       false,
-		"Compressor":        false,
-		"ResponseOverride":  false,
-		"HTTPUpgrade":       false,
-		"RequestBuffer":     false,
-		"Telemetry":         false,
-		"RoutingType":       false,
+		"LoadBalancer":        true,
+		"Retry":               false,
+		"ProxyProtocol":       true,
+		"TCPKeepalive":        true,
+		"HealthCheck":         true,
+		"CircuitBreaker":      true,
+		"Timeout":             true,
+		"Connection":          true,
+		"DNS":                 true,
+		"HTTP2":               true,
+		"MergeType":           false,
+		"RateLimit":           false,
+		"BandwidthLimit":      false,
+		"UDPTimeout":          false,
+		"FaultInjection":      false,
+		"AdmissionControl":    true,
+		"AdaptiveConcurrency": false,
+		"UseClientProtocol":   true,
+		"Compression":         false,
+		"Compressor":          false,
+		"Decompression":       false,
+		"ResponseOverride":    false,
+		"HTTPUpgrade":         false,
+		"RequestBuffer":       false,
+		"Cache":               false,
+		"Telemetry":           false,
+		"RoutingType":         false,
 	}
 
 	actualFields := structFieldNames(reflect.TypeOf(egv1a1.BackendTrafficPolicySpec{}), map[string]bool{"PolicyTargetReferences": true})
@@ -74,6 +74,11 @@ func translateTrafficFeatures(policy *egv1a1.ClusterSettings) (*ir.TrafficFeatur
 		ret.HTTP2 = h2
 	}
 
+	// Request hedging and the retry host selection only apply to the retries of the routes.
+	if r := policy.Retry; r != nil && (r.Hedge != nil || r.HostSelection != nil) {
+		return nil, fmt.Errorf("retry hedge and hostSelection are only supported in BackendTrafficPolicy")
+	}
+
 	var err error
 	if ret.Retry, err = buildRetry(policy.Retry); err != nil {
 		return nil, err
@@ -725,6 +730,7 @@ func translateDNS(policy *egv1a1.ClusterSettings, policyName string) *ir.DNS {
 		LookupFamily:  policy.DNS.LookupFamily,
 		RespectDNSTTL: policy.DNS.RespectDNSTTL,
 		Name:          policyName,
+		Resolver:      buildDNSResolver(policy.DNS.Resolver),
 	}
 
 	if policy.DNS.DNSRefreshRate != nil {
@@ -736,6 +742,24 @@ func translateDNS(policy *egv1a1.ClusterSettings, policyName string) *ir.DNS {
 	return irDNS
 }
 
+func buildDNSResolver(resolver *egv1a1.DNSResolver) *ir.DNSResolver {
+	if resolver == nil {
+		return nil
+	}
+	irResolver := &ir.DNSResolver{
+		Type:                  ptr.Deref(resolver.Type, egv1a1.CAresDNSResolverType),
+		UseTCP:                ptr.Deref(resolver.UseTCP, false),
+		NoDefaultSearchDomain: ptr.Deref(resolver.NoDefaultSearchDomain, false),
+	}
+	for _, address := range resolver.Addresses {
+		irResolver.Addresses = append(irResolver.Addresses, &ir.DNSResolverAddress{
+			Host: address.Address,
+			Port: uint32(address.Port),
+		})
+	}
+	return irResolver
+}
+
 func buildRetry(r *egv1a1.Retry) (*ir.Retry, error) {
 	if r == nil {
 		return nil, nil
@@ -813,6 +837,30 @@ func buildRetry(r *egv1a1.Retry) (*ir.Retry, error) {
 		}
 	}
 
+	if r.Hedge != nil {
+		if rt.PerRetry == nil || rt.PerRetry.Timeout == nil {
+			return nil, fmt.Errorf("perRetry.timeout must be set when hedge is set")
+		}
+		if len(r.Hedge.Methods) == 0 {
+			return nil, fmt.Errorf("hedge.methods must be set when hedge is set")
+		}
+		hp := &ir.HedgePolicy{}
+		for _, method := range r.Hedge.Methods {
+			hp.Methods = append(hp.Methods, string(method))
+		}
+		rt.Hedge = hp
+	}
+
+	if r.HostSelection != nil {
+		hs := &ir.RetryHostSelection{
+			AvoidPreviousHosts: ptr.Deref(r.HostSelection.AvoidPreviousHosts, true),
+		}
+		if r.HostSelection.MaxAttempts != nil {
+			hs.MaxAttempts = new(uint32(*r.HostSelection.MaxAttempts))
+		}
+		rt.HostSelection = hs
+	}
+
 	return rt, nil
 }
 
@@ -14,6 +14,8 @@ import (
 	"time"
 
 	perr "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,6 +39,9 @@ const (
 	ociURLPrefix = "oci://"
 	// LuaConfigMapKey is the key used in ConfigMaps to store Lua scripts
 	LuaConfigMapKey = "lua"
+	// GRPCJSONTranscoderProtoDescriptorKey is the key used in ConfigMaps and Secrets
+	// to store the proto descriptor set of the gRPC-JSON transcoder
+	GRPCJSONTranscoderProtoDescriptorKey = "descriptor.pb"
 )
 
 // deprecatedFieldsUsedInEnvoyExtensionPolicy returns a map of deprecated field paths to their alternatives.
@@ -1049,8 +1054,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 	var (
 		wasms                                                 []ir.Wasm
 		luas                                                  []ir.Lua
+		transcoder                                            *ir.GRPCJSONTranscoder
 		wasmFailOpen, extProcFailOpen                         bool
 		wasmError, luaError, extProcError, dynamicModuleError error
+		transcoderError                                       error
 		errs                                                  error
 	)
 
@@ -1058,6 +1065,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 		wasmError = perr.WithMessage(wasmError, "Wasm")
 		errs = errors.Join(errs, wasmError)
 	}
+	if transcoder, transcoderError = t.buildGRPCJSONTranscoder(policy, owners); transcoderError != nil {
+		transcoderError = perr.WithMessage(transcoderError, "GRPCJSONTranscoder")
+		errs = errors.Join(errs, transcoderError)
+	}
 
 	// Apply IR to all relevant routes
 	prefix := irRoutePrefix(route)
@@ -1150,6 +1161,9 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 						if dynamicModuleError != nil {
 							failRoute = true
 						}
+						if transcoderError != nil {
+							failRoute = true
+						}
 						if failRoute {
 							r.DirectResponse = &ir.CustomResponse{
 								StatusCode: new(uint32(500)),
@@ -1157,10 +1171,11 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 							routesWithDirectResponse.Insert(r.Name)
 						} else {
 							r.EnvoyExtensions = &ir.EnvoyExtensionFeatures{
-								ExtProcs:       extProcs,
-								Wasms:          wasms,
-								Luas:           luas,
-								DynamicModules: dynamicModules,
+								ExtProcs:           extProcs,
+								Wasms:              wasms,
+								Luas:               luas,
+								DynamicModules:     dynamicModules,
+								GRPCJSONTranscoder: transcoder,
 							}
 						}
 					}
@@ -1224,8 +1239,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForListeners(
 		wasms                                                 []ir.Wasm
 		luas                                                  []ir.Lua
 		dynamicModules                                        []ir.DynamicModule
+		transcoder                                            *ir.GRPCJSONTranscoder
 		wasmFailOpen, extProcFailOpen                         bool
 		wasmError, luaError, extProcError, dynamicModuleError error
+		transcoderError                                       error
 		errs                                                  error
 	)
 
@@ -1246,6 +1263,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForListeners(
 		dynamicModuleError = perr.WithMessage(dynamicModuleError, "DynamicModule")
 		errs = errors.Join(errs, dynamicModuleError)
 	}
+	if transcoder, transcoderError = t.buildGRPCJSONTranscoder(policy, noOwners); transcoderError != nil {
+		transcoderError = perr.WithMessage(transcoderError, "GRPCJSONTranscoder")
+		errs = errors.Join(errs, transcoderError)
+	}
 
 	irKey := t.getIRKey(gateway.Gateway)
 	// Should exist since we've validated this
@@ -1285,6 +1306,9 @@ func (t *Translator) translateEnvoyExtensionPolicyForListeners(
 			if dynamicModuleError != nil {
 				failRoute = true
 			}
+			if transcoderError != nil {
+				failRoute = true
+			}
 			if failRoute {
 				r.DirectResponse = &ir.CustomResponse{
 					StatusCode: new(uint32(500)),
@@ -1292,10 +1316,11 @@ func (t *Translator) translateEnvoyExtensionPolicyForListeners(
 				routesWithDirectResponse.Insert(r.Name)
 			} else {
 				r.EnvoyExtensions = &ir.EnvoyExtensionFeatures{
-					ExtProcs:       extProcs,
-					Wasms:          wasms,
-					Luas:           luas,
-					DynamicModules: dynamicModules,
+					ExtProcs:           extProcs,
+					Wasms:              wasms,
+					Luas:               luas,
+					DynamicModules:     dynamicModules,
+					GRPCJSONTranscoder: transcoder,
 				}
 			}
 		}
@@ -1345,6 +1370,11 @@ func (t *Translator) buildLua(
 	lua egv1a1.Lua,
 	envoyProxy *egv1a1.EnvoyProxy,
 ) (*ir.Lua, error) {
+	// The filter executed on match can't be configured per route, so it can't get a filter context.
+	if lua.Match != nil && lua.FilterContext != nil {
+		return nil, fmt.Errorf("filterContext can't be set together with match in lua with name %v", name)
+	}
+
 	var luaCode *string
 	var err error
 	if lua.Type == egv1a1.LuaValueTypeValueRef {
@@ -1359,10 +1389,15 @@ func (t *Translator) buildLua(
 	if err = luavalidator.NewLuaValidator(*luaCode, envoyProxy).Validate(); err != nil {
 		return nil, fmt.Errorf("validation failed for lua body in policy with name %v: %w", name, err)
 	}
+	match, err := buildExtensionMatch(lua.Match)
+	if err != nil {
+		return nil, err
+	}
 	return &ir.Lua{
 		Name:          name,
 		Code:          luaCode,
 		FilterContext: lua.FilterContext,
+		Match:         match,
 	}, nil
 }
 
@@ -1392,6 +1427,125 @@ func (t *Translator) getLuaBodyFromLocalObjectReference(
 	}
 }
 
+func (t *Translator) buildGRPCJSONTranscoder(
+	policy *egv1a1.EnvoyExtensionPolicy,
+	owners *envoyExtensionPolicyOwners,
+) (*ir.GRPCJSONTranscoder, error) {
+	if policy == nil || policy.Spec.GRPCJSONTranscoder == nil {
+		return nil, nil
+	}
+
+	ownerPolicy := policyOwnerOr(owners.grpcJSONTranscoder, policy)
+	transcoder := ownerPolicy.Spec.GRPCJSONTranscoder
+	descriptor, err := t.getProtoDescriptorFromLocalObjectReference(&transcoder.ProtoDescriptorRef, ownerPolicy.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateProtoDescriptorServices(descriptor, transcoder.Services); err != nil {
+		return nil, err
+	}
+
+	transcoderIR := &ir.GRPCJSONTranscoder{
+		Name:            irConfigNameForGRPCJSONTranscoder(ownerPolicy),
+		ProtoDescriptor: ir.PrivateBytes(descriptor),
+		Services:        transcoder.Services,
+	}
+	if po := transcoder.PrintOptions; po != nil {
+		transcoderIR.PrintOptions = &ir.GRPCJSONTranscoderPrintOptions{
+			AddWhitespace:              ptr.Deref(po.AddWhitespace, false),
+			AlwaysPrintPrimitiveFields: ptr.Deref(po.AlwaysPrintPrimitiveFields, false),
+			AlwaysPrintEnumsAsInts:     ptr.Deref(po.AlwaysPrintEnumsAsInts, false),
+			PreserveProtoFieldNames:    ptr.Deref(po.PreserveProtoFieldNames, false),
+		}
+	}
+	if transcoder.UnknownQueryParameters != nil {
+		switch *transcoder.UnknownQueryParameters {
+		case egv1a1.GRPCJSONTranscoderUnknownQueryParametersIgnore:
+			transcoderIR.IgnoreUnknownQueryParameters = true
+		case egv1a1.GRPCJSONTranscoderUnknownQueryParametersReject:
+			transcoderIR.RejectUnknownQueryParameters = true
+		}
+	}
+	return transcoderIR, nil
+}
+
+// getProtoDescriptorFromLocalObjectReference assumes the local object reference points to
+// a Kubernetes ConfigMap or Secret.
+func (t *Translator) getProtoDescriptorFromLocalObjectReference(
+	descriptorRef *gwapiv1.LocalObjectReference,
+	policyNs string,
+) ([]byte, error) {
+	kind := string(descriptorRef.Kind)
+	var binaryData map[string][]byte
+	switch kind {
+	case resource.KindConfigMap:
+		cm := t.GetConfigMap(policyNs, string(descriptorRef.Name))
+		if cm == nil {
+			return nil, fmt.Errorf("can't find the referenced configmap %s in namespace %s", descriptorRef.Name, policyNs)
+		}
+		binaryData = cm.BinaryData
+		if len(binaryData) == 0 {
+			binaryData = make(map[string][]byte, len(cm.Data))
+			for k, v := range cm.Data {
+				binaryData[k] = []byte(v)
+			}
+		}
+	case resource.KindSecret:
+		secret := t.GetSecret(policyNs, string(descriptorRef.Name))
+		if secret == nil {
+			return nil, fmt.Errorf("can't find the referenced secret %s in namespace %s", descriptorRef.Name, policyNs)
+		}
+		binaryData = secret.Data
+	default:
+		return nil, fmt.Errorf("unsupported proto descriptor reference kind %s", kind)
+	}
+
+	b, ok := binaryData[GRPCJSONTranscoderProtoDescriptorKey]
+	switch {
+	case ok:
+		return b, nil
+	case len(binaryData) == 1: // Fallback to the only key if descriptor.pb is not found
+		for _, value := range binaryData {
+			b = value
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("can't find the key %s in the referenced %s %s",
+		GRPCJSONTranscoderProtoDescriptorKey, strings.ToLower(kind), descriptorRef.Name)
+}
+
+// validateProtoDescriptorServices checks that the descriptor is a valid FileDescriptorSet
+// and that it defines all the given services.
+func validateProtoDescriptorServices(descriptor []byte, services []string) error {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptor, fds); err != nil {
+		return fmt.Errorf("invalid proto descriptor set: %w", err)
+	}
+
+	defined := sets.New[string]()
+	for _, file := range fds.GetFile() {
+		for _, svc := range file.GetService() {
+			if file.GetPackage() != "" {
+				defined.Insert(file.GetPackage() + "." + svc.GetName())
+			} else {
+				defined.Insert(svc.GetName())
+			}
+		}
+	}
+
+	var errs error
+	for _, svc := range services {
+		if !defined.Has(svc) {
+			errs = errors.Join(errs, fmt.Errorf("service %s is not defined in the proto descriptor set", svc))
+		}
+	}
+	return errs
+}
+
+func irConfigNameForGRPCJSONTranscoder(policy *egv1a1.EnvoyExtensionPolicy) string {
+	return fmt.Sprintf("%s/grpc-json-transcoder", irConfigName(policy))
+}
+
 func (t *Translator) buildExtProcs(
 	policy *egv1a1.EnvoyExtensionPolicy,
 	owners *envoyExtensionPolicyOwners,
@@ -1467,11 +1621,17 @@ func (t *Translator) buildExtProc(
 		return nil, err
 	}
 
+	match, err := buildExtensionMatch(extProc.Match)
+	if err != nil {
+		return nil, err
+	}
+
 	extProcIR := &ir.ExtProc{
 		Name:        name,
 		Destination: *rd,
 		Traffic:     traffic,
 		Authority:   authority,
+		Match:       match,
 	}
 
 	if extProc.MessageTimeout != nil {
@@ -1602,8 +1762,74 @@ func (t *Translator) buildWasm(
 	idx int,
 	resources *resource.Resources,
 ) (*ir.Wasm, error) {
+	failOpen := false
+	if config.FailOpen != nil {
+		failOpen = *config.FailOpen
+	}
+
+	match, err := buildExtensionMatch(config.Match)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := t.buildWasmCode(&config.Code, policy, resource.KindEnvoyExtensionPolicy, irConfigNameForWasm(policy, idx), resources)
+	if err != nil {
+		return nil, err
+	}
+
+	wasmName := name
+	if config.Name != nil {
+		wasmName = *config.Name
+	}
+	wasmIR := &ir.Wasm{
+		Name:     name,
+		RootID:   config.RootID,
+		WasmName: wasmName,
+		Config:   config.Config,
+		FailOpen: failOpen,
+		Code:     code,
+		Match:    match,
+	}
+
+	if config.Env != nil && len(config.Env.HostKeys) > 0 {
+		wasmIR.HostKeys = config.Env.HostKeys
+	}
+
+	return wasmIR, nil
+}
+
+// buildExtensionMatch builds the conditions of a request for an extension to be executed.
+func buildExtensionMatch(match *egv1a1.ExtensionMatch) (*ir.ExtensionMatch, error) {
+	if match == nil {
+		return nil, nil
+	}
+
+	irMatch := &ir.ExtensionMatch{
+		Headers: match.Headers,
+		Path:    match.Path,
+		Methods: match.Methods,
+	}
+	if match.CEL != nil {
+		if !validCELExpression(string(*match.CEL)) {
+			return nil, fmt.Errorf("invalid CEL expression in match: %s", *match.CEL)
+		}
+		irMatch.CEL = new(string(*match.CEL))
+	}
+	return irMatch, nil
+}
+
+// buildWasmCode fetches the Wasm code through the Wasm cache, and returns the
+// URL from which the Envoy proxies download the cached Wasm code.
+// The policyKind is the kind of the policy that references the Wasm code, it's
+// used to validate the cross-namespace references.
+func (t *Translator) buildWasmCode(
+	source *egv1a1.WasmCodeSource,
+	policy client.Object,
+	policyKind string,
+	resourceName string,
+	resources *resource.Resources,
+) (*ir.HTTPWasmCode, error) {
 	var (
-		failOpen   = false
 		code       *ir.HTTPWasmCode
 		pullPolicy wasm.PullPolicy
 		// the checksum provided by the user, it's used to validate the wasm module
@@ -1614,12 +1840,12 @@ func (t *Translator) buildWasm(
 		err              error
 	)
 
-	if config.FailOpen != nil {
-		failOpen = *config.FailOpen
+	if t.WasmCache == nil {
+		return nil, fmt.Errorf("wasm cache is not initialized")
 	}
 
-	if config.Code.PullPolicy != nil {
-		switch *config.Code.PullPolicy {
+	if source.PullPolicy != nil {
+		switch *source.PullPolicy {
 		case egv1a1.ImagePullPolicyAlways:
 			pullPolicy = wasm.Always
 		case egv1a1.ImagePullPolicyIfNotPresent:
@@ -1629,27 +1855,28 @@ func (t *Translator) buildWasm(
 		}
 	}
 
-	switch config.Code.Type {
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      policyKind,
+		namespace: policy.GetNamespace(),
+	}
+
+	switch source.Type {
 	case egv1a1.HTTPWasmCodeSourceType:
 		var checksum string
 
 		// This is a sanity check, the validation should have caught this
-		if config.Code.HTTP == nil {
+		if source.HTTP == nil {
 			return nil, fmt.Errorf("missing HTTP field in Wasm code source")
 		}
 
-		if config.Code.HTTP.SHA256 != nil {
-			originalChecksum = *config.Code.HTTP.SHA256
+		if source.HTTP.SHA256 != nil {
+			originalChecksum = *source.HTTP.SHA256
 		}
 
-		http := config.Code.HTTP
+		http := source.HTTP
 
 		if http.TLS != nil {
-			from := crossNamespaceFrom{
-				group:     egv1a1.GroupName,
-				kind:      resource.KindEnvoyExtensionPolicy,
-				namespace: policy.Namespace,
-			}
 			if caCert, err = t.validateAndGetDataAtKeyInRef(http.TLS.CACertificateRef, resources, from, "ca.crt"); err != nil {
 				return nil, err
 			}
@@ -1658,8 +1885,8 @@ func (t *Translator) buildWasm(
 		if servingURL, checksum, err = t.WasmCache.Get(http.URL, &wasm.GetOptions{
 			Checksum:        originalChecksum,
 			PullPolicy:      pullPolicy,
-			ResourceName:    irConfigNameForWasm(policy, idx),
-			ResourceVersion: policy.ResourceVersion,
+			ResourceName:    resourceName,
+			ResourceVersion: policy.GetResourceVersion(),
 			CACert:          caCert,
 		}); err != nil {
 			return nil, err
@@ -1673,7 +1900,7 @@ func (t *Translator) buildWasm(
 
 	case egv1a1.ImageWasmCodeSourceType:
 		var (
-			image      = config.Code.Image
+			image      = source.Image
 			secret     *corev1.Secret
 			pullSecret []byte
 			// the checksum of the wasm module extracted from the OCI image
@@ -1687,23 +1914,12 @@ func (t *Translator) buildWasm(
 		}
 
 		if image.TLS != nil {
-			from := crossNamespaceFrom{
-				group:     egv1a1.GroupName,
-				kind:      resource.KindEnvoyExtensionPolicy,
-				namespace: policy.Namespace,
-			}
 			if caCert, err = t.validateAndGetDataAtKeyInRef(image.TLS.CACertificateRef, resources, from, "ca.crt"); err != nil {
 				return nil, err
 			}
 		}
 
 		if image.PullSecretRef != nil {
-			from := crossNamespaceFrom{
-				group:     egv1a1.GroupName,
-				kind:      resource.KindEnvoyExtensionPolicy,
-				namespace: policy.Namespace,
-			}
-
 			if secret, err = t.validateSecretRef(
 				true, from, *image.PullSecretRef, resources); err != nil {
 				return nil, err
@@ -1727,8 +1943,8 @@ func (t *Translator) buildWasm(
 			imageURL += ":latest"
 		}
 
-		if config.Code.Image.SHA256 != nil {
-			originalChecksum = *config.Code.Image.SHA256
+		if image.SHA256 != nil {
+			originalChecksum = *image.SHA256
 		}
 
 		// The wasm checksum is different from the OCI image digest.
@@ -1739,8 +1955,8 @@ func (t *Translator) buildWasm(
 			Checksum:        originalChecksum,
 			PullSecret:      pullSecret,
 			PullPolicy:      pullPolicy,
-			ResourceName:    irConfigNameForWasm(policy, idx),
-			ResourceVersion: policy.ResourceVersion,
+			ResourceName:    resourceName,
+			ResourceVersion: policy.GetResourceVersion(),
 			CACert:          caCert,
 		}); err != nil {
 			return nil, err
@@ -1753,27 +1969,10 @@ func (t *Translator) buildWasm(
 		}
 	default:
 		// should never happen because of kubebuilder validation, just a sanity check
-		return nil, fmt.Errorf("unsupported Wasm code source type %q", config.Code.Type)
-	}
-
-	wasmName := name
-	if config.Name != nil {
-		wasmName = *config.Name
-	}
-	wasmIR := &ir.Wasm{
-		Name:     name,
-		RootID:   config.RootID,
-		WasmName: wasmName,
-		Config:   config.Config,
-		FailOpen: failOpen,
-		Code:     code,
+		return nil, fmt.Errorf("unsupported Wasm code source type %q", source.Type)
 	}
 
-	if config.Env != nil && len(config.Env.HostKeys) > 0 {
-		wasmIR.HostKeys = config.Env.HostKeys
-	}
-
-	return wasmIR, nil
+	return code, nil
 }
 
 func hasDigest(imageURL string) bool {
@@ -1843,36 +2042,8 @@ func (t *Translator) buildDynamicModules(
 			TerminalFilter: ptr.Deref(dm.TerminalFilter, false),
 		}
 
-		switch sourceType := ptr.Deref(entry.Source.Type, egv1a1.LocalDynamicModuleSourceType); sourceType {
-		case egv1a1.RemoteDynamicModuleSourceType:
-			if entry.Source.Remote == nil {
-				errs = errors.Join(errs, fmt.Errorf("dynamic module %q has no remote source configured", dm.Name))
-				continue
-			}
-			if entry.Source.Remote.URL == "" {
-				errs = errors.Join(errs, fmt.Errorf("dynamic module %q has no remote source URL configured", dm.Name))
-				continue
-			}
-			if entry.Source.Remote.SHA256 == "" {
-				errs = errors.Join(errs, fmt.Errorf("dynamic module %q has no remote source SHA256 configured", dm.Name))
-				continue
-			}
-			if err := validateDynamicModuleRemoteURL(entry.Source.Remote.URL); err != nil {
-				errs = errors.Join(errs, fmt.Errorf("dynamic module %q has invalid remote source URL %q: %w", dm.Name, entry.Source.Remote.URL, err))
-				continue
-			}
-			dmIR.Remote = &ir.RemoteDynamicModuleSource{
-				URL:    entry.Source.Remote.URL,
-				SHA256: entry.Source.Remote.SHA256,
-			}
-		case egv1a1.LocalDynamicModuleSourceType:
-			if entry.Source.Local == nil {
-				errs = errors.Join(errs, fmt.Errorf("dynamic module %q has no local source configured", dm.Name))
-				continue
-			}
-			dmIR.Path = entry.Source.Local.Path
-		default:
-			errs = errors.Join(errs, fmt.Errorf("dynamic module %q has unsupported source type %q", dm.Name, sourceType))
+		if err := setDynamicModuleSource(&dmIR, entry); err != nil {
+			errs = errors.Join(errs, err)
 			continue
 		}
 
@@ -1882,11 +2053,44 @@ func (t *Translator) buildDynamicModules(
 	return dmIRList, errs
 }
 
+// setDynamicModuleSource sets the source of the dynamic module IR from the
+// module registered in the EnvoyProxy dynamicModules allowlist.
+func setDynamicModuleSource(dmIR *ir.DynamicModule, entry *egv1a1.DynamicModuleEntry) error {
+	switch sourceType := ptr.Deref(entry.Source.Type, egv1a1.LocalDynamicModuleSourceType); sourceType {
+	case egv1a1.RemoteDynamicModuleSourceType:
+		if entry.Source.Remote == nil {
+			return fmt.Errorf("dynamic module %q has no remote source configured", entry.Name)
+		}
+		if entry.Source.Remote.URL == "" {
+			return fmt.Errorf("dynamic module %q has no remote source URL configured", entry.Name)
+		}
+		if entry.Source.Remote.SHA256 == "" {
+			return fmt.Errorf("dynamic module %q has no remote source SHA256 configured", entry.Name)
+		}
+		if err := validateDynamicModuleRemoteURL(entry.Source.Remote.URL); err != nil {
+			return fmt.Errorf("dynamic module %q has invalid remote source URL %q: %w", entry.Name, entry.Source.Remote.URL, err)
+		}
+		dmIR.Remote = &ir.RemoteDynamicModuleSource{
+			URL:    entry.Source.Remote.URL,
+			SHA256: entry.Source.Remote.SHA256,
+		}
+	case egv1a1.LocalDynamicModuleSourceType:
+		if entry.Source.Local == nil {
+			return fmt.Errorf("dynamic module %q has no local source configured", entry.Name)
+		}
+		dmIR.Path = entry.Source.Local.Path
+	default:
+		return fmt.Errorf("dynamic module %q has unsupported source type %q", entry.Name, sourceType)
+	}
+	return nil
+}
+
 type envoyExtensionPolicyOwners struct {
-	wasm          *egv1a1.EnvoyExtensionPolicy
-	extProc       *egv1a1.EnvoyExtensionPolicy
-	lua           *egv1a1.EnvoyExtensionPolicy
-	dynamicModule *egv1a1.EnvoyExtensionPolicy
+	wasm               *egv1a1.EnvoyExtensionPolicy
+	extProc            *egv1a1.EnvoyExtensionPolicy
+	lua                *egv1a1.EnvoyExtensionPolicy
+	dynamicModule      *egv1a1.EnvoyExtensionPolicy
+	grpcJSONTranscoder *egv1a1.EnvoyExtensionPolicy
 }
 
 // mergeEnvoyExtensionPolicy merges a route-level EnvoyExtensionPolicy with a parent (Gateway/Listener) EnvoyExtensionPolicy.
@@ -1919,5 +2123,8 @@ func buildEnvoyExtensionPolicyOwners(route, parent *egv1a1.EnvoyExtensionPolicy)
 		dynamicModule: ownerOf(route, parent, func(p *egv1a1.EnvoyExtensionPolicy) bool {
 			return len(p.Spec.DynamicModule) > 0
 		}),
+		grpcJSONTranscoder: ownerOf(route, parent, func(p *egv1a1.EnvoyExtensionPolicy) bool {
+			return p.Spec.GRPCJSONTranscoder != nil
+		}),
 	}
 }
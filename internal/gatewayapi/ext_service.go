@@ -162,6 +162,7 @@ func (t *Translator) processExtServiceDestination(
 	}
 
 	ds.TLS = backendTLS
+	setDefaultDNSResolver(ds, gtwCtx.envoyProxy)
 
 	// TODO: support weighted non-xRoute backends
 	if backendRef.Weight != nil {
@@ -6,6 +6,7 @@
 package gatewayapi
 
 import (
+	"errors"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -39,11 +40,31 @@ func (t *Translator) ProcessGlobalResources(resources *resource.Resources, xdsIR
 		}
 	}
 
+	// Build the TLS config for envoy to connect to the rate limit quota service. If the referenced
+	// secrets are missing, the rate limit quota service cluster isn't created instead of falling
+	// back to plaintext.
+	var errs error
+	if t.RateLimitQuotaTLS != nil {
+		rlqsTLS, err := t.buildRateLimitQuotaTLS()
+		if err != nil {
+			errs = errors.Join(errs, err)
+		}
+		for _, xdsIR := range xdsIRs {
+			if rlqsTLS == nil || !containsRateLimitQuota(xdsIR.HTTP) {
+				continue
+			}
+			if xdsIR.GlobalResources == nil {
+				xdsIR.GlobalResources = &ir.GlobalResources{}
+			}
+			xdsIR.GlobalResources.RateLimitQuotaTLS = rlqsTLS
+		}
+	}
+
 	// Get the envoy client TLS secret. It is used for envoy to establish a TLS connection with control plane components,
 	// including the rate limit server and the wasm HTTP server.
 	envoyTLSSecret := t.GetSecret(t.ControllerNamespace, envoyTLSSecretName)
 	if envoyTLSSecret == nil {
-		return fmt.Errorf("envoy TLS secret %s/%s not found", t.ControllerNamespace, envoyTLSSecretName)
+		return errors.Join(errs, fmt.Errorf("envoy TLS secret %s/%s not found", t.ControllerNamespace, envoyTLSSecretName))
 	}
 
 	for _, xdsIR := range xdsIRs {
@@ -59,7 +80,7 @@ func (t *Translator) ProcessGlobalResources(resources *resource.Resources, xdsIR
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // processServiceClusterForGateway returns the matching IR key for a gateway and builds a RouteDestination to represent the ProxyServiceCluster
@@ -114,6 +135,80 @@ func containsGlobalRateLimit(httpListeners []*ir.HTTPListener) bool {
 	return false
 }
 
+// buildRateLimitQuotaTLS builds the TLS config for envoy to connect to the rate limit quota service.
+// The SNI is set by the xDS translator from the URL of the service.
+func (t *Translator) buildRateLimitQuotaTLS() (*ir.TLSUpstreamConfig, error) {
+	tlsConfig := &ir.TLSUpstreamConfig{
+		TLSConfig: ir.TLSConfig{
+			ALPNProtocols: []string{"h2"},
+		},
+	}
+
+	if ref := t.RateLimitQuotaTLS.CACertificateRef; ref != nil {
+		secret, err := t.getRateLimitQuotaTLSSecret(ref)
+		if err != nil {
+			return nil, err
+		}
+		caCert, ok := secret.Data[CACertKey]
+		if !ok || len(caCert) == 0 {
+			return nil, fmt.Errorf("rate limit quota CA certificate secret %s/%s has no %s", secret.Namespace, secret.Name, CACertKey)
+		}
+		tlsConfig.CACertificate = &ir.TLSCACertificate{
+			Name:        irGlobalConfigName(secret) + "-ca",
+			Certificate: caCert,
+		}
+	} else {
+		name := ir.SystemTrustStoreSecretName
+		if t.PerResourceSystemCASecret {
+			name = "rate-limit-quota-ca"
+		}
+		tlsConfig.UseSystemTrustStore = true
+		tlsConfig.CACertificate = &ir.TLSCACertificate{
+			Name: name,
+		}
+	}
+
+	if ref := t.RateLimitQuotaTLS.ClientCertificateRef; ref != nil {
+		secret, err := t.getRateLimitQuotaTLSSecret(ref)
+		if err != nil {
+			return nil, err
+		}
+		if len(secret.Data[corev1.TLSCertKey]) == 0 || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+			return nil, fmt.Errorf("rate limit quota client certificate secret %s/%s has no %s or %s",
+				secret.Namespace, secret.Name, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+		}
+		tlsConfig.ClientCertificates = []ir.TLSCertificate{{
+			Name:        irGlobalConfigName(secret),
+			Certificate: secret.Data[corev1.TLSCertKey],
+			PrivateKey:  secret.Data[corev1.TLSPrivateKeyKey],
+		}}
+	}
+
+	return tlsConfig, nil
+}
+
+func (t *Translator) getRateLimitQuotaTLSSecret(ref *gwapiv1.SecretObjectReference) (*corev1.Secret, error) {
+	namespace := NamespaceDerefOr(ref.Namespace, t.ControllerNamespace)
+	secret := t.GetSecret(namespace, string(ref.Name))
+	if secret == nil {
+		return nil, fmt.Errorf("rate limit quota TLS secret %s/%s not found", namespace, ref.Name)
+	}
+	return secret, nil
+}
+
+func containsRateLimitQuota(httpListeners []*ir.HTTPListener) bool {
+	for _, httpListener := range httpListeners {
+		for _, route := range httpListener.Routes {
+			if route.Traffic != nil &&
+				route.Traffic.RateLimit != nil &&
+				route.Traffic.RateLimit.Quota != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func containsWasm(httpListeners []*ir.HTTPListener) bool {
 	for _, httpListener := range httpListeners {
 		for _, route := range httpListener.Routes {
@@ -0,0 +1,613 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"io"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+)
+
+// IncrementalTranslator translates the resources of a single GatewayClass,
+// re-translating only the Gateways affected by the resources that changed
+// since the previous translation.
+//
+// Gateways, ListenerSets, Routes, the policies attached to them and the EnvoyProxies
+// referenced by Gateways are tracked individually. They are grouped with the Gateways
+// they are attached to, and a change to any of them re-translates the whole group,
+// so that the statuses of resources attached to several Gateways stay complete.
+// Services, along with their EndpointSlices, and Secrets referenced by the tracked
+// resources are tracked individually as well, and a change to any of them re-translates
+// the groups of the resources referencing them. A change to any other resource, such as
+// a ReferenceGrant or a Secret that isn't referenced by a tracked resource, falls back to
+// a full translation because it may affect any Gateway.
+//
+// An IncrementalTranslator is not safe for concurrent use.
+type IncrementalTranslator struct {
+	// result is the result of the previous translation.
+	result *TranslateResult
+	// index is the dependency index of the resources of the previous translation.
+	index *dependencyIndex
+}
+
+// Translate translates the resources and returns the translation result, which always
+// covers all the resources, along with the IR keys that were re-translated.
+// A nil set of IR keys means that all the IR keys were re-translated.
+func (it *IncrementalTranslator) Translate(t *Translator, resources *resource.Resources) (*TranslateResult, sets.Set[string], error) {
+	index := buildDependencyIndex(resources)
+	prev := it.index
+
+	affected := it.affectedNodes(t, prev, index)
+	if affected == nil {
+		result, err := t.Translate(resources)
+		it.result, it.index = result, index
+		return result, nil, err
+	}
+
+	irKeys := sets.New[string]()
+	for node := range affected {
+		if gw, found := index.gateways[node]; found {
+			irKeys.Insert(irStringKey(gw.Namespace, gw.Name))
+		}
+		if gw, found := prev.gateways[node]; found {
+			irKeys.Insert(irStringKey(gw.Namespace, gw.Name))
+		}
+	}
+
+	if affected.Len() == 0 {
+		it.index = index
+		return it.result, irKeys, nil
+	}
+
+	partial, err := t.Translate(pruneResources(resources, affected))
+	it.result = mergeTranslateResults(it.result, partial, resources, affected, irKeys)
+	it.index = index
+	return it.result, irKeys, err
+}
+
+// Reset drops the state of the previous translation, so that the next translation is a full one.
+func (it *IncrementalTranslator) Reset() {
+	it.result, it.index = nil, nil
+}
+
+// affectedNodes returns the tracked resources which need to be re-translated, or nil if
+// a full translation is required.
+func (it *IncrementalTranslator) affectedNodes(t *Translator, prev, curr *dependencyIndex) sets.Set[string] {
+	if it.result == nil || prev == nil || t.MergeGateways ||
+		prev.dependsOnAll || curr.dependsOnAll || prev.global != curr.global {
+		return nil
+	}
+
+	var changed []string
+	for node, fp := range curr.fingerprints {
+		if prevFp, found := prev.fingerprints[node]; !found || prevFp != fp {
+			changed = append(changed, node)
+		}
+	}
+	for node := range prev.fingerprints {
+		if _, found := curr.fingerprints[node]; !found {
+			changed = append(changed, node)
+		}
+	}
+	// A changed Service or Secret changes the resources which reference it.
+	for leaf, fp := range curr.leaves {
+		if prevFp, found := prev.leaves[leaf]; !found || prevFp != fp {
+			changed = append(changed, prev.dependents[leaf]...)
+			changed = append(changed, curr.dependents[leaf]...)
+		}
+	}
+	for leaf := range prev.leaves {
+		if _, found := curr.leaves[leaf]; !found {
+			changed = append(changed, prev.dependents[leaf]...)
+			changed = append(changed, curr.dependents[leaf]...)
+		}
+	}
+	if len(changed) == 0 {
+		return sets.New[string]()
+	}
+
+	// Both the current and the previous references are followed, so that the resources
+	// a changed resource was detached from are re-translated as well.
+	uf := newUnionFind()
+	for _, index := range []*dependencyIndex{prev, curr} {
+		for node, refs := range index.references {
+			for _, ref := range refs {
+				uf.union(node, ref)
+			}
+		}
+	}
+
+	roots := sets.New[string]()
+	for _, node := range changed {
+		roots.Insert(uf.find(node))
+	}
+	affected := sets.New[string]()
+	for node := range curr.fingerprints {
+		if roots.Has(uf.find(node)) {
+			affected.Insert(node)
+		}
+	}
+	for node := range prev.gateways {
+		if roots.Has(uf.find(node)) {
+			affected.Insert(node)
+		}
+	}
+
+	// Nothing is saved if every Gateway has to be re-translated.
+	allGateways := true
+	for node := range curr.gateways {
+		if !affected.Has(node) {
+			allGateways = false
+			break
+		}
+	}
+	if allGateways {
+		return nil
+	}
+	return affected
+}
+
+// dependencyIndex records the tracked resources of a GatewayClass and the resources they reference.
+type dependencyIndex struct {
+	// fingerprints maps the tracked resources to their fingerprint.
+	fingerprints map[string]string
+	// references maps the tracked resources to the resources they reference.
+	references map[string][]string
+	// gateways maps the Gateway nodes to their Gateway.
+	gateways map[string]*gwapiv1.Gateway
+	// leaves maps the Services and Secrets referenced by the tracked resources to their
+	// fingerprint. The fingerprint of a Service covers its EndpointSlices.
+	leaves map[string]string
+	// dependents maps the Services and Secrets to the tracked resources which may reference them.
+	dependents map[string][]string
+	// global is the fingerprint of all the resources which aren't tracked individually.
+	global string
+	// dependsOnAll is set when a resource may affect any Gateway.
+	dependsOnAll bool
+}
+
+func buildDependencyIndex(resources *resource.Resources) *dependencyIndex {
+	index := &dependencyIndex{
+		fingerprints: make(map[string]string),
+		references:   make(map[string][]string),
+		gateways:     make(map[string]*gwapiv1.Gateway, len(resources.Gateways)),
+		leaves:       make(map[string]string),
+		dependents:   make(map[string][]string),
+	}
+
+	for _, gw := range resources.Gateways {
+		node := nodeKey(resource.KindGateway, gw.Namespace, gw.Name)
+		index.gateways[node] = gw
+		var refs []string
+		if infra := gw.Spec.Infrastructure; infra != nil && infra.ParametersRef != nil &&
+			string(infra.ParametersRef.Kind) == resource.KindEnvoyProxy {
+			refs = append(refs, nodeKey(resource.KindEnvoyProxy, gw.Namespace, infra.ParametersRef.Name))
+		}
+		index.add(node, gw, refs)
+	}
+	for _, ep := range resources.EnvoyProxiesForGateways {
+		index.add(nodeKey(resource.KindEnvoyProxy, ep.Namespace, ep.Name), ep, nil)
+	}
+	for _, ls := range resources.ListenerSets {
+		parent := ls.Spec.ParentRef
+		kind := resource.KindGateway
+		if parent.Kind != nil {
+			kind = string(*parent.Kind)
+		}
+		namespace := ls.Namespace
+		if parent.Namespace != nil {
+			namespace = string(*parent.Namespace)
+		}
+		index.add(nodeKey(resource.KindListenerSet, ls.Namespace, ls.Name), ls,
+			[]string{nodeKey(kind, namespace, string(parent.Name))})
+	}
+
+	for _, route := range resources.HTTPRoutes {
+		index.addRoute(resource.KindHTTPRoute, route, route.Spec.ParentRefs)
+	}
+	for _, route := range resources.GRPCRoutes {
+		index.addRoute(resource.KindGRPCRoute, route, route.Spec.ParentRefs)
+	}
+	for _, route := range resources.TLSRoutes {
+		index.addRoute(resource.KindTLSRoute, route, route.Spec.ParentRefs)
+	}
+	for _, route := range resources.TCPRoutes {
+		index.addRoute(resource.KindTCPRoute, route, route.Spec.ParentRefs)
+	}
+	for _, route := range resources.UDPRoutes {
+		index.addRoute(resource.KindUDPRoute, route, route.Spec.ParentRefs)
+	}
+
+	for _, policy := range resources.ClientTrafficPolicies {
+		index.addPolicy(resource.KindClientTrafficPolicy, policy, policy.Spec.PolicyTargetReferences)
+	}
+	for _, policy := range resources.BackendTrafficPolicies {
+		index.addPolicy(resource.KindBackendTrafficPolicy, policy, policy.Spec.PolicyTargetReferences)
+	}
+	for _, policy := range resources.SecurityPolicies {
+		index.addPolicy(resource.KindSecurityPolicy, policy, policy.Spec.PolicyTargetReferences)
+	}
+	for _, policy := range resources.EnvoyExtensionPolicies {
+		index.addPolicy(resource.KindEnvoyExtensionPolicy, policy, policy.Spec.PolicyTargetReferences)
+	}
+	for _, policy := range resources.EnvoyPatchPolicies {
+		node := nodeKey(resource.KindEnvoyPatchPolicy, policy.Namespace, policy.Name)
+		target := policy.Spec.TargetRef
+		if string(target.Kind) != resource.KindGateway {
+			// A policy targeting the GatewayClass patches every Gateway.
+			index.dependsOnAll = true
+		}
+		index.add(node, policy, []string{nodeKey(string(target.Kind), policy.Namespace, string(target.Name))})
+	}
+
+	// Resources attached to a Service rather than to a Gateway or a Route can affect any Gateway.
+	if len(resources.BackendTLSPolicies) > 0 || len(resources.ExtensionServerPolicies) > 0 {
+		index.dependsOnAll = true
+	}
+
+	index.global = index.globalFingerprint(resources)
+	return index
+}
+
+func (d *dependencyIndex) add(node string, obj metav1.Object, refs []string) {
+	d.fingerprints[node] = fingerprint(obj)
+	d.references[node] = refs
+	for _, leaf := range leafReferences(obj.GetNamespace(), obj) {
+		d.dependents[leaf] = append(d.dependents[leaf], node)
+	}
+}
+
+func (d *dependencyIndex) addRoute(kind string, route metav1.Object, parentRefs []gwapiv1.ParentReference) {
+	if len(parentRefs) == 0 {
+		d.dependsOnAll = true
+	}
+	refs := make([]string, 0, len(parentRefs))
+	for _, parentRef := range parentRefs {
+		parentKind := resource.KindGateway
+		if parentRef.Kind != nil {
+			parentKind = string(*parentRef.Kind)
+		}
+		if parentKind != resource.KindGateway && parentKind != resource.KindListenerSet {
+			d.dependsOnAll = true
+		}
+		namespace := route.GetNamespace()
+		if parentRef.Namespace != nil {
+			namespace = string(*parentRef.Namespace)
+		}
+		refs = append(refs, nodeKey(parentKind, namespace, string(parentRef.Name)))
+	}
+	d.add(nodeKey(kind, route.GetNamespace(), route.GetName()), route, refs)
+}
+
+func (d *dependencyIndex) addPolicy(kind string, policy metav1.Object, targets egv1a1.PolicyTargetReferences) {
+	// The resources selected by labels are only known after translation.
+	if len(targets.TargetSelectors) > 0 {
+		d.dependsOnAll = true
+	}
+	targetRefs := targets.GetTargetRefs()
+	refs := make([]string, 0, len(targetRefs))
+	for _, targetRef := range targetRefs {
+		switch string(targetRef.Kind) {
+		case resource.KindGateway, resource.KindListenerSet, resource.KindHTTPRoute, resource.KindGRPCRoute,
+			resource.KindTLSRoute, resource.KindTCPRoute, resource.KindUDPRoute:
+		default:
+			d.dependsOnAll = true
+		}
+		refs = append(refs, nodeKey(string(targetRef.Kind), policy.GetNamespace(), string(targetRef.Name)))
+	}
+	d.add(nodeKey(kind, policy.GetNamespace(), policy.GetName()), policy, refs)
+}
+
+func nodeKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// fingerprint returns a value which changes whenever the object changes.
+// The resource version is used when it's set, otherwise the object is hashed.
+func fingerprint(obj any) string {
+	if o, ok := obj.(metav1.Object); ok && o.GetResourceVersion() != "" {
+		return o.GetResourceVersion()
+	}
+	h := fnv.New64a()
+	writeHash(h, obj)
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+func writeHash(w io.Writer, obj any) {
+	// Errors are ignored: a value that can't be marshalled hashes to the same
+	// content, which only affects how incremental the translation is.
+	_ = json.NewEncoder(w).Encode(obj)
+}
+
+// leafReferences returns the keys of the Services and Secrets that may be referenced by the object.
+// Every nested object with a name, and without a value, is considered to be a reference to both a
+// Service and a Secret, so that the references are over-approximated rather than missed.
+func leafReferences(namespace string, obj any) []string {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil
+	}
+	var root map[string]any
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil
+	}
+	// The name of the object itself isn't a reference.
+	delete(root, "apiVersion")
+	delete(root, "kind")
+	delete(root, "metadata")
+	delete(root, "status")
+
+	var refs []string
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if name, ok := v["name"].(string); ok {
+				if _, hasValue := v["value"]; !hasValue {
+					ns := namespace
+					if refNs, ok := v["namespace"].(string); ok && refNs != "" {
+						ns = refNs
+					}
+					refs = append(refs, nodeKey(resource.KindService, ns, name), nodeKey(resource.KindSecret, ns, name))
+				}
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(root)
+	return refs
+}
+
+// globalFingerprint returns the fingerprint of all the resources that aren't tracked individually.
+// The Services and the Secrets referenced by the tracked resources are tracked individually as
+// leaves instead, unless they are referenced by the resources shared by all of them. The Services
+// which aren't referenced are leaves too, except the Services of the proxies, which are looked up
+// by labels. The Secrets which aren't referenced, such as the ones used by the control plane,
+// aren't leaves.
+func (d *dependencyIndex) globalFingerprint(resources *resource.Resources) string {
+	global := sets.New[string]()
+	addGlobal := func(namespace string, obj any) {
+		global.Insert(leafReferences(namespace, obj)...)
+	}
+	if resources.GatewayClass != nil {
+		addGlobal("", resources.GatewayClass)
+	}
+	if ep := resources.EnvoyProxyForGatewayClass; ep != nil {
+		addGlobal(ep.Namespace, ep)
+	}
+	addGlobal("", resources.EnvoyProxyDefaultSpec)
+	for i := range resources.ExtensionRefFilters {
+		addGlobal(resources.ExtensionRefFilters[i].GetNamespace(), &resources.ExtensionRefFilters[i])
+	}
+	for _, obj := range resources.Backends {
+		addGlobal(obj.Namespace, obj)
+	}
+	for _, obj := range resources.HTTPRouteFilters {
+		addGlobal(obj.Namespace, obj)
+	}
+
+	h := fnv.New64a()
+	writeHash(h, resources.GatewayClass)
+	writeHash(h, resources.EnvoyProxyForGatewayClass)
+	writeHash(h, resources.EnvoyProxyDefaultSpec)
+
+	write := func(kind string, obj metav1.Object) {
+		_, _ = io.WriteString(h, nodeKey(kind, obj.GetNamespace(), obj.GetName())+"="+fingerprint(obj)+"\n")
+	}
+	for _, obj := range resources.ReferenceGrants {
+		write(resource.KindReferenceGrant, obj)
+	}
+	for _, obj := range resources.Namespaces {
+		write(resource.KindNamespace, obj)
+	}
+	services := make(map[string]hash.Hash64)
+	for _, obj := range resources.Services {
+		key := nodeKey(resource.KindService, obj.Namespace, obj.Name)
+		_, isProxyService := obj.Labels[OwningGatewayClassLabel]
+		if _, found := obj.Labels[OwningGatewayNameLabel]; found {
+			isProxyService = true
+		}
+		if isProxyService || global.Has(key) {
+			write(resource.KindService, obj)
+			continue
+		}
+		services[key] = fnv.New64a()
+		_, _ = io.WriteString(services[key], fingerprint(obj)+"\n")
+	}
+	for _, obj := range resources.ServiceImports {
+		write(resource.KindServiceImport, obj)
+	}
+	for _, obj := range resources.EndpointSlices {
+		if name, ok := obj.Labels[discoveryv1.LabelServiceName]; ok {
+			if sh, found := services[nodeKey(resource.KindService, obj.Namespace, name)]; found {
+				_, _ = io.WriteString(sh, obj.Name+"="+fingerprint(obj)+"\n")
+				continue
+			}
+		}
+		write("EndpointSlice", obj)
+	}
+	for key, sh := range services {
+		d.leaves[key] = fmt.Sprintf("%x", sh.Sum64())
+	}
+	for _, obj := range resources.Secrets {
+		key := nodeKey(resource.KindSecret, obj.Namespace, obj.Name)
+		if _, referenced := d.dependents[key]; referenced && !global.Has(key) {
+			d.leaves[key] = fingerprint(obj)
+			continue
+		}
+		write(resource.KindSecret, obj)
+	}
+	for _, obj := range resources.ConfigMaps {
+		write(resource.KindConfigMap, obj)
+	}
+	for i := range resources.ExtensionRefFilters {
+		write(resources.ExtensionRefFilters[i].GetKind(), &resources.ExtensionRefFilters[i])
+	}
+	for _, obj := range resources.Backends {
+		write(resource.KindBackend, obj)
+	}
+	for _, obj := range resources.HTTPRouteFilters {
+		write(resource.KindHTTPRouteFilter, obj)
+	}
+	for _, obj := range resources.ClusterTrustBundles {
+		write(resource.KindClusterTrustBundle, obj)
+	}
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// pruneResources returns a copy of the resources in which the tracked resources
+// are limited to the affected ones.
+func pruneResources(resources *resource.Resources, affected sets.Set[string]) *resource.Resources {
+	out := *resources
+	out.Gateways = filterNodes(resources.Gateways, resource.KindGateway, affected)
+	out.EnvoyProxiesForGateways = filterNodes(resources.EnvoyProxiesForGateways, resource.KindEnvoyProxy, affected)
+	out.ListenerSets = filterNodes(resources.ListenerSets, resource.KindListenerSet, affected)
+	out.HTTPRoutes = filterNodes(resources.HTTPRoutes, resource.KindHTTPRoute, affected)
+	out.GRPCRoutes = filterNodes(resources.GRPCRoutes, resource.KindGRPCRoute, affected)
+	out.TLSRoutes = filterNodes(resources.TLSRoutes, resource.KindTLSRoute, affected)
+	out.TCPRoutes = filterNodes(resources.TCPRoutes, resource.KindTCPRoute, affected)
+	out.UDPRoutes = filterNodes(resources.UDPRoutes, resource.KindUDPRoute, affected)
+	out.ClientTrafficPolicies = filterNodes(resources.ClientTrafficPolicies, resource.KindClientTrafficPolicy, affected)
+	out.BackendTrafficPolicies = filterNodes(resources.BackendTrafficPolicies, resource.KindBackendTrafficPolicy, affected)
+	out.SecurityPolicies = filterNodes(resources.SecurityPolicies, resource.KindSecurityPolicy, affected)
+	out.EnvoyExtensionPolicies = filterNodes(resources.EnvoyExtensionPolicies, resource.KindEnvoyExtensionPolicy, affected)
+	out.EnvoyPatchPolicies = filterNodes(resources.EnvoyPatchPolicies, resource.KindEnvoyPatchPolicy, affected)
+	return &out
+}
+
+func filterNodes[T metav1.Object](objs []T, kind string, affected sets.Set[string]) []T {
+	out := make([]T, 0, len(objs))
+	for _, obj := range objs {
+		if affected.Has(nodeKey(kind, obj.GetNamespace(), obj.GetName())) {
+			out = append(out, obj)
+		}
+	}
+	return out
+}
+
+// mergeTranslateResults merges the result of translating the affected resources into the
+// previous result, which still holds the translation of the resources that weren't affected.
+func mergeTranslateResults(prev, partial *TranslateResult, resources *resource.Resources,
+	affected, irKeys sets.Set[string],
+) *TranslateResult {
+	merged := &TranslateResult{
+		Resources: partial.Resources,
+		XdsIR:     make(resource.XdsIRMap, len(prev.XdsIR)),
+		InfraIR:   make(resource.InfraIRMap, len(prev.InfraIR)),
+	}
+	merged.Gateways = mergeNodes(prev.Gateways, partial.Gateways, resources.Gateways, resource.KindGateway, affected)
+	merged.EnvoyProxiesForGateways = mergeNodes(prev.EnvoyProxiesForGateways, partial.EnvoyProxiesForGateways,
+		resources.EnvoyProxiesForGateways, resource.KindEnvoyProxy, affected)
+	merged.ListenerSets = mergeNodes(prev.ListenerSets, partial.ListenerSets, resources.ListenerSets, resource.KindListenerSet, affected)
+	merged.HTTPRoutes = mergeNodes(prev.HTTPRoutes, partial.HTTPRoutes, resources.HTTPRoutes, resource.KindHTTPRoute, affected)
+	merged.GRPCRoutes = mergeNodes(prev.GRPCRoutes, partial.GRPCRoutes, resources.GRPCRoutes, resource.KindGRPCRoute, affected)
+	merged.TLSRoutes = mergeNodes(prev.TLSRoutes, partial.TLSRoutes, resources.TLSRoutes, resource.KindTLSRoute, affected)
+	merged.TCPRoutes = mergeNodes(prev.TCPRoutes, partial.TCPRoutes, resources.TCPRoutes, resource.KindTCPRoute, affected)
+	merged.UDPRoutes = mergeNodes(prev.UDPRoutes, partial.UDPRoutes, resources.UDPRoutes, resource.KindUDPRoute, affected)
+	merged.ClientTrafficPolicies = mergeNodes(prev.ClientTrafficPolicies, partial.ClientTrafficPolicies,
+		resources.ClientTrafficPolicies, resource.KindClientTrafficPolicy, affected)
+	merged.BackendTrafficPolicies = mergeNodes(prev.BackendTrafficPolicies, partial.BackendTrafficPolicies,
+		resources.BackendTrafficPolicies, resource.KindBackendTrafficPolicy, affected)
+	merged.SecurityPolicies = mergeNodes(prev.SecurityPolicies, partial.SecurityPolicies,
+		resources.SecurityPolicies, resource.KindSecurityPolicy, affected)
+	merged.EnvoyExtensionPolicies = mergeNodes(prev.EnvoyExtensionPolicies, partial.EnvoyExtensionPolicies,
+		resources.EnvoyExtensionPolicies, resource.KindEnvoyExtensionPolicy, affected)
+	merged.EnvoyPatchPolicies = mergeNodes(prev.EnvoyPatchPolicies, partial.EnvoyPatchPolicies,
+		resources.EnvoyPatchPolicies, resource.KindEnvoyPatchPolicy, affected)
+
+	for key, val := range prev.XdsIR {
+		if !irKeys.Has(key) {
+			merged.XdsIR[key] = val
+		}
+	}
+	for key, val := range partial.XdsIR {
+		merged.XdsIR[key] = val
+	}
+	for key, val := range prev.InfraIR {
+		if !irKeys.Has(key) {
+			merged.InfraIR[key] = val
+		}
+	}
+	for key, val := range partial.InfraIR {
+		merged.InfraIR[key] = val
+	}
+	return merged
+}
+
+// mergeNodes returns the translated objects, in the order of the resources, taking
+// the affected ones from the partial result and the others from the previous result.
+func mergeNodes[T metav1.Object](prev, partial, resources []T, kind string, affected sets.Set[string]) []T {
+	if len(resources) == 0 {
+		return nil
+	}
+	translated := make(map[string]T, len(prev))
+	for _, obj := range prev {
+		if key := nodeKey(kind, obj.GetNamespace(), obj.GetName()); !affected.Has(key) {
+			translated[key] = obj
+		}
+	}
+	for _, obj := range partial {
+		translated[nodeKey(kind, obj.GetNamespace(), obj.GetName())] = obj
+	}
+
+	out := make([]T, 0, len(translated))
+	for _, obj := range resources {
+		if t, found := translated[nodeKey(kind, obj.GetNamespace(), obj.GetName())]; found {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// unionFind groups the resources which reference each other.
+type unionFind struct {
+	parent map[string]string
+}
+
+func newUnionFind() *unionFind {
+	return &unionFind{parent: make(map[string]string)}
+}
+
+func (u *unionFind) find(node string) string {
+	root := node
+	for {
+		parent, found := u.parent[root]
+		if !found || parent == root {
+			break
+		}
+		root = parent
+	}
+	// Compress the path to speed up the following lookups.
+	for node != root {
+		next := u.parent[node]
+		u.parent[node] = root
+		node = next
+	}
+	return root
+}
+
+func (u *unionFind) union(a, b string) {
+	rootA, rootB := u.find(a), u.find(b)
+	if rootA != rootB {
+		u.parent[rootA] = rootB
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+const incrementalTestResources = `
+gateways:
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: Gateway
+  metadata:
+    namespace: envoy-gateway
+    name: gateway-1
+  spec:
+    gatewayClassName: envoy-gateway-class
+    listeners:
+    - name: http
+      protocol: HTTP
+      port: 80
+      allowedRoutes:
+        namespaces:
+          from: All
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: Gateway
+  metadata:
+    namespace: envoy-gateway
+    name: gateway-2
+  spec:
+    gatewayClassName: envoy-gateway-class
+    listeners:
+    - name: http
+      protocol: HTTP
+      port: 80
+      allowedRoutes:
+        namespaces:
+          from: All
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: Gateway
+  metadata:
+    namespace: envoy-gateway
+    name: gateway-3
+  spec:
+    gatewayClassName: envoy-gateway-class
+    listeners:
+    - name: http
+      protocol: HTTP
+      port: 80
+      allowedRoutes:
+        namespaces:
+          from: All
+httpRoutes:
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    namespace: default
+    name: route-1
+  spec:
+    parentRefs:
+    - namespace: envoy-gateway
+      name: gateway-1
+    hostnames:
+    - route-1.example.com
+    rules:
+    - backendRefs:
+      - name: service-2
+        port: 8080
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    namespace: default
+    name: route-2
+  spec:
+    parentRefs:
+    - namespace: envoy-gateway
+      name: gateway-2
+    hostnames:
+    - route-2.example.com
+    rules:
+    - backendRefs:
+      - name: service-1
+        port: 8080
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    namespace: default
+    name: route-3
+  spec:
+    parentRefs:
+    - namespace: envoy-gateway
+      name: gateway-2
+    - namespace: envoy-gateway
+      name: gateway-3
+    hostnames:
+    - route-3.example.com
+    rules:
+    - backendRefs:
+      - name: service-1
+        port: 8080
+backendTrafficPolicies:
+- apiVersion: gateway.envoyproxy.io/v1alpha1
+  kind: BackendTrafficPolicy
+  metadata:
+    namespace: default
+    name: policy-1
+  spec:
+    targetRefs:
+    - group: gateway.networking.k8s.io
+      kind: HTTPRoute
+      name: route-1
+    timeout:
+      http:
+        requestTimeout: 10s
+services:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    namespace: default
+    name: service-1
+  spec:
+    clusterIP: 1.1.1.1
+    ports:
+    - name: http
+      port: 8080
+      protocol: TCP
+- apiVersion: v1
+  kind: Service
+  metadata:
+    namespace: default
+    name: service-2
+  spec:
+    clusterIP: 2.2.2.2
+    ports:
+    - name: http
+      port: 8080
+      protocol: TCP
+endpointSlices:
+- apiVersion: discovery.k8s.io/v1
+  kind: EndpointSlice
+  metadata:
+    namespace: default
+    name: service-2-abcde
+    labels:
+      kubernetes.io/service-name: service-2
+  addressType: IPv4
+  ports:
+  - name: http
+    port: 8080
+    protocol: TCP
+  endpoints:
+  - addresses:
+    - 10.0.0.1
+    conditions:
+      ready: true
+`
+
+func newIncrementalTestTranslator(resources *resource.Resources) *Translator {
+	return &Translator{
+		GatewayControllerName: egv1a1.GatewayControllerName,
+		GatewayClassName:      "envoy-gateway-class",
+		BackendEnabled:        true,
+		ControllerNamespace:   "envoy-gateway-system",
+		MergeGateways:         IsMergeGatewaysEnabled(resources),
+		MergeBackends:         ResolveMergeBackendsConfig(resources),
+		WasmCache:             &mockWasmCache{},
+		Logger:                logging.DefaultLogger(io.Discard, egv1a1.LogLevelInfo),
+	}
+}
+
+// loadIncrementalTestResources loads the resources merged with the base resources.
+func loadIncrementalTestResources(t *testing.T, input []byte) *resource.Resources {
+	base, err := os.ReadFile("testdata/base/base.yaml")
+	require.NoError(t, err)
+	baseResources := &resource.Resources{}
+	mustUnmarshal(t, base, baseResources)
+
+	resources := &resource.Resources{}
+	mustUnmarshal(t, input, resources)
+	resources.Secrets = append(resources.Secrets, baseResources.Secrets...)
+	return resources
+}
+
+func TestIncrementalTranslator(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mutate func(resources *resource.Resources)
+		// expected is the set of re-translated IR keys, nil for a full translation.
+		expected sets.Set[string]
+	}{
+		{
+			name:     "no changes",
+			mutate:   func(*resource.Resources) {},
+			expected: sets.New[string](),
+		},
+		{
+			name: "route attached to one gateway",
+			mutate: func(resources *resource.Resources) {
+				resources.HTTPRoutes[0].Spec.Hostnames = []gwapiv1.Hostname{"updated.example.com"}
+			},
+			expected: sets.New("envoy-gateway/gateway-1"),
+		},
+		{
+			name: "policy attached to a route",
+			mutate: func(resources *resource.Resources) {
+				resources.BackendTrafficPolicies[0].Spec.Timeout.HTTP.RequestTimeout = new(gwapiv1.Duration("20s"))
+			},
+			expected: sets.New("envoy-gateway/gateway-1"),
+		},
+		{
+			name: "route attached to a gateway shared with another route",
+			mutate: func(resources *resource.Resources) {
+				resources.HTTPRoutes[1].Spec.Hostnames = []gwapiv1.Hostname{"updated.example.com"}
+			},
+			expected: sets.New("envoy-gateway/gateway-2", "envoy-gateway/gateway-3"),
+		},
+		{
+			name: "route deleted",
+			mutate: func(resources *resource.Resources) {
+				resources.HTTPRoutes = resources.HTTPRoutes[1:]
+			},
+			expected: sets.New("envoy-gateway/gateway-1"),
+		},
+		{
+			name: "route moved to another gateway",
+			mutate: func(resources *resource.Resources) {
+				resources.HTTPRoutes[0].Spec.ParentRefs[0].Name = "gateway-2"
+			},
+			expected: nil,
+		},
+		{
+			name: "gateway deleted",
+			mutate: func(resources *resource.Resources) {
+				resources.Gateways = resources.Gateways[:1]
+				resources.HTTPRoutes = resources.HTTPRoutes[:1]
+			},
+			expected: sets.New("envoy-gateway/gateway-2", "envoy-gateway/gateway-3"),
+		},
+		{
+			name: "service shared by routes updated",
+			mutate: func(resources *resource.Resources) {
+				resources.Services[0].Spec.ClusterIP = "3.3.3.3"
+			},
+			expected: sets.New("envoy-gateway/gateway-2", "envoy-gateway/gateway-3"),
+		},
+		{
+			name: "service referenced by one route updated",
+			mutate: func(resources *resource.Resources) {
+				resources.Services[1].Spec.ClusterIP = "3.3.3.3"
+			},
+			expected: sets.New("envoy-gateway/gateway-1"),
+		},
+		{
+			name: "endpoint slice updated",
+			mutate: func(resources *resource.Resources) {
+				resources.EndpointSlices[0].Endpoints[0].Addresses = []string{"10.0.0.2"}
+			},
+			expected: sets.New("envoy-gateway/gateway-1"),
+		},
+		{
+			name: "secret not referenced by a route updated",
+			mutate: func(resources *resource.Resources) {
+				resources.Secrets[0].Data = map[string][]byte{"foo": []byte("bar")}
+			},
+			expected: nil,
+		},
+		{
+			name: "policy with target selectors",
+			mutate: func(resources *resource.Resources) {
+				resources.BackendTrafficPolicies[0].Spec.TargetSelectors = []egv1a1.TargetSelector{
+					{Kind: resource.KindHTTPRoute, MatchLabels: map[string]string{"app": "foo"}},
+				}
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			it := &IncrementalTranslator{}
+			resources := loadIncrementalTestResources(t, []byte(incrementalTestResources))
+			_, irKeys, err := it.Translate(newIncrementalTestTranslator(resources), resources)
+			require.NoError(t, err)
+			require.Nil(t, irKeys)
+
+			resources = loadIncrementalTestResources(t, []byte(incrementalTestResources))
+			tc.mutate(resources)
+			got, irKeys, err := it.Translate(newIncrementalTestTranslator(resources), resources)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, irKeys)
+
+			want, err := newIncrementalTestTranslator(resources).Translate(resources)
+			require.NoError(t, err)
+			requireEqualTranslateResults(t, want, got)
+		})
+	}
+}
+
+// TestIncrementalTranslatorTestdata checks that an incremental translation gives the same
+// result as a full translation for all the translator test inputs, by priming the incremental
+// translator with the input without its first route.
+func TestIncrementalTranslatorTestdata(t *testing.T) {
+	inputFiles, err := filepath.Glob(filepath.Join("testdata", "*.in.yaml"))
+	require.NoError(t, err)
+
+	for _, inputFile := range inputFiles {
+		t.Run(testName(inputFile), func(t *testing.T) {
+			t.Parallel()
+
+			input, err := os.ReadFile(inputFile)
+			require.NoError(t, err)
+			load := func() *resource.Resources {
+				return loadIncrementalTestResources(t, input)
+			}
+
+			primed := load()
+			switch {
+			case len(primed.HTTPRoutes) > 0:
+				primed.HTTPRoutes = primed.HTTPRoutes[1:]
+			case len(primed.GRPCRoutes) > 0:
+				primed.GRPCRoutes = primed.GRPCRoutes[1:]
+			case len(primed.TCPRoutes) > 0:
+				primed.TCPRoutes = primed.TCPRoutes[1:]
+			default:
+				t.Skip("no route to change")
+			}
+
+			it := &IncrementalTranslator{}
+			_, _, _ = it.Translate(newIncrementalTestTranslator(primed), primed)
+
+			resources := load()
+			got, _, gotErr := it.Translate(newIncrementalTestTranslator(resources), resources)
+			resources = load()
+			want, wantErr := newIncrementalTestTranslator(resources).Translate(resources)
+			require.Equal(t, wantErr != nil, gotErr != nil)
+			requireEqualTranslateResults(t, want, got)
+		})
+	}
+}
+
+func requireEqualTranslateResults(t *testing.T, want, got *TranslateResult) {
+	t.Helper()
+
+	require.Equal(t, want.XdsIR, got.XdsIR)
+	require.Equal(t, want.InfraIR, got.InfraIR)
+
+	statuses := func(result *TranslateResult) map[string]any {
+		out := make(map[string]any)
+		add := func(kind string, obj metav1.Object, status any) {
+			out[nodeKey(kind, obj.GetNamespace(), obj.GetName())] = status
+		}
+		for _, obj := range result.Gateways {
+			add(resource.KindGateway, obj, obj.Status)
+		}
+		for _, obj := range result.EnvoyProxiesForGateways {
+			add(resource.KindEnvoyProxy, obj, obj.Status)
+		}
+		for _, obj := range result.ListenerSets {
+			add(resource.KindListenerSet, obj, obj.Status)
+		}
+		for _, obj := range result.HTTPRoutes {
+			add(resource.KindHTTPRoute, obj, obj.Status)
+		}
+		for _, obj := range result.GRPCRoutes {
+			add(resource.KindGRPCRoute, obj, obj.Status)
+		}
+		for _, obj := range result.TLSRoutes {
+			add(resource.KindTLSRoute, obj, obj.Status)
+		}
+		for _, obj := range result.TCPRoutes {
+			add(resource.KindTCPRoute, obj, obj.Status)
+		}
+		for _, obj := range result.UDPRoutes {
+			add(resource.KindUDPRoute, obj, obj.Status)
+		}
+		for _, obj := range result.ClientTrafficPolicies {
+			add(resource.KindClientTrafficPolicy, obj, obj.Status)
+		}
+		for _, obj := range result.BackendTrafficPolicies {
+			add(resource.KindBackendTrafficPolicy, obj, obj.Status)
+		}
+		for _, obj := range result.SecurityPolicies {
+			add(resource.KindSecurityPolicy, obj, obj.Status)
+		}
+		for _, obj := range result.EnvoyExtensionPolicies {
+			add(resource.KindEnvoyExtensionPolicy, obj, obj.Status)
+		}
+		for _, obj := range result.EnvoyPatchPolicies {
+			add(resource.KindEnvoyPatchPolicy, obj, obj.Status)
+		}
+		for _, obj := range result.Backends {
+			add(resource.KindBackend, obj, obj.Status)
+		}
+		return out
+	}
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+		cmpopts.EquateEmpty(),
+		// The translator doesn't always add the conditions in the same order.
+		cmpopts.SortSlices(func(a, b metav1.Condition) bool { return a.Type < b.Type }),
+	}
+	if diff := cmp.Diff(statuses(want), statuses(got), opts...); diff != "" {
+		t.Fatalf("unexpected statuses (-want +got):\n%s", diff)
+	}
+}
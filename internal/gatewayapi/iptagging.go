@@ -0,0 +1,209 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	perr "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// IPTagConfigMapKey is the key of the ConfigMaps that hold the IP address ranges of an IP tag.
+const IPTagConfigMapKey = "cidrs"
+
+// ipTagCIDRs holds the IP address ranges parsed from an IP tag ConfigMap, or the error
+// that occurred while reading them.
+type ipTagCIDRs struct {
+	cidrs []*ir.CIDRMatch
+	err   error
+}
+
+// buildIPTagging builds the IP tag sets of the EnvoyProxy, with the IP address ranges
+// read from the referenced ConfigMaps.
+func (t *Translator) buildIPTagging(envoyProxy *egv1a1.EnvoyProxy) (*ir.IPTagging, error) {
+	if envoyProxy == nil || envoyProxy.Spec.IPTagging == nil {
+		return nil, nil
+	}
+
+	ipTagging := envoyProxy.Spec.IPTagging
+	irIPTagging := &ir.IPTagging{}
+	if ipTagging.File != nil {
+		irIPTagging.FilePath = new(ipTagging.File.Path)
+	}
+
+	for _, tag := range ipTagging.Tags {
+		irTag := &ir.IPTag{
+			Name:  tag.Name,
+			CIDRs: make([]*ir.CIDRMatch, 0, len(tag.CIDRs)),
+		}
+		for _, cidr := range tag.CIDRs {
+			cidrMatch, err := parseCIDR(string(cidr))
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR of the IP tag %s: %w", tag.Name, err)
+			}
+			irTag.CIDRs = append(irTag.CIDRs, cidrMatch)
+		}
+
+		if tag.ValueRef != nil {
+			cidrs, err := t.getIPTagConfigMapCIDRs(envoyProxy.Namespace, string(tag.ValueRef.Name))
+			if err != nil {
+				return nil, fmt.Errorf("invalid configmap referenced by the IP tag %s: %w", tag.Name, err)
+			}
+			irTag.CIDRs = append(irTag.CIDRs, cidrs...)
+		}
+		irIPTagging.Tags = append(irIPTagging.Tags, irTag)
+	}
+
+	return irIPTagging, nil
+}
+
+// getIPTagConfigMapCIDRs returns the IP address ranges of an IP tag ConfigMap, using
+// t.IPTagCIDRsMap as a cache so that each ConfigMap is parsed once per translation,
+// however many Gateways reference it.
+func (t *Translator) getIPTagConfigMapCIDRs(namespace, name string) ([]*ir.CIDRMatch, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	if cached, ok := t.IPTagCIDRsMap[key]; ok {
+		return cached.cidrs, cached.err
+	}
+	if t.IPTagCIDRsMap == nil {
+		t.IPTagCIDRsMap = make(map[types.NamespacedName]*ipTagCIDRs)
+	}
+
+	parsed := &ipTagCIDRs{}
+	parsed.cidrs, parsed.err = t.parseIPTagConfigMap(key)
+	t.IPTagCIDRsMap[key] = parsed
+	return parsed.cidrs, parsed.err
+}
+
+// parseIPTagConfigMap parses the IP address ranges listed in the IP tag ConfigMap.
+func (t *Translator) parseIPTagConfigMap(key types.NamespacedName) ([]*ir.CIDRMatch, error) {
+	cm := t.GetConfigMap(key.Namespace, key.Name)
+	if cm == nil {
+		return nil, fmt.Errorf("can't find the configmap %s in namespace %s", key.Name, key.Namespace)
+	}
+	data, ok := cm.Data[IPTagConfigMapKey]
+	if !ok {
+		return nil, fmt.Errorf("can't find the key %s in the configmap %s", IPTagConfigMapKey, key.Name)
+	}
+
+	lines := parseIPTagCIDRs(data)
+	cidrs := make([]*ir.CIDRMatch, 0, len(lines))
+	for _, line := range lines {
+		cidrMatch, err := parseCIDR(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR in the configmap %s: %w", key.Name, err)
+		}
+		cidrs = append(cidrs, cidrMatch)
+	}
+	return cidrs, nil
+}
+
+// parseIPTagCIDRs returns the IP address ranges listed in a ConfigMap, one per line.
+// Empty lines and comments starting with "#" are skipped.
+func parseIPTagCIDRs(data string) []string {
+	var cidrs []string
+	for line := range strings.Lines(data) {
+		line, _, _ = strings.Cut(line, "#")
+		if line = strings.TrimSpace(line); line != "" {
+			cidrs = append(cidrs, line)
+		}
+	}
+	return cidrs
+}
+
+// validateClientIPTags checks that the IP tags referenced by a policy are defined in
+// the IP tagging settings of the listener.
+func validateClientIPTags(tags sets.Set[string], ipTagging *ir.IPTagging) error {
+	if tags.Len() == 0 {
+		return nil
+	}
+	if ipTagging == nil {
+		return errors.New("clientIPTags requires EnvoyProxy.spec.ipTagging to be configured")
+	}
+	// The tags defined in the file aren't known, so the references can't be checked.
+	if ipTagging.FilePath != nil {
+		return nil
+	}
+
+	missing := tags.Clone()
+	for _, tag := range ipTagging.Tags {
+		missing.Delete(tag.Name)
+	}
+	if missing.Len() > 0 {
+		return fmt.Errorf("clientIPTags %s are not defined in EnvoyProxy.spec.ipTagging",
+			strings.Join(sets.List(missing), ", "))
+	}
+	return nil
+}
+
+// authorizationClientIPTags returns the IP tags referenced by the authorization rules.
+func authorizationClientIPTags(authorization *ir.Authorization) sets.Set[string] {
+	tags := sets.New[string]()
+	if authorization == nil {
+		return tags
+	}
+	for _, rule := range authorization.Rules {
+		if rule != nil {
+			tags.Insert(rule.Principal.ClientIPTags...)
+		}
+	}
+	return tags
+}
+
+// rateLimitClientIPTags returns the IP tags referenced by the rate limit client selectors.
+func rateLimitClientIPTags(rateLimit *egv1a1.RateLimitSpec) sets.Set[string] {
+	tags := sets.New[string]()
+	if rateLimit == nil {
+		return tags
+	}
+
+	var selectors []egv1a1.RateLimitSelectCondition
+	if rateLimit.Local != nil {
+		for _, rule := range rateLimit.Local.Rules {
+			selectors = append(selectors, rule.ClientSelectors...)
+		}
+	}
+	if rateLimit.Global != nil {
+		for _, rule := range rateLimit.Global.Rules {
+			selectors = append(selectors, rule.ClientSelectors...)
+		}
+	}
+	if rateLimit.Quota != nil {
+		for _, rule := range rateLimit.Quota.Rules {
+			selectors = append(selectors, rule.ClientSelectors...)
+		}
+	}
+	for _, selector := range selectors {
+		tags.Insert(selector.ClientIPTags...)
+	}
+	return tags
+}
+
+// validateRateLimitClientIPTags checks that the IP tags referenced by the rate limit client
+// selectors are defined in the IP tag sets of all the listeners.
+func validateRateLimitClientIPTags(rateLimit *egv1a1.RateLimitSpec, listeners []*ListenerContext) error {
+	tags := rateLimitClientIPTags(rateLimit)
+	if tags.Len() == 0 {
+		return nil
+	}
+	for _, listener := range listeners {
+		// The IP tags only apply to the HTTP listeners.
+		if listener == nil || listener.httpIR == nil {
+			continue
+		}
+		if err := validateClientIPTags(tags, listener.httpIR.IPTagging); err != nil {
+			return perr.WithMessage(err, "RateLimit")
+		}
+	}
+	return nil
+}
@@ -316,6 +316,12 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR resource
 		t.processProxyReadyListener(xdsIR[irKey], gateway.envoyProxy)
 		t.processProxyObservability(gateway, xdsIR[irKey], infraIR[irKey].Proxy, resources)
 
+		ipTagging, err := t.buildIPTagging(gateway.envoyProxy)
+		if err != nil {
+			status.UpdateGatewayStatusNotAccepted(gateway.Gateway, gwapiv1.GatewayReasonInvalidParameters,
+				fmt.Sprintf("Invalid IP tagging in the referenced EnvoyProxy: %v", err))
+		}
+
 		for _, listener := range gateway.listeners {
 			// Finalize listener conditions and check readiness.
 			t.validateListenerConditions(listener)
@@ -367,6 +373,7 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR resource
 				irListener.PreserveRouteOrder = getPreserveRouteOrder(gateway.envoyProxy)
 				irListener.RequestID = getRequestIDExtensionAction(gateway.envoyProxy)
 				t.processProxyGRPCSettings(irListener, gateway.envoyProxy)
+				irListener.IPTagging = ipTagging
 				xdsIR[irKey].HTTP = append(xdsIR[irKey].HTTP, irListener)
 				// Store the HTTPListener IR in the listener context for use in the overlapping TLS config check.
 				listener.httpIR = irListener
@@ -1297,12 +1304,35 @@ func (t *Translator) processProxyGRPCSettings(
 		return
 	}
 
-	if envoyProxy.Spec.Telemetry != nil && envoyProxy.Spec.Telemetry.Metrics != nil &&
-		envoyProxy.Spec.Telemetry.Metrics.EnableGRPCStats != nil {
+	if envoyProxy.Spec.Telemetry == nil || envoyProxy.Spec.Telemetry.Metrics == nil {
+		return
+	}
+
+	metrics := envoyProxy.Spec.Telemetry.Metrics
+	if metrics.EnableGRPCStats != nil {
 		if irListener.GRPC == nil {
 			irListener.GRPC = &ir.GRPCSettings{}
 		}
-		irListener.GRPC.EnableGRPCStats = envoyProxy.Spec.Telemetry.Metrics.EnableGRPCStats
+		irListener.GRPC.EnableGRPCStats = metrics.EnableGRPCStats
+	}
+
+	if metrics.GRPCStats != nil {
+		if irListener.GRPC == nil {
+			irListener.GRPC = &ir.GRPCSettings{}
+		}
+		// Configuring the gRPC stats filter enables it, unless it's explicitly disabled.
+		if irListener.GRPC.EnableGRPCStats == nil {
+			irListener.GRPC.EnableGRPCStats = new(true)
+		}
+		irListener.GRPC.GRPCStats = &ir.GRPCStats{
+			EnableUpstreamStats: ptr.Deref(metrics.GRPCStats.EnableUpstreamStats, false),
+		}
+		for _, service := range metrics.GRPCStats.Services {
+			irListener.GRPC.GRPCStats.Services = append(irListener.GRPC.GRPCStats.Services, ir.GRPCStatsService{
+				Name:    service.Name,
+				Methods: service.Methods,
+			})
+		}
 	}
 }
 
@@ -1356,6 +1386,7 @@ func (t *Translator) processBackendRefsForTelemetry(name string, backendCluster
 			return nil, nil, err
 		}
 		ds.TLS = backendTLS
+		setDefaultDNSResolver(ds, envoyProxy)
 
 		// Infer SNI from FQDN for telemetry backends (no Host header available).
 		if ds.TLS != nil && ds.TLS.SNI == nil && kind == resource.KindBackend {
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -467,6 +468,28 @@ func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRe
 				t.Logger.Info("setting 500 direct response in routes due to dynamic resolver with multiple backendRefs",
 					"routes", sets.List(routesWithDirectResponse))
 			}
+		// The backendRefs of a rule share one cluster, which can only use one DNS resolver.
+		case hasConflictingDNSResolvers(routeBackendDestinations):
+			routesWithDirectResponse := sets.New[string]()
+			for _, irRoute := range ruleRoutes {
+				// If the route already has a direct response or redirect configured, then it was from a filter so skip
+				// the direct response from errors.
+				if irRoute.DirectResponse != nil || irRoute.Redirect != nil {
+					continue
+				}
+				irRoute.DirectResponse = &ir.CustomResponse{
+					StatusCode: new(uint32(500)),
+				}
+				routesWithDirectResponse.Insert(irRoute.Name)
+			}
+			errorCollector.Add(status.NewRouteStatusError(
+				fmt.Errorf("failed to process route rule %d: %w", ruleIdx, errConflictingDNSResolvers),
+				status.RouteReasonInvalidBackendRef,
+			))
+			if len(routesWithDirectResponse) > 0 {
+				t.Logger.Info("setting 500 direct response in routes due to conflicting DNS resolvers",
+					"routes", sets.List(routesWithDirectResponse))
+			}
 		}
 
 		// finalize the IR routes for this rule, deferring the Settings/BackendClusterRefs split to
@@ -1692,6 +1715,28 @@ func (t *Translator) processGRPCRouteRules(grpcRoute *GRPCRouteContext, parentRe
 		// let a crafted path resolve to a loopback address and bypass the SSRF protection.
 		case hasDynamicResolver && hasPathRegexHostRewrite(ruleRoutes):
 			t.rejectPathRegexHostRewriteWithDynamicResolver(ruleRoutes, ruleIdx, errorCollector)
+		// The backendRefs of a rule share one cluster, which can only use one DNS resolver.
+		case hasConflictingDNSResolvers(routeBackendDestinations):
+			routesWithDirectResponse := sets.New[string]()
+			for _, irRoute := range ruleRoutes {
+				// If the route already has a direct response or redirect configured, then it was from a filter so skip
+				// the direct response from errors.
+				if irRoute.DirectResponse != nil || irRoute.Redirect != nil {
+					continue
+				}
+				irRoute.DirectResponse = &ir.CustomResponse{
+					StatusCode: new(uint32(500)),
+				}
+				routesWithDirectResponse.Insert(irRoute.Name)
+			}
+			errorCollector.Add(status.NewRouteStatusError(
+				fmt.Errorf("failed to process route rule %d: %w", ruleIdx, errConflictingDNSResolvers),
+				status.RouteReasonInvalidBackendRef,
+			))
+			if len(routesWithDirectResponse) > 0 {
+				t.Logger.Info("setting 500 direct response in routes due to conflicting DNS resolvers",
+					"routes", sets.List(routesWithDirectResponse))
+			}
 		}
 
 		// finalize the IR routes for this rule, deferring the Settings/BackendClusterRefs split to
@@ -2373,6 +2418,10 @@ func (t *Translator) processTLSRouteParentRefs(tlsRoute *TLSRouteContext, resour
 			// cluster is produced from an invalid combination of backends.
 			routeBackendDestinations = nil
 		}
+		if hasConflictingDNSResolvers(routeBackendDestinations) {
+			resolveErrs.Add(status.NewRouteStatusError(errConflictingDNSResolvers, status.RouteReasonInvalidBackendRef))
+			routeBackendDestinations = nil
+		}
 
 		routeStatus := GetRouteStatus(tlsRoute)
 		if !resolveErrs.Empty() {
@@ -2583,6 +2632,12 @@ func (t *Translator) processUDPRouteParentRefs(udpRoute *UDPRouteContext, resour
 			}
 		}
 
+		// The backendRefs share one cluster, which can only use one DNS resolver.
+		if hasConflictingDNSResolvers(routeBackendDestinations) {
+			resolveErrs.Add(status.NewRouteStatusError(errConflictingDNSResolvers, status.RouteReasonInvalidBackendRef))
+			routeBackendDestinations = nil
+		}
+
 		routeStatus := GetRouteStatus(udpRoute)
 		if !resolveErrs.Empty() {
 			status.SetRouteStatusCondition(routeStatus,
@@ -2747,6 +2802,12 @@ func (t *Translator) processTCPRouteParentRefs(tcpRoute *TCPRouteContext, resour
 			}
 		}
 
+		// The backendRefs share one cluster, which can only use one DNS resolver.
+		if hasConflictingDNSResolvers(routeBackendDestinations) {
+			resolveErrs.Add(status.NewRouteStatusError(errConflictingDNSResolvers, status.RouteReasonInvalidBackendRef))
+			routeBackendDestinations = nil
+		}
+
 		routeStatus := GetRouteStatus(tcpRoute)
 		if !resolveErrs.Empty() {
 			status.SetRouteStatusCondition(routeStatus,
@@ -2954,6 +3015,7 @@ func (t *Translator) processDestination(name string, backendRefContext BackendRe
 	}
 
 	ds.TLS = tls
+	setDefaultDNSResolver(ds, envoyProxy)
 
 	var filtersErr error
 	ds.Filters, filtersErr = t.processDestinationFilters(routeType, backendRefContext, parentRef, route, resources, xdsIR)
@@ -3512,6 +3574,7 @@ func (t *Translator) processBackendDestinationSetting(
 		ds.IsDynamicResolver = true
 		ds.Protocol = protocol
 		ds.ForceHTTP1Upstream = forceHTTP1Upstream
+		ds.DNSResolver = buildDNSResolver(backend.Spec.DNSResolver)
 		return ds
 	}
 
@@ -3562,6 +3625,9 @@ func (t *Translator) processBackendDestinationSetting(
 	ds.AddressType = dstAddrType
 	ds.Protocol = protocol
 	ds.ForceHTTP1Upstream = forceHTTP1Upstream
+	if dstAddrType != nil && *dstAddrType == ir.FQDN {
+		ds.DNSResolver = buildDNSResolver(backend.Spec.DNSResolver)
+	}
 
 	if backend.Spec.Fallback != nil {
 		// set only the secondary priority, the backend defaults to a primary priority if unset.
@@ -3575,6 +3641,42 @@ func (t *Translator) processBackendDestinationSetting(
 	return ds
 }
 
+var errConflictingDNSResolvers = errors.New("the backendRefs resolved with DNS must use the same DNS resolver")
+
+// hasConflictingDNSResolvers reports whether the destinations resolved with DNS use different DNS
+// resolvers. The destinations of a route rule share one cluster, which can only use one DNS resolver,
+// except for the merged backends, which get their own cluster.
+func hasConflictingDNSResolvers(destinations []routeBackendRefDestination) bool {
+	var (
+		resolver *ir.DNSResolver
+		found    bool
+	)
+	for _, bd := range destinations {
+		if bd.backendClusterKey != nil || !bd.ds.IsDynamicResolver && (bd.ds.AddressType == nil || *bd.ds.AddressType != ir.FQDN) {
+			continue
+		}
+		if !found {
+			resolver, found = bd.ds.DNSResolver, true
+			continue
+		}
+		if !reflect.DeepEqual(resolver, bd.ds.DNSResolver) {
+			return true
+		}
+	}
+	return false
+}
+
+// setDefaultDNSResolver sets the DNS resolver of the EnvoyProxy on the destination setting,
+// if the destination is resolved with DNS and its Backend doesn't define a DNS resolver.
+func setDefaultDNSResolver(ds *ir.DestinationSetting, envoyProxy *egv1a1.EnvoyProxy) {
+	if ds.DNSResolver != nil || envoyProxy == nil || envoyProxy.Spec.DNSResolver == nil {
+		return
+	}
+	if ds.IsDynamicResolver || (ds.AddressType != nil && *ds.AddressType == ir.FQDN) {
+		ds.DNSResolver = buildDNSResolver(envoyProxy.Spec.DNSResolver)
+	}
+}
+
 // resolveBackendProtocol computes the upstream ir.AppProtocol for a backend from the
 // backend's own appProtocol and the route's default (fallback) protocol. It recognizes
 // both the Kubernetes Service convention ("kubernetes.io/*") and the Envoy Gateway
@@ -22,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -67,6 +68,9 @@ type Runner struct {
 	// Key tracking for mark and sweep - avoids expensive LoadAll operations
 	keyCache *KeyCache
 
+	// incrementalTranslators holds the incremental translator of each GatewayClass.
+	incrementalTranslators map[string]*gatewayapi.IncrementalTranslator
+
 	// Goroutine synchronization
 	done sync.WaitGroup
 }
@@ -74,11 +78,15 @@ type Runner struct {
 type aggregatedPolicyStatus struct {
 	status     *gwapiv1.PolicyStatus
 	generation int64
+	// copied is set once status no longer aliases the status of a translated resource.
+	copied bool
 }
 
 type aggregatedRouteStatus struct {
 	status     *gwapiv1.RouteStatus
 	generation int64
+	// copied is set once status no longer aliases the status of a translated resource.
+	copied bool
 }
 
 func mergeAggregatedRouteStatus(aggregated aggregatedRouteStatus, incoming *gwapiv1.RouteStatus, generation int64) aggregatedRouteStatus {
@@ -92,6 +100,12 @@ func mergeAggregatedRouteStatus(aggregated aggregatedRouteStatus, incoming *gwap
 		return aggregated
 	}
 
+	// The translated resources may be reused by the next incremental translation,
+	// so their status must not be modified.
+	if !aggregated.copied {
+		aggregated.status = aggregated.status.DeepCopy()
+		aggregated.copied = true
+	}
 	aggregated.status.Parents = append(aggregated.status.Parents, incoming.Parents...)
 	if generation > aggregated.generation {
 		aggregated.generation = generation
@@ -113,6 +127,12 @@ func mergePolicyStatus(aggregated aggregatedPolicyStatus, incoming *gwapiv1.Poli
 
 	// Prevent self-merge when aggregated and incoming reference the same object
 	if aggregated.status != incoming {
+		// The translated resources may be reused by the next incremental translation,
+		// so their status must not be modified.
+		if !aggregated.copied {
+			aggregated.status = aggregated.status.DeepCopy()
+			aggregated.copied = true
+		}
 		aggregated.status.Ancestors = append(aggregated.status.Ancestors, incoming.Ancestors...)
 	}
 	if generation > aggregated.generation {
@@ -133,15 +153,19 @@ func mergeEnvoyProxyStatus(aggregated, incoming *egv1a1.EnvoyProxyStatus) *egv1a
 
 	// Prevent self-merge when aggregated and incoming reference the same object
 	if aggregated != incoming {
-		aggregated.Ancestors = append(aggregated.Ancestors, incoming.Ancestors...)
+		// Copy so that the status of the translated EnvoyProxy isn't modified.
+		merged := aggregated.DeepCopy()
+		merged.Ancestors = append(merged.Ancestors, incoming.Ancestors...)
+		return merged
 	}
 	return aggregated
 }
 
 func New(cfg *Config) *Runner {
 	return &Runner{
-		Config:   *cfg,
-		keyCache: newKeyCache(),
+		Config:                 *cfg,
+		keyCache:               newKeyCache(),
+		incrementalTranslators: make(map[string]*gatewayapi.IncrementalTranslator),
 	}
 }
 
@@ -232,6 +256,7 @@ func (r *Runner) subscribeAndTranslate(sub <-chan watchable.Snapshot[string, *re
 					r.ProviderResources.EnvoyProxyStatuses.Store(key, emptyStatus)
 				}
 				r.deleteAllKeys()
+				r.incrementalTranslators = make(map[string]*gatewayapi.IncrementalTranslator)
 				return
 			}
 
@@ -287,12 +312,16 @@ func (r *Runner) subscribeAndTranslate(sub <-chan watchable.Snapshot[string, *re
 			}
 
 			span.AddEvent("translate", trace.WithAttributes(attribute.Int("resources.count", len(*val))))
+			incremental := r.EnvoyGateway.RuntimeFlags.IsEnabled(egv1a1.IncrementalTranslation)
+			gatewayClasses := sets.New[string]()
 			for _, resources := range *val {
 				// Translate and publish IRs.
 				t := &gatewayapi.Translator{
 					GatewayControllerName:           r.EnvoyGateway.Gateway.ControllerName,
 					GatewayClassName:                gwapiv1.ObjectName(resources.GatewayClass.Name),
 					GlobalRateLimitEnabled:          r.EnvoyGateway.RateLimit != nil,
+					RateLimitQuotaEnabled:           r.EnvoyGateway.RateLimitQuota != nil,
+					RateLimitQuotaTLS:               r.EnvoyGateway.RateLimitQuotaTLS(),
 					EnvoyPatchPolicyEnabled:         r.EnvoyGateway.ExtensionAPIs != nil && r.EnvoyGateway.ExtensionAPIs.EnableEnvoyPatchPolicy,
 					BackendEnabled:                  r.EnvoyGateway.ExtensionAPIs != nil && r.EnvoyGateway.ExtensionAPIs.EnableBackend,
 					SDSSecretRefEnabled:             r.EnvoyGateway.ExtensionAPIs != nil && r.EnvoyGateway.ExtensionAPIs.EnableSDSSecretRef,
@@ -325,7 +354,18 @@ func (r *Runner) subscribeAndTranslate(sub <-chan watchable.Snapshot[string, *re
 				}
 				// Translate to IR
 				_, translateToIRSpan := tracer.Start(traceCtx, "GatewayApiRunner.ResoureTranslationCycle.TranslateToIR")
-				result, err := t.Translate(resources)
+				var (
+					result *gatewayapi.TranslateResult
+					// irKeys holds the IR keys which were translated again, nil if all of them were.
+					irKeys sets.Set[string]
+					err    error
+				)
+				if incremental {
+					result, irKeys, err = r.incrementalTranslator(resources.GatewayClass.Name).Translate(t, resources)
+					gatewayClasses.Insert(resources.GatewayClass.Name)
+				} else {
+					result, err = t.Translate(resources)
+				}
 				translateToIRSpan.End()
 				if err != nil {
 					// Currently all errors that Translate returns should just be logged
@@ -338,6 +378,12 @@ func (r *Runner) subscribeAndTranslate(sub <-chan watchable.Snapshot[string, *re
 				// Publish the IRs.
 				// Also validate the ir before sending it.
 				for key, val := range result.InfraIR {
+					if irKeys != nil && !irKeys.Has(key) {
+						// The IR is unchanged and has already been published.
+						r.keyCache.IR[key] = true
+						delete(keysToDelete.IR, key)
+						continue
+					}
 					logV := traceLogger.V(1).WithValues(string(message.InfraIRMessageName), key)
 					if logV.Enabled() {
 						logV.Info(val.JSONString())
@@ -355,6 +401,9 @@ func (r *Runner) subscribeAndTranslate(sub <-chan watchable.Snapshot[string, *re
 				}
 
 				for key, val := range result.XdsIR {
+					if irKeys != nil && !irKeys.Has(key) {
+						continue
+					}
 					logV := traceLogger.V(1).WithValues(string(message.XDSIRMessageName), key)
 					if logV.Enabled() {
 						logV.Info(val.JSONString())
@@ -490,6 +539,13 @@ func (r *Runner) subscribeAndTranslate(sub <-chan watchable.Snapshot[string, *re
 				statusUpdateSpan.End()
 			}
 
+			// Drop the incremental translators of the GatewayClasses that are gone.
+			for gatewayClass := range r.incrementalTranslators {
+				if !gatewayClasses.Has(gatewayClass) {
+					delete(r.incrementalTranslators, gatewayClass)
+				}
+			}
+
 			// Store the stauses of all objects atomically with the aggregated status.
 			for key, entry := range aggregatedStatuses.HTTPRoutes {
 				status.TruncateRouteParents(entry.status, entry.generation)
@@ -609,6 +665,16 @@ func (r *Runner) subscribeAndTranslate(sub <-chan watchable.Snapshot[string, *re
 	r.Logger.Info("shutting down")
 }
 
+// incrementalTranslator returns the incremental translator of the GatewayClass.
+func (r *Runner) incrementalTranslator(gatewayClass string) *gatewayapi.IncrementalTranslator {
+	it, found := r.incrementalTranslators[gatewayClass]
+	if !found {
+		it = &gatewayapi.IncrementalTranslator{}
+		r.incrementalTranslators[gatewayClass] = it
+	}
+	return it
+}
+
 func (r *Runner) loadTLSConfig(ctx context.Context) (*tls.Config, []byte, error) {
 	switch {
 	case r.EnvoyGateway.Provider.IsRunningOnKubernetes():
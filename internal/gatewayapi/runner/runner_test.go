@@ -299,6 +299,8 @@ func TestMergePolicyStatus(t *testing.T) {
 		require.Equal(t, gwapiv1.ObjectName("gw-a"), entry.status.Ancestors[0].AncestorRef.Name)
 		require.Equal(t, gwapiv1.ObjectName("gw-b"), entry.status.Ancestors[1].AncestorRef.Name)
 		require.Equal(t, int64(9), entry.generation)
+		// The translated status is left untouched.
+		require.Len(t, first.Ancestors, 1)
 
 		entry = mergePolicyStatus(entry, &gwapiv1.PolicyStatus{}, 4)
 		require.Equal(t, int64(9), entry.generation)
@@ -373,6 +375,8 @@ func TestMergeEnvoyProxyStatus(t *testing.T) {
 		require.Len(t, got.Ancestors, 2)
 		require.Equal(t, gwapiv1.ObjectName("gc-a"), got.Ancestors[0].AncestorRef.Name)
 		require.Equal(t, gwapiv1.ObjectName("gw-b"), got.Ancestors[1].AncestorRef.Name)
+		// The translated status is left untouched.
+		require.Len(t, first.Ancestors, 1)
 	})
 
 	t.Run("self-merge prevention", func(t *testing.T) {
@@ -453,6 +457,8 @@ func TestMergeRouteStatus(t *testing.T) {
 		require.Equal(t, gwapiv1.ObjectName("gw-a"), entry.status.Parents[0].ParentRef.Name)
 		require.Equal(t, gwapiv1.ObjectName("gw-b"), entry.status.Parents[1].ParentRef.Name)
 		require.Equal(t, int64(9), entry.generation)
+		// The translated status is left untouched.
+		require.Len(t, first.Parents, 1)
 
 		entry = mergeAggregatedRouteStatus(entry, &gwapiv1.RouteStatus{}, 4)
 		require.Equal(t, int64(9), entry.generation)
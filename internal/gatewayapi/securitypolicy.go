@@ -970,13 +970,14 @@ func validateSecurityPolicy(p *egv1a1.SecurityPolicy) error {
 
 // validateSecurityPolicyForTCP ensures SecurityPolicy usage on TCP is compatible.
 //
-// TCP supports Authorization with ClientCIDRs ONLY.
+// TCP supports Authorization with ClientCIDRs and ClientCertificate ONLY.
 // - Principals.JWT      => invalid (HTTP-only)
 // - Principals.Headers  => invalid (HTTP-only)
+// - Principals.ClientIPTags => invalid (HTTP-only)
 // - Empty/no Authorization is allowed and results in no-op on TCP.
 // Returns an error when any HTTP-only field is present or CIDRs are invalid.
 func validateSecurityPolicyForTCP(p *egv1a1.SecurityPolicy) error {
-	if p.Spec.CORS != nil || p.Spec.CSRF != nil || p.Spec.JWT != nil || p.Spec.OIDC != nil || p.Spec.APIKeyAuth != nil || p.Spec.BasicAuth != nil || p.Spec.ExtAuth != nil {
+	if p.Spec.CORS != nil || p.Spec.CSRF != nil || p.Spec.JWT != nil || p.Spec.OIDC != nil || p.Spec.APIKeyAuth != nil || p.Spec.BasicAuth != nil || p.Spec.ExtAuth != nil || p.Spec.WAF != nil {
 		return fmt.Errorf("only authorization is supported for TCP (routes/listeners)")
 	}
 	if p.Spec.Authorization == nil || len(p.Spec.Authorization.Rules) == 0 {
@@ -999,6 +1000,9 @@ func validateSecurityPolicyForTCP(p *egv1a1.SecurityPolicy) error {
 		if len(rule.Principal.ClientIPGeoLocations) > 0 {
 			return fmt.Errorf("rule %d: clientIPGeoLocations not supported for TCP", i)
 		}
+		if len(rule.Principal.ClientIPTags) > 0 {
+			return fmt.Errorf("rule %d: clientIPTags not supported for TCP", i)
+		}
 		if err := validateCIDRs(rule.Principal.ClientCIDRs); err != nil {
 			return fmt.Errorf("rule %d: %w", i, err)
 		}
@@ -1364,6 +1368,20 @@ func (t *Translator) translateSecurityPolicyForRoute(
 			}
 		}
 
+		var waf *ir.WAF
+		if policy.Spec.WAF != nil {
+			if waf, err = t.buildWAF(
+				policy,
+				owners,
+				resources,
+				gtwCtx.envoyProxy,
+			); err != nil {
+				err = perr.WithMessage(err, "WAF")
+				errs = errors.Join(errs, err)
+				hasNonExtAuthError = true
+			}
+		}
+
 		// Pre-create security features to avoid repeated allocations
 		securityFeatures := &ir.SecurityFeatures{
 			CORS:          cors,
@@ -1374,6 +1392,7 @@ func (t *Translator) translateSecurityPolicyForRoute(
 			BasicAuth:     basicAuth,
 			ExtAuth:       extAuth,
 			Authorization: authorization,
+			WAF:           waf,
 		}
 
 		irKey := t.getIRKey(gtwCtx.Gateway)
@@ -1418,8 +1437,10 @@ func (t *Translator) translateSecurityPolicyForRoute(
 					var (
 						geoIPProvider              *ir.GeoIPProvider
 						geoIPErr                   error
+						ipTagsErr                  error
 						listenerHasNonExtAuthError = hasNonExtAuthError
 						geoIPValidated             bool
+						ipTagsValidated            bool
 					)
 
 					for _, r := range irListener.Routes {
@@ -1453,7 +1474,17 @@ func (t *Translator) translateSecurityPolicyForRoute(
 								geoIPValidated = true
 							}
 
-							if geoIPErr != nil || hasBaseErrs {
+							// Validate the clientIPTags against the IP tag sets of the listener.
+							if !ipTagsValidated {
+								if ipTagsErr = validateClientIPTags(authorizationClientIPTags(r.Security.Authorization), irListener.IPTagging); ipTagsErr != nil {
+									ipTagsErr = perr.WithMessage(ipTagsErr, "Authorization")
+									errs = errors.Join(errs, ipTagsErr)
+									listenerHasNonExtAuthError = true
+								}
+								ipTagsValidated = true
+							}
+
+							if geoIPErr != nil || ipTagsErr != nil || hasBaseErrs {
 								// If there is only error for ext auth and ext auth is set to fail open, then skip the ext auth
 								// and allow the request to go through.
 								// Otherwise, return a 500 direct response to avoid unauthorized access.
@@ -1530,6 +1561,7 @@ func (t *Translator) translateSecurityPolicyForListeners(
 		basicAuth             *ir.BasicAuth
 		extAuth               *ir.ExtAuth
 		authorization         *ir.Authorization
+		waf                   *ir.WAF
 		extAuthErr, err, errs error
 		hasNonExtAuthError    bool
 	)
@@ -1595,6 +1627,18 @@ func (t *Translator) translateSecurityPolicyForListeners(
 		}
 	}
 
+	if policy.Spec.WAF != nil {
+		if waf, err = t.buildWAF(
+			policy,
+			noOwners,
+			resources,
+			gtwCtx.envoyProxy,
+		); err != nil {
+			err = perr.WithMessage(err, "WAF")
+			errs = errors.Join(errs, err)
+		}
+	}
+
 	hasNonExtAuthError = errs != nil
 
 	if policy.Spec.ExtAuth != nil {
@@ -1634,6 +1678,7 @@ func (t *Translator) translateSecurityPolicyForListeners(
 		BasicAuth:     basicAuth,
 		ExtAuth:       extAuth,
 		Authorization: authorization,
+		WAF:           waf,
 	}
 
 	routesWithDirectResponse := sets.New[string]()
@@ -1647,6 +1692,7 @@ func (t *Translator) translateSecurityPolicyForListeners(
 		var (
 			geoIPProvider              *ir.GeoIPProvider
 			geoIPErr                   error
+			ipTagsErr                  error
 			listenerHasNonExtAuthError = hasNonExtAuthError
 		)
 
@@ -1662,8 +1708,15 @@ func (t *Translator) translateSecurityPolicyForListeners(
 			}
 		}
 
+		// Validate the clientIPTags against the IP tag sets of the listener.
+		if ipTagsErr = validateClientIPTags(authorizationClientIPTags(authorization), h.IPTagging); ipTagsErr != nil {
+			ipTagsErr = perr.WithMessage(ipTagsErr, "Authorization")
+			errs = errors.Join(errs, ipTagsErr)
+			listenerHasNonExtAuthError = true
+		}
+
 		var errorResponse *ir.CustomResponse
-		if geoIPErr != nil || hasBaseErrs {
+		if geoIPErr != nil || ipTagsErr != nil || hasBaseErrs {
 			// If there is only error for ext auth and ext auth is set to fail open, then skip the ext auth
 			// and allow the request to go through.
 			// Otherwise, return a 500 direct response to avoid unauthorized access.
@@ -2933,6 +2986,13 @@ func (t *Translator) buildAuthorization(
 			irPrincipal.JWT = rule.Principal.JWT
 			irPrincipal.Headers = rule.Principal.Headers
 			irPrincipal.ClientIPGeoLocations = rule.Principal.ClientIPGeoLocations
+			irPrincipal.ClientIPTags = rule.Principal.ClientIPTags
+
+			clientCertificate, err := buildAuthorizationClientCertificate(rule.Principal.ClientCertificate)
+			if err != nil {
+				return nil, fmt.Errorf("unable to translate authorization rule: %w", err)
+			}
+			irPrincipal.ClientCertificate = clientCertificate
 		}
 
 		if err := validateAuthorizationOperation(rule.Operation); err != nil {
@@ -2981,6 +3041,58 @@ func validateAuthorizationOperation(operation *egv1a1.Operation) error {
 	}
 }
 
+// buildAuthorizationClientCertificate builds the client certificate identity to be matched,
+// and validates its regular expressions.
+func buildAuthorizationClientCertificate(cert *egv1a1.ClientCertificatePrincipal) (*ir.ClientCertificateMatch, error) {
+	if cert == nil {
+		return nil, nil
+	}
+
+	build := func(name string, match egv1a1.StringMatch) (*ir.StringMatch, error) {
+		irMatch := irStringMatch(name, match)
+		if irMatch.SafeRegex != nil {
+			if err := regex.Validate(*irMatch.SafeRegex); err != nil {
+				return nil, err
+			}
+		}
+		return irMatch, nil
+	}
+
+	var (
+		irCert = &ir.ClientCertificateMatch{}
+		err    error
+	)
+	if cert.Subject != nil {
+		if irCert.Subject, err = build("subject", *cert.Subject); err != nil {
+			return nil, err
+		}
+	}
+	// The SANs are matched as a comma-separated list, so a value with a comma would match
+	// several SANs.
+	buildSAN := func(name string, match egv1a1.StringMatch) (*ir.StringMatch, error) {
+		if ptr.Deref(match.Type, egv1a1.StringMatchExact) != egv1a1.StringMatchRegularExpression &&
+			strings.Contains(match.Value, ",") {
+			return nil, fmt.Errorf("%s value %q must not contain a comma", name, match.Value)
+		}
+		return build(name, match)
+	}
+	for _, dnsName := range cert.DNSNames {
+		irMatch, err := buildSAN("dnsNames", dnsName)
+		if err != nil {
+			return nil, err
+		}
+		irCert.DNSNames = append(irCert.DNSNames, irMatch)
+	}
+	for _, uri := range cert.URIs {
+		irMatch, err := buildSAN("uris", uri)
+		if err != nil {
+			return nil, err
+		}
+		irCert.URIs = append(irCert.URIs, irMatch)
+	}
+	return irCert, nil
+}
+
 func validateAuthorizationGeoIP(
 	authorization *ir.Authorization,
 	envoyProxy *egv1a1.EnvoyProxy,
@@ -3094,6 +3206,7 @@ type securityPolicyOwners struct {
 	oidcClientIDRef          *egv1a1.SecurityPolicy
 	oidcClientSecret         *egv1a1.SecurityPolicy
 	jwtProviders             *egv1a1.SecurityPolicy
+	waf                      *egv1a1.SecurityPolicy
 }
 
 // mergeSecurityPolicy merges a route-level SecurityPolicy with a parent (Gateway/Listener) SecurityPolicy.
@@ -3155,6 +3268,9 @@ func buildSecurityPolicyOwners(route, parent *egv1a1.SecurityPolicy) *securityPo
 		jwtProviders: ownerOf(route, parent, func(p *egv1a1.SecurityPolicy) bool {
 			return p.Spec.JWT != nil && len(p.Spec.JWT.Providers) > 0
 		}),
+		waf: ownerOf(route, parent, func(p *egv1a1.SecurityPolicy) bool {
+			return p.Spec.WAF != nil
+		}),
 	}
 }
 
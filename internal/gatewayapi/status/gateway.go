@@ -15,6 +15,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/message"
 )
 
 func UpdateGatewayStatusNotAccepted(gw *gwapiv1.Gateway, reason gwapiv1.GatewayConditionReason, msg string) *gwapiv1.Gateway {
@@ -97,6 +100,63 @@ type NodeAddresses struct {
 	IPv6 []string
 }
 
+// UpdateGatewayStatusXDSRejectedCondition sets the XDSRejected condition of the Gateway when its
+// proxies rejected the latest xDS configuration, and removes the condition otherwise.
+func UpdateGatewayStatusXDSRejectedCondition(gw *gwapiv1.Gateway, rejections *message.XdsRejections) {
+	if rejections == nil || len(rejections.Rejections) == 0 {
+		gw.Status.Conditions = slices.DeleteFunc(gw.Status.Conditions, func(c metav1.Condition) bool {
+			return c.Type == string(egv1a1.GatewayConditionXDSRejected)
+		})
+		return
+	}
+	gw.Status.Conditions = MergeConditions(gw.Status.Conditions,
+		newCondition(string(egv1a1.GatewayConditionXDSRejected), metav1.ConditionTrue, string(egv1a1.GatewayReasonXDSRejected),
+			xdsRejectedMessage(rejections.Rejections), gw.Generation))
+}
+
+// maxRejectionErrorLength is the maximum length of each proxy error included in the XDSRejected condition.
+const maxRejectionErrorLength = 512
+
+// xdsRejectedMessage describes the rejected xDS updates, the rejections of the same update by
+// several proxies are reported once.
+func xdsRejectedMessage(rejections []message.XdsRejection) string {
+	type rejectedUpdate struct {
+		typeURL, version, message, policies string
+	}
+	var updates []rejectedUpdate
+	proxies := make(map[rejectedUpdate]int)
+	for _, r := range rejections {
+		policies := make([]string, 0, len(r.EnvoyPatchPolicies))
+		for _, p := range r.EnvoyPatchPolicies {
+			policies = append(policies, p.String())
+		}
+		u := rejectedUpdate{typeURL: r.TypeURL, version: r.Version, message: r.Message, policies: strings.Join(policies, ", ")}
+		if proxies[u] == 0 {
+			updates = append(updates, u)
+		}
+		proxies[u]++
+	}
+
+	details := make([]string, 0, len(updates))
+	for _, u := range updates {
+		detail := fmt.Sprintf("%d proxy(s) rejected %s", proxies[u], u.typeURL)
+		if u.version != "" {
+			detail += " version " + u.version
+		}
+		if u.policies != "" {
+			detail += " patched by EnvoyPatchPolicy " + u.policies
+		}
+		msg := u.message
+		if len(msg) > maxRejectionErrorLength {
+			msg = msg[:maxRejectionErrorLength] + "..."
+		}
+		details = append(details, detail+": "+msg)
+	}
+
+	return "The proxies rejected the latest xDS configuration and keep serving the last accepted one: " +
+		strings.Join(details, "; ")
+}
+
 // UpdateGatewayStatusProgrammedCondition updates the status addresses for the provided gateway
 // based on the status IP/Hostname of svc and updates the Programmed condition based on the
 // service and deployment or daemonset state.
@@ -14,10 +14,15 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/message"
 )
 
 // TestUpdateGatewayStatusProgrammedCondition tests whether UpdateGatewayStatusProgrammedCondition correctly updates the addresses in the Gateway status.
@@ -638,3 +643,53 @@ func TestUpdateGatewayProgrammedCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateGatewayStatusXDSRejectedCondition(t *testing.T) {
+	gw := &gwapiv1.Gateway{
+		Status: gwapiv1.GatewayStatus{
+			Conditions: []metav1.Condition{{
+				Type:   string(gwapiv1.GatewayConditionProgrammed),
+				Status: metav1.ConditionTrue,
+				Reason: string(gwapiv1.GatewayReasonProgrammed),
+			}},
+		},
+	}
+	policy := types.NamespacedName{Namespace: "default", Name: "patch"}
+
+	UpdateGatewayStatusXDSRejectedCondition(gw, &message.XdsRejections{
+		Rejections: []message.XdsRejection{
+			{
+				NodeID:             "envoy-1",
+				TypeURL:            string(egv1a1.ClusterEnvoyResourceType),
+				Version:            "5",
+				Message:            "invalid cluster default/backend",
+				EnvoyPatchPolicies: []types.NamespacedName{policy},
+			},
+			{
+				NodeID:             "envoy-2",
+				TypeURL:            string(egv1a1.ClusterEnvoyResourceType),
+				Version:            "5",
+				Message:            "invalid cluster default/backend",
+				EnvoyPatchPolicies: []types.NamespacedName{policy},
+			},
+			{
+				NodeID:  "envoy-2",
+				TypeURL: string(egv1a1.ListenerEnvoyResourceType),
+				Message: "invalid listener",
+			},
+		},
+	})
+	require.Len(t, gw.Status.Conditions, 2)
+	cond := gw.Status.Conditions[1]
+	assert.Equal(t, string(egv1a1.GatewayConditionXDSRejected), cond.Type)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(egv1a1.GatewayReasonXDSRejected), cond.Reason)
+	assert.Equal(t, "The proxies rejected the latest xDS configuration and keep serving the last accepted one: "+
+		"2 proxy(s) rejected type.googleapis.com/envoy.config.cluster.v3.Cluster version 5 patched by EnvoyPatchPolicy default/patch: invalid cluster default/backend; "+
+		"1 proxy(s) rejected type.googleapis.com/envoy.config.listener.v3.Listener: invalid listener", cond.Message)
+
+	// The condition is removed once the proxies accept the configuration.
+	UpdateGatewayStatusXDSRejectedCondition(gw, nil)
+	require.Len(t, gw.Status.Conditions, 1)
+	assert.Equal(t, string(gwapiv1.GatewayConditionProgrammed), gw.Status.Conditions[0].Type)
+}
@@ -6,6 +6,7 @@
 package status
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
@@ -109,6 +110,15 @@ func SetDeprecatedFieldsWarningForPolicyAncestor(policyStatus *gwapiv1.PolicySta
 	SetWarningForPolicyAncestor(policyStatus, ancestorRef, controllerName, egv1a1.PolicyReasonDeprecatedField, buildDeprecationWarningMessage(deprecatedFields), generation)
 }
 
+// SetNotApplicableFieldsWarningForPolicyAncestors sets a warning condition for each ancestor reference
+// listing the fields of the policy that are ignored for the given kind of target.
+func SetNotApplicableFieldsWarningForPolicyAncestors(policyStatus *gwapiv1.PolicyStatus, ancestorRefs []*gwapiv1.ParentReference, controllerName string, generation int64, targetKind string, fields []string) {
+	message := fmt.Sprintf("fields not applicable to %s are ignored: %s", targetKind, strings.Join(fields, ", "))
+	for _, ancestorRef := range ancestorRefs {
+		SetWarningForPolicyAncestor(policyStatus, ancestorRef, controllerName, egv1a1.PolicyReasonNotApplicableField, message, generation)
+	}
+}
+
 // SetWarningForPolicyAncestor sets or appends a warning condition for a specific ancestor reference.
 func SetWarningForPolicyAncestor(policyStatus *gwapiv1.PolicyStatus, ancestorRef *gwapiv1.ParentReference, controllerName string, reason gwapiv1.PolicyConditionReason, message string, generation int64) {
 	if message == "" {
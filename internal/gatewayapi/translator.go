@@ -87,6 +87,14 @@ type Translator struct {
 	// ratelimiting has been configured by the admin.
 	GlobalRateLimitEnabled bool
 
+	// RateLimitQuotaEnabled is true when the rate limit
+	// quota service has been configured by the admin.
+	RateLimitQuotaEnabled bool
+
+	// RateLimitQuotaTLS holds the TLS settings for the connections
+	// to the rate limit quota service, if configured by the admin.
+	RateLimitQuotaTLS *egv1a1.RateLimitQuotaTLSSettings
+
 	// EndpointRoutingDisabled can be set to true to use
 	// the Service Cluster IP for routing to the backend
 	// instead.
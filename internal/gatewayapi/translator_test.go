@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/yaml"
 
@@ -57,7 +58,15 @@ func TestTranslate(t *testing.T) {
 		LuaEnvoyExtensionPolicyDisabled bool
 		SDSEnabled                      bool
 		PerResourceSystemCASecret       bool
+		RateLimitQuotaTLS               *egv1a1.RateLimitQuotaTLSSettings
 	}{
+		{
+			name: "backendtrafficpolicy-ratelimit-quota-tls",
+			RateLimitQuotaTLS: &egv1a1.RateLimitQuotaTLSSettings{
+				CACertificateRef:     &gwapiv1.SecretObjectReference{Name: "rlqs-ca"},
+				ClientCertificateRef: &gwapiv1.SecretObjectReference{Name: "rlqs-client"},
+			},
+		},
 		{
 			name:                    "envoypatchpolicy-invalid-feature-disabled",
 			EnvoyPatchPolicyEnabled: false,
@@ -160,6 +169,7 @@ func TestTranslate(t *testing.T) {
 			luaEnvoyExtensionPolicyDisabled := false
 			sdsEnabled := false
 			perResourceSystemCASecret := false
+			var rateLimitQuotaTLS *egv1a1.RateLimitQuotaTLSSettings
 
 			for _, config := range testCasesConfig {
 				if config.name == strings.Split(filepath.Base(inputFile), ".")[0] {
@@ -170,6 +180,7 @@ func TestTranslate(t *testing.T) {
 					luaEnvoyExtensionPolicyDisabled = config.LuaEnvoyExtensionPolicyDisabled
 					sdsEnabled = config.SDSEnabled
 					perResourceSystemCASecret = config.PerResourceSystemCASecret
+					rateLimitQuotaTLS = config.RateLimitQuotaTLS
 				}
 			}
 
@@ -177,6 +188,8 @@ func TestTranslate(t *testing.T) {
 				GatewayControllerName:           egv1a1.GatewayControllerName,
 				GatewayClassName:                "envoy-gateway-class",
 				GlobalRateLimitEnabled:          true,
+				RateLimitQuotaEnabled:           true,
+				RateLimitQuotaTLS:               rateLimitQuotaTLS,
 				EnvoyPatchPolicyEnabled:         envoyPatchPolicyEnabled,
 				BackendEnabled:                  backendEnabled,
 				SDSSecretRefEnabled:             sdsEnabled,
@@ -0,0 +1,479 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	coreruleset "github.com/corazawaf/coraza-coreruleset/v4"
+	"github.com/corazawaf/coraza/v3"
+	lru "github.com/hashicorp/golang-lru/v2"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// wafDefaultDirectivesName is the name of the directives passed to the WAF rule engine.
+	wafDefaultDirectivesName = "default"
+
+	// wafExclusionRuleIDBase is the first ID of the rules generated for the
+	// path-scoped exclusions. The IDs from wafReservedRuleIDMin to wafReservedRuleIDMax
+	// are reserved for them, and can't be used by the rules of the rule sets.
+	// They are at the end of the 1-99,999 range, which the ModSecurity rule ID
+	// conventions reserve for local use, so they don't collide with the CRS rules.
+	wafExclusionRuleIDBase = wafReservedRuleIDMin
+
+	// wafReservedRuleIDMin and wafReservedRuleIDMax are the range of the rule IDs
+	// reserved for the rules generated by Envoy Gateway.
+	wafReservedRuleIDMin = 99900
+	wafReservedRuleIDMax = 99999
+
+	// wafCompileCacheSize is the number of compiled rule sets whose result is cached.
+	wafCompileCacheSize = 64
+)
+
+var (
+	// wafDirectives is the set of the SecLang directives accepted in the rule sets.
+	// The directives that read or write files on the proxy, such as SecAuditLog,
+	// SecDataDir or SecUploadDir, are not accepted. Include is validated separately,
+	// see validateWAFInclude.
+	wafDirectives = func() map[string]bool {
+		directives := map[string]bool{}
+		for _, d := range []string{
+			"SecAction",
+			"SecArgumentSeparator",
+			"SecArgumentsLimit",
+			"SecAuditEngine",
+			"SecAuditLogFormat",
+			"SecAuditLogParts",
+			"SecAuditLogRelevantStatus",
+			"SecAuditLogType",
+			"SecCollectionTimeout",
+			"SecComponentSignature",
+			"SecContentInjection",
+			"SecCookieFormat",
+			"SecDebugLogLevel",
+			"SecDefaultAction",
+			"SecIgnoreRuleCompilationErrors",
+			"SecMarker",
+			"SecRequestBodyAccess",
+			"SecRequestBodyInMemoryLimit",
+			"SecRequestBodyJsonDepthLimit",
+			"SecRequestBodyLimit",
+			"SecRequestBodyLimitAction",
+			"SecRequestBodyNoFilesLimit",
+			"SecResponseBodyAccess",
+			"SecResponseBodyLimit",
+			"SecResponseBodyLimitAction",
+			"SecResponseBodyMimeType",
+			"SecResponseBodyMimeTypesClear",
+			"SecRule",
+			"SecRuleEngine",
+			"SecRuleRemoveById",
+			"SecRuleRemoveByMsg",
+			"SecRuleRemoveByTag",
+			"SecRuleUpdateActionById",
+			"SecRuleUpdateTargetById",
+			"SecRuleUpdateTargetByMsg",
+			"SecRuleUpdateTargetByTag",
+			"SecServerSignature",
+			"SecStatusEngine",
+			"SecUploadFileLimit",
+			"SecWebAppId",
+		} {
+			directives[strings.ToLower(d)] = true
+		}
+		return directives
+	}()
+
+	wafRuleIDActionRegex    = regexp.MustCompile(`(^|,)\s*id\s*:\s*'?(\d*)`)
+	wafRuleChainActionRegex = regexp.MustCompile(`(^|,)\s*chain\s*(,|$)`)
+
+	// wafCompileResults caches the result of compileWAFDirectives by the hash of the
+	// directives, so the rule sets, which often include the whole CRS, are only
+	// compiled again when they change.
+	wafCompileResults, _ = lru.New[[sha256.Size]byte, error](wafCompileCacheSize)
+)
+
+// wafEngineConfig is the JSON configuration passed to the WAF rule engine.
+// It follows the configuration format of coraza-proxy-wasm.
+type wafEngineConfig struct {
+	DirectivesMap     map[string][]string `json:"directives_map"`
+	DefaultDirectives string              `json:"default_directives"`
+}
+
+func (t *Translator) buildWAF(
+	policy *egv1a1.SecurityPolicy,
+	owners *securityPolicyOwners,
+	resources *resource.Resources,
+	envoyProxy *egv1a1.EnvoyProxy,
+) (*ir.WAF, error) {
+	waf := policy.Spec.WAF
+	ownerPolicy := policyOwnerOr(owners.waf, policy)
+	name := irConfigNameForWAF(ownerPolicy)
+
+	directives, err := t.buildWAFDirectives(waf, ownerPolicy.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := compileWAFDirectives(directives); err != nil {
+		return nil, err
+	}
+
+	config, err := json.Marshal(&wafEngineConfig{
+		DirectivesMap: map[string][]string{
+			wafDefaultDirectivesName: directives,
+		},
+		DefaultDirectives: wafDefaultDirectivesName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	engineConfig := &apiextensionsv1.JSON{Raw: config}
+
+	wafIR := &ir.WAF{
+		Name: name,
+	}
+
+	switch waf.Engine.Type {
+	case egv1a1.WAFEngineTypeWasm:
+		// This is a sanity check, the validation should have caught this
+		if waf.Engine.Wasm == nil {
+			return nil, errors.New("missing wasm field in WAF engine")
+		}
+		code, err := t.buildWasmCode(waf.Engine.Wasm, ownerPolicy, resource.KindSecurityPolicy, name, resources)
+		if err != nil {
+			return nil, err
+		}
+		wafIR.Wasm = &ir.Wasm{
+			Name:     name,
+			WasmName: name,
+			Config:   engineConfig,
+			Code:     code,
+		}
+	case egv1a1.WAFEngineTypeDynamicModule:
+		// This is a sanity check, the validation should have caught this
+		if waf.Engine.DynamicModule == nil {
+			return nil, errors.New("missing dynamicModule field in WAF engine")
+		}
+		dm := waf.Engine.DynamicModule
+		var entry *egv1a1.DynamicModuleEntry
+		if envoyProxy != nil {
+			for i := range envoyProxy.Spec.DynamicModules {
+				if envoyProxy.Spec.DynamicModules[i].Name == dm.Name {
+					entry = &envoyProxy.Spec.DynamicModules[i]
+					break
+				}
+			}
+		}
+		if entry == nil {
+			return nil, fmt.Errorf("dynamic module %q is not registered in the EnvoyProxy dynamicModules allowlist", dm.Name)
+		}
+		wafIR.DynamicModule = &ir.DynamicModule{
+			Name:         name,
+			FilterName:   ptr.Deref(dm.FilterName, ""),
+			Config:       engineConfig,
+			DoNotClose:   ptr.Deref(entry.DoNotClose, false),
+			LoadGlobally: ptr.Deref(entry.LoadGlobally, false),
+		}
+		if err := setDynamicModuleSource(wafIR.DynamicModule, entry); err != nil {
+			return nil, err
+		}
+	default:
+		// should never happen because of kubebuilder validation, just a sanity check
+		return nil, fmt.Errorf("unsupported WAF engine type %q", waf.Engine.Type)
+	}
+
+	return wafIR, nil
+}
+
+// buildWAFDirectives returns the SecLang directives passed to the WAF rule engine.
+// The directives are, in order:
+// - the runtime exclusions that only apply to the requests matching a path prefix
+// - the rules in the referenced rule sets
+// - the exclusions that apply to all the requests, which must be defined after the rules
+// - the SecRuleEngine directive for the mode, which overrides the one in the rule sets
+func (t *Translator) buildWAFDirectives(waf *egv1a1.WAF, policyNs string) ([]string, error) {
+	var (
+		directives []string
+		errs       error
+	)
+
+	for i, exclusion := range waf.Exclusions {
+		if exclusion.PathPrefix == nil {
+			continue
+		}
+		actions := []string{
+			"id:" + strconv.Itoa(wafExclusionRuleIDBase+i),
+			"phase:1",
+			"pass",
+			"nolog",
+		}
+		for _, id := range exclusion.RuleIDs {
+			actions = append(actions, "ctl:ruleRemoveById="+strconv.FormatUint(uint64(id), 10))
+		}
+		for _, tag := range exclusion.RuleTags {
+			actions = append(actions, "ctl:ruleRemoveByTag="+tag)
+		}
+		directives = append(directives, fmt.Sprintf(
+			`SecRule REQUEST_FILENAME "@beginsWith %s" "%s"`, *exclusion.PathPrefix, strings.Join(actions, ",")))
+	}
+
+	for _, ruleSet := range waf.RuleSets {
+		cm := t.GetConfigMap(policyNs, string(ruleSet.Name))
+		if cm == nil {
+			errs = errors.Join(errs, fmt.Errorf("can't find the referenced configmap %s in namespace %s", ruleSet.Name, policyNs))
+			continue
+		}
+
+		keys := []string{egv1a1.WAFRulesKey}
+		if _, ok := cm.Data[egv1a1.WAFRulesKey]; !ok {
+			keys = make([]string, 0, len(cm.Data))
+			for key := range cm.Data {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+		}
+		if len(keys) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("can't find any rules in the referenced configmap %s/%s", policyNs, ruleSet.Name))
+			continue
+		}
+
+		for _, key := range keys {
+			parsed, err := parseWAFDirectives(cm.Data[key])
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("invalid rules in key %s of configmap %s/%s: %w", key, policyNs, ruleSet.Name, err))
+				continue
+			}
+			directives = append(directives, parsed...)
+		}
+	}
+	if errs != nil {
+		return nil, errs
+	}
+
+	for _, exclusion := range waf.Exclusions {
+		if exclusion.PathPrefix != nil {
+			continue
+		}
+		if len(exclusion.RuleIDs) > 0 {
+			ids := make([]string, 0, len(exclusion.RuleIDs))
+			for _, id := range exclusion.RuleIDs {
+				ids = append(ids, strconv.FormatUint(uint64(id), 10))
+			}
+			directives = append(directives, "SecRuleRemoveById "+strings.Join(ids, " "))
+		}
+		for _, tag := range exclusion.RuleTags {
+			directives = append(directives, "SecRuleRemoveByTag "+tag)
+		}
+	}
+
+	if ptr.Deref(waf.Mode, egv1a1.WAFModeBlock) == egv1a1.WAFModeDetect {
+		directives = append(directives, "SecRuleEngine DetectionOnly")
+	} else {
+		directives = append(directives, "SecRuleEngine On")
+	}
+
+	return directives, nil
+}
+
+// compileWAFDirectives compiles the directives with the Coraza rule engine, so that
+// the invalid operators, actions and variables are reported on the policy status
+// instead of failing when the proxy loads them. The included files are only read
+// from the CRS embedded in the rule engine.
+func compileWAFDirectives(directives []string) error {
+	rules := strings.Join(directives, "\n")
+	key := sha256.Sum256([]byte(rules))
+	if err, ok := wafCompileResults.Get(key); ok {
+		return err
+	}
+
+	_, err := coraza.NewWAF(coraza.NewWAFConfig().
+		WithRootFS(coreruleset.FS).
+		WithDirectives(rules))
+	if err != nil {
+		err = fmt.Errorf("failed to compile the WAF rules: %w", err)
+	}
+	wafCompileResults.Add(key, err)
+	return err
+}
+
+// parseWAFDirectives parses the SecLang rules, and returns one directive per
+// logical line, with the line continuations joined and the comments removed.
+// It only validates the syntax of the directives, the operators and actions of
+// the rules are validated by compileWAFDirectives.
+func parseWAFDirectives(rules string) ([]string, error) {
+	var (
+		directives []string
+		errs       error
+		current    strings.Builder
+		startLine  int
+		// whether the next rule is chained to the previous one and doesn't need an id
+		chained bool
+	)
+
+	lines := strings.Split(strings.ReplaceAll(rules, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if current.Len() == 0 {
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			startLine = i + 1
+		}
+
+		if strings.HasSuffix(line, `\`) && i < len(lines)-1 {
+			current.WriteString(strings.TrimSuffix(line, `\`))
+			continue
+		}
+		current.WriteString(line)
+
+		directive := current.String()
+		current.Reset()
+
+		isChainedRule := chained
+		var err error
+		chained, err = validateWAFDirective(directive, isChainedRule)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("line %d: %w", startLine, err))
+			continue
+		}
+		directives = append(directives, directive)
+	}
+	if chained {
+		errs = errors.Join(errs, errors.New("the last rule is chained to a rule that doesn't exist"))
+	}
+
+	return directives, errs
+}
+
+// validateWAFDirective validates a single SecLang directive, and returns
+// whether the next rule is chained to this one.
+func validateWAFDirective(directive string, isChainedRule bool) (bool, error) {
+	args, err := splitWAFDirectiveArgs(directive)
+	if err != nil {
+		return false, err
+	}
+
+	name := args[0]
+	if strings.EqualFold(name, "Include") {
+		if isChainedRule {
+			return false, fmt.Errorf("directive %q can't follow a chained rule", name)
+		}
+		return false, validateWAFInclude(args)
+	}
+	if !wafDirectives[strings.ToLower(name)] {
+		return false, fmt.Errorf("unknown directive %q", name)
+	}
+	if isChainedRule && !strings.EqualFold(name, "SecRule") {
+		return false, fmt.Errorf("directive %q can't follow a chained rule", name)
+	}
+
+	var actions string
+	switch strings.ToLower(name) {
+	case "secrule":
+		if len(args) != 3 && len(args) != 4 {
+			return false, errors.New("SecRule requires variables, an operator, and optional actions")
+		}
+		if len(args) == 4 {
+			actions = args[3]
+		}
+	case "secaction":
+		if len(args) != 2 {
+			return false, errors.New("SecAction requires exactly one list of actions")
+		}
+		actions = args[1]
+	default:
+		return false, nil
+	}
+
+	if match := wafRuleIDActionRegex.FindStringSubmatch(actions); match != nil {
+		if id, err := strconv.Atoi(match[2]); err == nil && id >= wafReservedRuleIDMin && id <= wafReservedRuleIDMax {
+			return false, fmt.Errorf("%s id %d is in the range %d-%d reserved by Envoy Gateway",
+				name, id, wafReservedRuleIDMin, wafReservedRuleIDMax)
+		}
+	} else if !isChainedRule {
+		return false, fmt.Errorf("%s must have an id action", name)
+	}
+	return wafRuleChainActionRegex.MatchString(actions), nil
+}
+
+// validateWAFInclude validates an Include directive. Only the rules embedded in the
+// WAF rule engine, whose path starts with @, such as @owasp_crs/*.conf, can be included,
+// so that the rule sets can't read arbitrary files on the proxy.
+func validateWAFInclude(args []string) error {
+	if len(args) != 2 {
+		return errors.New("directive Include requires exactly one path")
+	}
+	path := args[1]
+	if !strings.HasPrefix(path, "@") || strings.Contains(path, "..") {
+		return fmt.Errorf("directive Include path %q must reference the rules embedded in the WAF rule engine, starting with @", path)
+	}
+	return nil
+}
+
+// splitWAFDirectiveArgs splits a SecLang directive into its whitespace separated
+// arguments, the double quotes around an argument are removed.
+func splitWAFDirectiveArgs(directive string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		quoted  bool
+		escaped bool
+		inArg   bool
+	)
+
+	for _, r := range directive {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			current.WriteRune(r)
+			escaped = true
+		case r == '"' && (quoted || !inArg):
+			quoted = !quoted
+			inArg = true
+			if !quoted {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		case (r == ' ' || r == '\t') && !quoted:
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quoted {
+		return nil, errors.New("unterminated quoted string")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+func irConfigNameForWAF(policy *egv1a1.SecurityPolicy) string {
+	return fmt.Sprintf("%s/waf", irConfigName(policy))
+}
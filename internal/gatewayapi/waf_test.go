@@ -0,0 +1,194 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWAFDirectives(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "comments and empty lines are removed",
+			rules: `# comment
+
+SecRuleEngine On
+  # indented comment
+SecRequestBodyAccess On
+`,
+			want: []string{"SecRuleEngine On", "SecRequestBodyAccess On"},
+		},
+		{
+			name: "line continuations are joined",
+			rules: `SecRule ARGS "@rx foo" \
+    "id:1,\
+    phase:2,\
+    deny"`,
+			want: []string{`SecRule ARGS "@rx foo" "id:1,phase:2,deny"`},
+		},
+		{
+			name:  "CRLF line endings",
+			rules: "SecRuleEngine On\r\nSecAction \"id:2,phase:1,pass\"\r\n",
+			want:  []string{"SecRuleEngine On", `SecAction "id:2,phase:1,pass"`},
+		},
+		{
+			name: "chained rules don't need an id",
+			rules: `SecRule ARGS "@rx foo" "id:3,phase:2,deny,chain"
+SecRule REQUEST_METHOD "@streq POST" "chain"
+SecRule REQUEST_HEADERS:Host "@streq example.com"`,
+			want: []string{
+				`SecRule ARGS "@rx foo" "id:3,phase:2,deny,chain"`,
+				`SecRule REQUEST_METHOD "@streq POST" "chain"`,
+				`SecRule REQUEST_HEADERS:Host "@streq example.com"`,
+			},
+		},
+		{
+			name:  "escaped quotes in a quoted argument",
+			rules: `SecRule ARGS "@rx \"quoted\"" "id:4,phase:2,deny,msg:'a \"msg\"'"`,
+			want:  []string{`SecRule ARGS "@rx \"quoted\"" "id:4,phase:2,deny,msg:'a \"msg\"'"`},
+		},
+		{
+			name:  "directives are case insensitive",
+			rules: `secruleengine DetectionOnly`,
+			want:  []string{"secruleengine DetectionOnly"},
+		},
+		{
+			name:    "unknown directive",
+			rules:   "SecRuleEngine On\nSecFoo bar",
+			wantErr: `line 2: unknown directive "SecFoo"`,
+		},
+		{
+			name:    "rule without id",
+			rules:   `SecRule ARGS "@rx foo" "phase:2,deny"`,
+			wantErr: "line 1: SecRule must have an id action",
+		},
+		{
+			name:    "rule without operator",
+			rules:   `SecRule ARGS`,
+			wantErr: "line 1: SecRule requires variables, an operator, and optional actions",
+		},
+		{
+			name:    "action without id",
+			rules:   `SecAction "phase:1,pass"`,
+			wantErr: "line 1: SecAction must have an id action",
+		},
+		{
+			name:  "include of the embedded rules",
+			rules: `Include @owasp_crs/*.conf`,
+			want:  []string{"Include @owasp_crs/*.conf"},
+		},
+		{
+			name:    "include of a file",
+			rules:   `Include /etc/passwd`,
+			wantErr: `line 1: directive Include path "/etc/passwd" must reference the rules embedded in the WAF rule engine`,
+		},
+		{
+			name:    "include escaping the embedded rules",
+			rules:   `Include @owasp_crs/../../etc/passwd`,
+			wantErr: "must reference the rules embedded in the WAF rule engine",
+		},
+		{
+			name:    "filesystem directive",
+			rules:   `SecAuditLog /tmp/audit.log`,
+			wantErr: `line 1: unknown directive "SecAuditLog"`,
+		},
+		{
+			name:    "rule with a reserved id",
+			rules:   `SecRule ARGS "@rx foo" "id:99900,phase:2,deny"`,
+			wantErr: "line 1: SecRule id 99900 is in the range 99900-99999 reserved by Envoy Gateway",
+		},
+		{
+			name:    "chained rule with a reserved id",
+			rules:   "SecRule ARGS \"@rx foo\" \"id:8,phase:2,deny,chain\"\nSecRule ARGS \"@rx bar\" \"id:'99999'\"",
+			wantErr: "line 2: SecRule id 99999 is in the range 99900-99999 reserved by Envoy Gateway",
+		},
+		{
+			name:    "unterminated quoted string",
+			rules:   "SecRuleEngine On\n\nSecRule ARGS \"@rx foo",
+			wantErr: "line 3: unterminated quoted string",
+		},
+		{
+			name:    "dangling chain",
+			rules:   `SecRule ARGS "@rx foo" "id:5,phase:2,deny,chain"`,
+			wantErr: "the last rule is chained to a rule that doesn't exist",
+		},
+		{
+			name: "directive after a chained rule",
+			rules: `SecRule ARGS "@rx foo" "id:6,phase:2,deny,chain"
+SecAction "id:7,phase:1,pass"`,
+			wantErr: `line 2: directive "SecAction" can't follow a chained rule`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseWAFDirectives(tt.rules)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompileWAFDirectives(t *testing.T) {
+	tests := []struct {
+		name       string
+		directives []string
+		wantErr    string
+	}{
+		{
+			name: "valid rules",
+			directives: []string{
+				`SecRule ARGS "@rx foo" "id:1,phase:2,deny,status:403"`,
+				"SecRuleEngine On",
+			},
+		},
+		{
+			name: "embedded CRS",
+			directives: []string{
+				"Include @crs-setup.conf.example",
+				"Include @owasp_crs/*.conf",
+				"SecRuleEngine On",
+			},
+		},
+		{
+			name:       "unknown operator",
+			directives: []string{`SecRule ARGS "@unknown foo" "id:2,phase:2,deny"`},
+			wantErr:    "failed to compile the WAF rules",
+		},
+		{
+			name:       "invalid regular expression",
+			directives: []string{`SecRule ARGS "@rx (foo" "id:3,phase:2,deny"`},
+			wantErr:    "failed to compile the WAF rules",
+		},
+		{
+			name:       "file outside of the embedded CRS",
+			directives: []string{`SecRule REMOTE_ADDR "@ipMatchFromFile /etc/hosts" "id:4,phase:1,deny"`},
+			wantErr:    "failed to compile the WAF rules",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := compileWAFDirectives(tt.directives)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
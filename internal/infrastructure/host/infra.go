@@ -64,6 +64,17 @@ type Infra struct {
 	// envoyRunner runs Envoy (can be overridden in tests).
 	envoyRunner func_e_api.RunFunc
 
+	// rateLimitMu guards rateLimitContext and rateLimitEnv.
+	rateLimitMu sync.Mutex
+	// rateLimitContext stores the context of the running rate limit process for lifecycle management.
+	rateLimitContext *proxyContext
+	// rateLimitEnv is the environment the running rate limit process was started with.
+	rateLimitEnv []string
+	// rateLimitRunner runs the rate limit service with the given environment (can be overridden in tests).
+	rateLimitRunner func(ctx context.Context, env []string) error
+	// localBackendRunner runs the in-memory database of the Local rate limit backend (can be overridden in tests).
+	localBackendRunner func(ctx context.Context) error
+
 	// errors is the notifier used to send async errors to the main control loop.
 	errors message.RunnerErrorNotifier
 }
@@ -109,6 +120,8 @@ func NewInfra(_ context.Context, cfg *config.Server, logger logging.Logger, erro
 		envoyRunner:       func_e.Run,
 		errors:            errors,
 	}
+	infra.rateLimitRunner = infra.execRateLimit
+	infra.localBackendRunner = infra.execLocalBackend
 	return infra, nil
 }
 
@@ -45,6 +45,13 @@ func (i *Infra) Close() error {
 		return true
 	})
 
+	// Stop the rate limit subprocess, if any
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		i.stopRateLimit()
+	}()
+
 	wg.Wait()
 	return nil
 }
@@ -7,18 +7,252 @@ package host
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/ratelimit"
+)
+
+const (
+	// RateLimitBinary is the name of the rate limit executable looked up in $PATH
+	// when running the rate limit service on the host.
+	RateLimitBinary = "ratelimit"
+	// RateLimitLocalBackendBinary is the name of the in-memory database executable looked up in $PATH
+	// when running the Local rate limit backend on the host.
+	RateLimitLocalBackendBinary = "redis-server"
+	// rateLimitCertComponent is the certificate directory component of the rate limit service.
+	// This matches the directory written by `envoy-gateway certgen --local`.
+	rateLimitCertComponent = "envoy-rate-limit"
+	// rateLimitXdsServerHost is the host the rate limit service uses to reach the xDS config server.
+	rateLimitXdsServerHost = "localhost"
 )
 
-// TODO: add ratelimit support for host infra
+// GetRateLimitServiceURL returns the URL for the rate limit service running on the host.
+func GetRateLimitServiceURL() string {
+	return fmt.Sprintf("grpc://%s", net.JoinHostPort("localhost", strconv.Itoa(ratelimit.InfraGRPCPort)))
+}
 
 // CreateOrUpdateRateLimitInfra creates the managed host rate limit process, if it doesn't exist.
-func (i *Infra) CreateOrUpdateRateLimitInfra(_ context.Context) error {
-	return fmt.Errorf("create/update ratelimit infrastructure is not supported yet for host infrastructure")
+// If the process is running with a different configuration, it's restarted to apply the new one.
+func (i *Infra) CreateOrUpdateRateLimitInfra(ctx context.Context) error {
+	if i.EnvoyGateway == nil || i.EnvoyGateway.RateLimit == nil {
+		return errors.New("ratelimit configuration is nil")
+	}
+
+	env, err := i.buildRateLimitEnv(i.EnvoyGateway.RateLimit)
+	if err != nil {
+		return err
+	}
+
+	i.rateLimitMu.Lock()
+	defer i.rateLimitMu.Unlock()
+	if i.rateLimitContext != nil {
+		// Return directly if the rate limit service is running with the same configuration.
+		if slices.Equal(i.rateLimitEnv, env) {
+			return nil
+		}
+		i.Logger.Info("restarting ratelimit to apply the updated configuration")
+		stopRateLimitProcess(i.rateLimitContext)
+		i.rateLimitContext = nil
+	}
+
+	i.runRateLimit(ctx, env, i.EnvoyGateway.RateLimit.Backend.Type == egv1a1.LocalBackendType)
+	i.rateLimitEnv = env
+	return nil
+}
+
+// buildRateLimitEnv returns the environment of the rate limit process. It mirrors the
+// environment rendered for the rate limit Deployment on Kubernetes, with the TLS
+// material read from the host certificate directory.
+func (i *Infra) buildRateLimitEnv(rateLimit *egv1a1.RateLimit) ([]string, error) {
+	certDir := i.Paths.CertDir(rateLimitCertComponent)
+	certPath := filepath.Join(certDir, XdsTLSCertFilename)
+	keyPath := filepath.Join(certDir, XdsTLSKeyFilename)
+	caPath := filepath.Join(certDir, XdsTLSCaFilename)
+	for _, f := range []string{certPath, keyPath, caPath} {
+		if _, err := os.Stat(f); err != nil {
+			return nil, fmt.Errorf("failed to find ratelimit certificate %s, run `envoy-gateway certgen --local` to generate it: %w", f, err)
+		}
+	}
+
+	env := map[string]string{
+		ratelimit.RuntimeRootEnvVar:                    filepath.Join(i.Paths.RuntimeDir, ratelimit.InfraName),
+		ratelimit.RuntimeSubdirectoryEnvVar:            "ratelimit",
+		ratelimit.RuntimeIgnoreDotfilesEnvVar:          "true",
+		ratelimit.RuntimeWatchRootEnvVar:               "false",
+		ratelimit.LogLevelEnvVar:                       "info",
+		ratelimit.UseStatsdEnvVar:                      "false",
+		ratelimit.ConfigTypeEnvVar:                     "GRPC_XDS_SOTW",
+		ratelimit.ConfigGrpcXdsServerURLEnvVar:         net.JoinHostPort(rateLimitXdsServerHost, strconv.Itoa(ratelimit.XdsGrpcSotwConfigServerPort)),
+		ratelimit.ConfigGrpcXdsNodeIDEnvVar:            ratelimit.InfraName,
+		ratelimit.GRPCServerUseTLSEnvVar:               "true",
+		ratelimit.GRPCServerTLSCertEnvVar:              certPath,
+		ratelimit.GRPCServerTLSKeyEnvVarEnvVar:         keyPath,
+		ratelimit.GRPCServerTLSCACertEnvVar:            caPath,
+		ratelimit.ConfigGRPCXDSServerUseTLSEnvVar:      "true",
+		ratelimit.ConfigGRPCXDSClientTLSCertEnvVar:     certPath,
+		ratelimit.ConfigGRPCXDSClientTLSKeyEnvVar:      keyPath,
+		ratelimit.ConfigGRPCXDSServerTLSCACertEnvVar:   caPath,
+		ratelimit.ForceStartWithoutInitialConfigEnvVar: "true",
+	}
+
+	switch rateLimit.Backend.Type {
+	case egv1a1.LocalBackendType:
+		env[ratelimit.RedisSocketTypeEnvVar] = "tcp"
+		env[ratelimit.RedisURLEnvVar] = net.JoinHostPort("127.0.0.1", strconv.Itoa(ratelimit.LocalBackendPort))
+	case egv1a1.MemcachedBackendType:
+		memcached := rateLimit.Backend.Memcached
+		if memcached == nil {
+			break
+		}
+		env[ratelimit.BackendTypeEnvVar] = "memcache"
+		if len(memcached.HostPorts) > 0 {
+			env[ratelimit.MemcacheHostPortEnvVar] = strings.Join(memcached.HostPorts, ",")
+		}
+		if memcached.SRV != nil {
+			env[ratelimit.MemcacheSrvEnvVar] = *memcached.SRV
+		}
+		if memcached.SRVRefresh != nil {
+			env[ratelimit.MemcacheSrvRefreshEnvVar] = string(*memcached.SRVRefresh)
+		}
+		if memcached.MaxIdleConnections != nil {
+			env[ratelimit.MemcacheMaxIdleConnsEnvVar] = strconv.FormatUint(uint64(*memcached.MaxIdleConnections), 10)
+		}
+		if memcached.TLS != nil {
+			env[ratelimit.MemcacheTLSEnvVar] = "true"
+			if memcached.TLS.CertificateRef != nil {
+				return nil, errors.New("memcached tls certificateRef is not supported for host infrastructure")
+			}
+		}
+	case egv1a1.RedisBackendType:
+		redis := rateLimit.Backend.Redis
+		if redis == nil {
+			break
+		}
+		if redis.URLRef != nil {
+			return nil, errors.New("redis urlRef is not supported for host infrastructure, use url instead")
+		}
+		if ratelimit.RedisTLSCertificateRef(redis) != nil {
+			return nil, errors.New("redis tls certificateRef is not supported for host infrastructure")
+		}
+		for _, e := range ratelimit.RedisEnv(redis) {
+			if e.ValueFrom != nil {
+				return nil, fmt.Errorf("%s from a Secret is not supported for host infrastructure", e.Name)
+			}
+			env[e.Name] = e.Value
+		}
+	}
+
+	ret := make([]string, 0, len(env))
+	for k, v := range env {
+		ret = append(ret, k+"="+v)
+	}
+	slices.Sort(ret)
+	return ret, nil
 }
 
-// DeleteRateLimitInfra is a no-op for host infrastructure since rate limiting
-// is not yet supported, so there is nothing to clean up.
+// runRateLimit runs the rate limit process with the given environment in a separate goroutine.
+// With the Local backend, the in-memory database is run alongside, and stopped with it.
+func (i *Infra) runRateLimit(ctx context.Context, env []string, localBackend bool) {
+	// #nosec G118 - cancel is stored in rateLimitContext and called later to stop the rate limit process
+	rCtx, cancel := context.WithCancel(ctx)
+	exit := make(chan struct{}, 1)
+	i.rateLimitContext = &proxyContext{cancel: cancel, exit: exit}
+	go func() {
+		var wg sync.WaitGroup
+		defer func() {
+			// Stop the in-memory database if the rate limit process exited on its own.
+			cancel()
+			wg.Wait()
+			exit <- struct{}{}
+		}()
+		if localBackend {
+			wg.Go(func() {
+				if err := i.localBackendRunner(rCtx); err != nil && rCtx.Err() == nil {
+					i.Logger.Error(err, "failed to run the ratelimit local backend")
+					i.errors.Store(err)
+					cancel()
+				}
+			})
+		}
+		if err := i.rateLimitRunner(rCtx, env); err != nil && rCtx.Err() == nil {
+			i.Logger.Error(err, "failed to run ratelimit")
+			// If the rate limit process fails to start, notify an unrecoverable error so that the main control
+			// loop can properly handle it.
+			i.errors.Store(err)
+		}
+	}()
+}
+
+// execRateLimit runs the rate limit binary and blocks until it exits or ctx is done.
+func (i *Infra) execRateLimit(ctx context.Context, env []string) error {
+	binary, err := exec.LookPath(RateLimitBinary)
+	if err != nil {
+		return fmt.Errorf("failed to find %s binary: %w", RateLimitBinary, err)
+	}
+	if err := os.MkdirAll(filepath.Join(i.Paths.RuntimeDir, ratelimit.InfraName, "ratelimit"), 0o750); err != nil {
+		return fmt.Errorf("failed to create ratelimit runtime directory: %w", err)
+	}
+
+	// #nosec G204 - the binary is resolved from $PATH and takes no user provided arguments
+	cmd := exec.CommandContext(ctx, binary)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = i.Stdout
+	cmd.Stderr = i.Stderr
+	return cmd.Run()
+}
+
+// execLocalBackend runs the in-memory database of the Local backend and blocks until it exits or ctx is done.
+// Like the container run on Kubernetes, it only listens on the loopback interface and never persists its state.
+func (i *Infra) execLocalBackend(ctx context.Context) error {
+	binary, err := exec.LookPath(RateLimitLocalBackendBinary)
+	if err != nil {
+		return fmt.Errorf("failed to find %s binary: %w", RateLimitLocalBackendBinary, err)
+	}
+
+	// #nosec G204 - the binary is resolved from $PATH and takes no user provided arguments
+	cmd := exec.CommandContext(ctx, binary,
+		"--bind", "127.0.0.1",
+		"--port", strconv.Itoa(ratelimit.LocalBackendPort),
+		"--save", "",
+		"--appendonly", "no",
+	)
+	cmd.Stdout = i.Stdout
+	cmd.Stderr = i.Stderr
+	return cmd.Run()
+}
+
+// DeleteRateLimitInfra removes the managed host rate limit process, if it exists.
 func (i *Infra) DeleteRateLimitInfra(_ context.Context) error {
+	i.stopRateLimit()
 	return nil
 }
+
+// stopRateLimit stops the rate limit process. It will block until the process completely stopped.
+func (i *Infra) stopRateLimit() {
+	i.rateLimitMu.Lock()
+	rCtx := i.rateLimitContext
+	i.rateLimitContext = nil
+	i.rateLimitEnv = nil
+	i.rateLimitMu.Unlock()
+
+	if rCtx != nil {
+		stopRateLimitProcess(rCtx)
+	}
+}
+
+// stopRateLimitProcess stops the rate limit process of the given context, and blocks until it completely stopped.
+func stopRateLimitProcess(rCtx *proxyContext) {
+	rCtx.cancel()    // Cancel causes the rate limit process to exit.
+	<-rCtx.exit      // Wait for the rate limit process to completely exit.
+	close(rCtx.exit) // Close the channel to avoid leaking.
+}
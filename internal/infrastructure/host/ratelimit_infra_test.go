@@ -0,0 +1,211 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package host
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/crypto"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/ratelimit"
+	"github.com/envoyproxy/gateway/internal/utils/file"
+)
+
+// newMockRateLimitInfra returns a mock infra with rate limit certificates and a
+// rate limit runner that records its environment instead of running the binary.
+func newMockRateLimitInfra(t *testing.T, rl *egv1a1.RateLimit, envCh chan []string) *Infra {
+	t.Helper()
+	cfg, err := config.New(io.Discard, io.Discard)
+	require.NoError(t, err)
+	cfg.EnvoyGateway.RateLimit = rl
+
+	infra := newMockInfra(t, cfg)
+	certs, err := crypto.GenerateCerts(cfg)
+	require.NoError(t, err)
+	rlDir := infra.Paths.CertDir(rateLimitCertComponent)
+	require.NoError(t, file.WriteDir(certs.CACertificate, rlDir, "ca.crt"))
+	require.NoError(t, file.WriteDir(certs.EnvoyRateLimitCertificate, rlDir, "tls.crt"))
+	require.NoError(t, file.WriteDir(certs.EnvoyRateLimitPrivateKey, rlDir, "tls.key"))
+
+	infra.rateLimitRunner = func(ctx context.Context, env []string) error {
+		envCh <- env
+		// Block until context is cancelled (mimics the real rate limit process blocking)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return infra
+}
+
+func TestInfra_CreateOrUpdateRateLimitInfra(t *testing.T) {
+	rl := &egv1a1.RateLimit{
+		Backend: egv1a1.RateLimitDatabaseBackend{
+			Type: egv1a1.RedisBackendType,
+			Redis: &egv1a1.RateLimitRedisSettings{
+				URL: new("localhost:6379"),
+			},
+		},
+	}
+
+	t.Run("create and delete", func(t *testing.T) {
+		envCh := make(chan []string, 1)
+		infra := newMockRateLimitInfra(t, rl, envCh)
+		t.Cleanup(infra.stopRateLimit)
+
+		require.NoError(t, infra.CreateOrUpdateRateLimitInfra(t.Context()))
+		env := <-envCh
+
+		certDir := infra.Paths.CertDir(rateLimitCertComponent)
+		require.Contains(t, env, ratelimit.RedisURLEnvVar+"=localhost:6379")
+		require.Contains(t, env, ratelimit.ConfigGrpcXdsServerURLEnvVar+"=localhost:18001")
+		require.Contains(t, env, ratelimit.GRPCServerTLSCertEnvVar+"="+filepath.Join(certDir, "tls.crt"))
+		require.Contains(t, env, ratelimit.ConfigGRPCXDSServerTLSCACertEnvVar+"="+filepath.Join(certDir, "ca.crt"))
+
+		// Second call should be idempotent (early return without error)
+		require.NoError(t, infra.CreateOrUpdateRateLimitInfra(t.Context()))
+		require.NotNil(t, infra.rateLimitContext)
+
+		// An updated configuration restarts the rate limit process with the new environment.
+		infra.EnvoyGateway.RateLimit = &egv1a1.RateLimit{
+			Backend: egv1a1.RateLimitDatabaseBackend{
+				Type: egv1a1.RedisBackendType,
+				Redis: &egv1a1.RateLimitRedisSettings{
+					URL: new("localhost:6380"),
+				},
+			},
+		}
+		require.NoError(t, infra.CreateOrUpdateRateLimitInfra(t.Context()))
+		env = <-envCh
+		require.Contains(t, env, ratelimit.RedisURLEnvVar+"=localhost:6380")
+		require.NotNil(t, infra.rateLimitContext)
+
+		require.NoError(t, infra.DeleteRateLimitInfra(t.Context()))
+		require.Nil(t, infra.rateLimitContext)
+
+		// Deleting again should not error
+		require.NoError(t, infra.DeleteRateLimitInfra(t.Context()))
+	})
+
+	t.Run("missing certificates", func(t *testing.T) {
+		cfg, err := config.New(io.Discard, io.Discard)
+		require.NoError(t, err)
+		cfg.EnvoyGateway.RateLimit = rl
+		infra := newMockInfra(t, cfg)
+
+		err = infra.CreateOrUpdateRateLimitInfra(t.Context())
+		require.ErrorContains(t, err, "failed to find ratelimit certificate")
+	})
+
+	t.Run("redis urlRef unsupported", func(t *testing.T) {
+		infra := newMockRateLimitInfra(t, &egv1a1.RateLimit{
+			Backend: egv1a1.RateLimitDatabaseBackend{
+				Type: egv1a1.RedisBackendType,
+				Redis: &egv1a1.RateLimitRedisSettings{
+					URLRef: &egv1a1.RedisURLSource{},
+				},
+			},
+		}, make(chan []string, 1))
+
+		err := infra.CreateOrUpdateRateLimitInfra(t.Context())
+		require.ErrorContains(t, err, "redis urlRef is not supported")
+	})
+
+	t.Run("redis sentinel", func(t *testing.T) {
+		envCh := make(chan []string, 1)
+		infra := newMockRateLimitInfra(t, &egv1a1.RateLimit{
+			Backend: egv1a1.RateLimitDatabaseBackend{
+				Type: egv1a1.RedisBackendType,
+				Redis: &egv1a1.RateLimitRedisSettings{
+					RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+						Sentinel: &egv1a1.RedisSentinelSettings{
+							MasterName: "mymaster",
+							Addresses:  []string{"localhost:26379", "localhost:26380"},
+						},
+					},
+					PerSecond: &egv1a1.RateLimitRedisPerSecondSettings{
+						URL: new("localhost:6380"),
+						TLS: &egv1a1.RedisTLSSettings{},
+					},
+				},
+			},
+		}, envCh)
+		t.Cleanup(infra.stopRateLimit)
+
+		require.NoError(t, infra.CreateOrUpdateRateLimitInfra(t.Context()))
+		env := <-envCh
+		require.Contains(t, env, ratelimit.RedisTypeEnvVar+"=SENTINEL")
+		require.Contains(t, env, ratelimit.RedisURLEnvVar+"=mymaster,localhost:26379,localhost:26380")
+		require.Contains(t, env, ratelimit.RedisPerSecondEnvVar+"=true")
+		require.Contains(t, env, ratelimit.RedisPerSecondURLEnvVar+"=localhost:6380")
+		require.Contains(t, env, ratelimit.RedisPerSecondTLSEnvVar+"=true")
+		require.NotContains(t, env, ratelimit.RedisTLSEnvVar+"=true")
+	})
+
+	t.Run("redis sentinel authRef unsupported", func(t *testing.T) {
+		infra := newMockRateLimitInfra(t, &egv1a1.RateLimit{
+			Backend: egv1a1.RateLimitDatabaseBackend{
+				Type: egv1a1.RedisBackendType,
+				Redis: &egv1a1.RateLimitRedisSettings{
+					RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+						Sentinel: &egv1a1.RedisSentinelSettings{
+							MasterName: "mymaster",
+							Addresses:  []string{"localhost:26379"},
+							AuthRef:    &corev1.SecretKeySelector{Key: "password"},
+						},
+					},
+				},
+			},
+		}, make(chan []string, 1))
+
+		err := infra.CreateOrUpdateRateLimitInfra(t.Context())
+		require.ErrorContains(t, err, ratelimit.RedisSentinelAuthEnvVar+" from a Secret is not supported")
+	})
+
+	t.Run("local backend", func(t *testing.T) {
+		envCh := make(chan []string, 1)
+		infra := newMockRateLimitInfra(t, &egv1a1.RateLimit{
+			Backend: egv1a1.RateLimitDatabaseBackend{
+				Type: egv1a1.LocalBackendType,
+			},
+		}, envCh)
+		started, stopped := make(chan struct{}), make(chan struct{})
+		infra.localBackendRunner = func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			close(stopped)
+			return ctx.Err()
+		}
+
+		require.NoError(t, infra.CreateOrUpdateRateLimitInfra(t.Context()))
+		env := <-envCh
+		require.Contains(t, env, ratelimit.RedisSocketTypeEnvVar+"=tcp")
+		require.Contains(t, env, ratelimit.RedisURLEnvVar+"=127.0.0.1:6379")
+		<-started
+
+		// The in-memory database is stopped with the rate limit process.
+		require.NoError(t, infra.DeleteRateLimitInfra(t.Context()))
+		<-stopped
+	})
+
+	t.Run("nil ratelimit", func(t *testing.T) {
+		cfg, err := config.New(io.Discard, io.Discard)
+		require.NoError(t, err)
+		infra := newMockInfra(t, cfg)
+
+		err = infra.CreateOrUpdateRateLimitInfra(t.Context())
+		require.EqualError(t, err, "ratelimit configuration is nil")
+	})
+}
+
+func TestGetRateLimitServiceURL(t *testing.T) {
+	require.Equal(t, "grpc://localhost:8081", GetRateLimitServiceURL())
+}
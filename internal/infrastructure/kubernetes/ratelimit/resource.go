@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/api/v1alpha1/validation"
@@ -39,6 +40,54 @@ const (
 	RedisTLSClientKeyEnvVar = "REDIS_TLS_CLIENT_KEY"
 	// RedisTLSClientKeyFilename is the redis client key file.
 	RedisTLSClientKeyFilename = "/redis-certs/tls.key"
+	// RedisTypeEnvVar is the redis deployment type.
+	RedisTypeEnvVar = "REDIS_TYPE"
+	// RedisSentinelAuthEnvVar is the redis sentinel password.
+	RedisSentinelAuthEnvVar = "REDIS_SENTINEL_AUTH"
+	// RedisPoolSizeEnvVar is the redis connection pool size.
+	RedisPoolSizeEnvVar = "REDIS_POOL_SIZE"
+	// RedisPipelineWindowEnvVar is the redis implicit pipelining window.
+	RedisPipelineWindowEnvVar = "REDIS_PIPELINE_WINDOW"
+	// RedisPipelineLimitEnvVar is the redis implicit pipelining limit.
+	RedisPipelineLimitEnvVar = "REDIS_PIPELINE_LIMIT"
+	// RedisPerSecondEnvVar enables the separate redis for the per second limits.
+	RedisPerSecondEnvVar = "REDIS_PERSECOND"
+	// RedisPerSecondSocketTypeEnvVar is the per second redis socket type.
+	RedisPerSecondSocketTypeEnvVar = "REDIS_PERSECOND_SOCKET_TYPE"
+	// RedisPerSecondURLEnvVar is the per second redis url.
+	RedisPerSecondURLEnvVar = "REDIS_PERSECOND_URL"
+	// RedisPerSecondTLSEnvVar is the per second redis tls.
+	RedisPerSecondTLSEnvVar = "REDIS_PERSECOND_TLS"
+	// RedisPerSecondTypeEnvVar is the per second redis deployment type.
+	RedisPerSecondTypeEnvVar = "REDIS_PERSECOND_TYPE"
+	// RedisPerSecondSentinelAuthEnvVar is the per second redis sentinel password.
+	RedisPerSecondSentinelAuthEnvVar = "REDIS_PERSECOND_SENTINEL_AUTH"
+	// RedisPerSecondPoolSizeEnvVar is the per second redis connection pool size.
+	RedisPerSecondPoolSizeEnvVar = "REDIS_PERSECOND_POOL_SIZE"
+	// RedisPerSecondPipelineWindowEnvVar is the per second redis implicit pipelining window.
+	RedisPerSecondPipelineWindowEnvVar = "REDIS_PERSECOND_PIPELINE_WINDOW"
+	// RedisPerSecondPipelineLimitEnvVar is the per second redis implicit pipelining limit.
+	RedisPerSecondPipelineLimitEnvVar = "REDIS_PERSECOND_PIPELINE_LIMIT"
+	// BackendTypeEnvVar is the database backend type.
+	BackendTypeEnvVar = "BACKEND_TYPE"
+	// MemcacheHostPortEnvVar is the comma separated list of memcached host:port addresses.
+	MemcacheHostPortEnvVar = "MEMCACHE_HOST_PORT"
+	// MemcacheSrvEnvVar is the SRV record used to discover the memcached hosts.
+	MemcacheSrvEnvVar = "MEMCACHE_SRV"
+	// MemcacheSrvRefreshEnvVar is the refresh interval of the memcached SRV record.
+	MemcacheSrvRefreshEnvVar = "MEMCACHE_SRV_REFRESH"
+	// MemcacheMaxIdleConnsEnvVar is the maximum number of idle connections per memcached host.
+	MemcacheMaxIdleConnsEnvVar = "MEMCACHE_MAX_IDLE_CONNS"
+	// MemcacheTLSEnvVar is the memcached tls.
+	MemcacheTLSEnvVar = "MEMCACHE_TLS"
+	// MemcacheTLSClientCertEnvVar is the memcached tls client cert.
+	MemcacheTLSClientCertEnvVar = "MEMCACHE_TLS_CLIENT_CERT"
+	// MemcacheTLSClientCertFilename is the memcached tls client cert file.
+	MemcacheTLSClientCertFilename = "/memcached-certs/tls.crt"
+	// MemcacheTLSClientKeyEnvVar is the memcached tls client key.
+	MemcacheTLSClientKeyEnvVar = "MEMCACHE_TLS_CLIENT_KEY"
+	// MemcacheTLSClientKeyFilename is the memcached tls client key file.
+	MemcacheTLSClientKeyFilename = "/memcached-certs/tls.key"
 	// RuntimeRootEnvVar is the runtime root.
 	RuntimeRootEnvVar = "RUNTIME_ROOT"
 	// RuntimeSubdirectoryEnvVar is the runtime subdirectory.
@@ -104,6 +153,10 @@ const (
 	XdsGrpcSotwConfigServerPort = 18001
 	// XdsGrpcSotwConfigServerHost is the hostname of the ratelimit xDS config server.
 	XdsGrpcSotwConfigServerHost = "envoy-gateway"
+	// LocalBackendContainerName is the name of the in-memory database container of the Local backend.
+	LocalBackendContainerName = "local-backend"
+	// LocalBackendPort is the port the in-memory database of the Local backend listens on.
+	LocalBackendPort = 6379
 	// ReadinessPath is readiness path for readiness probe.
 	ReadinessPath = "/healthcheck"
 	// ReadinessPort is readiness port for readiness probe.
@@ -216,9 +269,47 @@ func expectedRateLimitContainers(rateLimit *egv1a1.RateLimit, rateLimitDeploymen
 		},
 	}
 
+	if rateLimit.Backend.Type == egv1a1.LocalBackendType {
+		containers = append(containers, expectedLocalBackendContainer(rateLimit.Backend.Local, rateLimitDeployment))
+	}
+
 	return containers
 }
 
+// expectedLocalBackendContainer returns the in-memory database container of the Local backend.
+// The database only listens on the loopback interface and never persists its state.
+func expectedLocalBackendContainer(local *egv1a1.RateLimitLocalSettings, rateLimitDeployment *egv1a1.KubernetesDeploymentSpec) corev1.Container {
+	image := egv1a1.DefaultRateLimitLocalBackendImage
+	var resources corev1.ResourceRequirements
+	if local != nil {
+		if local.Image != nil {
+			image = *local.Image
+		}
+		if local.Resources != nil {
+			resources = *local.Resources
+		}
+	}
+
+	return corev1.Container{
+		Name:            LocalBackendContainerName,
+		Image:           image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command: []string{
+			"redis-server",
+		},
+		Args: []string{
+			"--bind", "127.0.0.1",
+			"--port", strconv.Itoa(LocalBackendPort),
+			"--save", "",
+			"--appendonly", "no",
+		},
+		Resources:                resources,
+		SecurityContext:          expectedRateLimitContainerSecurityContext(rateLimitDeployment),
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		TerminationMessagePath:   "/dev/termination-log",
+	}
+}
+
 // expectedContainerVolumeMounts returns expected rateLimit container volume mounts.
 func expectedContainerVolumeMounts(rateLimit *egv1a1.RateLimit, rateLimitDeployment *egv1a1.KubernetesDeploymentSpec) []corev1.VolumeMount {
 	var volumeMounts []corev1.VolumeMount
@@ -238,7 +329,7 @@ func expectedContainerVolumeMounts(rateLimit *egv1a1.RateLimit, rateLimitDeploym
 		})
 	}
 
-	if rateLimit.Backend.Redis.TLS != nil {
+	if redis := redisBackend(rateLimit); redis != nil && RedisTLSCertificateRef(redis) != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "redis-certs",
 			MountPath: "/redis-certs",
@@ -246,6 +337,15 @@ func expectedContainerVolumeMounts(rateLimit *egv1a1.RateLimit, rateLimitDeploym
 		})
 	}
 
+	if memcached := memcachedBackend(rateLimit); memcached != nil &&
+		memcached.TLS != nil && memcached.TLS.CertificateRef != nil {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "memcached-certs",
+			MountPath: "/memcached-certs",
+			ReadOnly:  true,
+		})
+	}
+
 	return resource.ExpectedContainerVolumeMounts(rateLimitDeployment.Container, volumeMounts)
 }
 
@@ -253,14 +353,25 @@ func expectedContainerVolumeMounts(rateLimit *egv1a1.RateLimit, rateLimitDeploym
 func expectedDeploymentVolumes(rateLimit *egv1a1.RateLimit, rateLimitDeployment *egv1a1.KubernetesDeploymentSpec) []corev1.Volume {
 	var volumes []corev1.Volume
 
-	if rateLimit.Backend.Redis != nil &&
-		rateLimit.Backend.Redis.TLS != nil &&
-		rateLimit.Backend.Redis.TLS.CertificateRef != nil {
+	if redis := redisBackend(rateLimit); redis != nil && RedisTLSCertificateRef(redis) != nil {
 		volumes = append(volumes, corev1.Volume{
 			Name: "redis-certs",
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName:  string(rateLimit.Backend.Redis.TLS.CertificateRef.Name),
+					SecretName:  string(RedisTLSCertificateRef(redis).Name),
+					DefaultMode: new(int32(420)),
+				},
+			},
+		})
+	}
+
+	if memcached := memcachedBackend(rateLimit); memcached != nil &&
+		memcached.TLS != nil && memcached.TLS.CertificateRef != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: "memcached-certs",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  string(memcached.TLS.CertificateRef.Name),
 					DefaultMode: new(int32(420)),
 				},
 			},
@@ -375,44 +486,75 @@ func expectedRateLimitContainerEnv(rateLimit *egv1a1.RateLimit, rateLimitDeploym
 		},
 	}
 
-	if rateLimit.Backend.Redis != nil {
-		redisURLEnv := corev1.EnvVar{Name: RedisURLEnvVar}
-		if rateLimit.Backend.Redis.URLRef != nil {
-			redisURLEnv.ValueFrom = &corev1.EnvVarSource{
-				SecretKeyRef: rateLimit.Backend.Redis.URLRef.SecretKeyRef,
+	// The settings of the database are rendered for the backend type only. The settings of the
+	// other backends are rejected by the validation of the Envoy Gateway configuration.
+	switch rateLimit.Backend.Type {
+	case egv1a1.RedisBackendType:
+		if redis := redisBackend(rateLimit); redis != nil {
+			env = append(env, RedisEnv(redis)...)
+		}
+	case egv1a1.MemcachedBackendType:
+		if memcached := memcachedBackend(rateLimit); memcached != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  BackendTypeEnvVar,
+				Value: "memcache",
+			})
+			if len(memcached.HostPorts) > 0 {
+				env = append(env, corev1.EnvVar{
+					Name:  MemcacheHostPortEnvVar,
+					Value: strings.Join(memcached.HostPorts, ","),
+				})
+			}
+			if memcached.SRV != nil {
+				env = append(env, corev1.EnvVar{
+					Name:  MemcacheSrvEnvVar,
+					Value: *memcached.SRV,
+				})
+			}
+			if memcached.SRVRefresh != nil {
+				env = append(env, corev1.EnvVar{
+					Name:  MemcacheSrvRefreshEnvVar,
+					Value: string(*memcached.SRVRefresh),
+				})
+			}
+			if memcached.MaxIdleConnections != nil {
+				env = append(env, corev1.EnvVar{
+					Name:  MemcacheMaxIdleConnsEnvVar,
+					Value: strconv.FormatUint(uint64(*memcached.MaxIdleConnections), 10),
+				})
+			}
+			if memcached.TLS != nil {
+				env = append(env, corev1.EnvVar{
+					Name:  MemcacheTLSEnvVar,
+					Value: "true",
+				})
+				if memcached.TLS.CertificateRef != nil {
+					env = append(env, []corev1.EnvVar{
+						{
+							Name:  MemcacheTLSClientCertEnvVar,
+							Value: MemcacheTLSClientCertFilename,
+						},
+						{
+							Name:  MemcacheTLSClientKeyEnvVar,
+							Value: MemcacheTLSClientKeyFilename,
+						},
+					}...)
+				}
 			}
-		} else if rateLimit.Backend.Redis.URL != nil {
-			redisURLEnv.Value = *rateLimit.Backend.Redis.URL
 		}
+	case egv1a1.LocalBackendType:
 		env = append(env, []corev1.EnvVar{
 			{
 				Name:  RedisSocketTypeEnvVar,
 				Value: "tcp",
 			},
-			redisURLEnv,
+			{
+				Name:  RedisURLEnvVar,
+				Value: net.JoinHostPort("127.0.0.1", strconv.Itoa(LocalBackendPort)),
+			},
 		}...)
 	}
 
-	if rateLimit.Backend.Redis != nil && rateLimit.Backend.Redis.TLS != nil {
-		env = append(env, corev1.EnvVar{
-			Name:  RedisTLSEnvVar,
-			Value: "true",
-		})
-
-		if rateLimit.Backend.Redis.TLS.CertificateRef != nil {
-			env = append(env, []corev1.EnvVar{
-				{
-					Name:  RedisTLSClientCertEnvVar,
-					Value: RedisTLSClientCertFilename,
-				},
-				{
-					Name:  RedisTLSClientKeyEnvVar,
-					Value: RedisTLSClientKeyFilename,
-				},
-			}...)
-		}
-	}
-
 	if enablePrometheus(rateLimit) {
 		env = append(env, corev1.EnvVar{
 			Name:  "USE_PROMETHEUS",
@@ -477,43 +619,253 @@ func expectedRateLimitContainerEnv(rateLimit *egv1a1.RateLimit, rateLimitDeploym
 	return resource.ExpectedContainerEnv(rateLimitDeployment.Container, env)
 }
 
-// Validate validates the ratelimit redis url/tls secret references.
+// redisEnvNames holds the names of the environment variables of a redis database.
+type redisEnvNames struct {
+	socketType     string
+	url            string
+	redisType      string
+	sentinelAuth   string
+	poolSize       string
+	pipelineWindow string
+	pipelineLimit  string
+}
+
+var (
+	redisEnvVars = redisEnvNames{
+		socketType:     RedisSocketTypeEnvVar,
+		url:            RedisURLEnvVar,
+		redisType:      RedisTypeEnvVar,
+		sentinelAuth:   RedisSentinelAuthEnvVar,
+		poolSize:       RedisPoolSizeEnvVar,
+		pipelineWindow: RedisPipelineWindowEnvVar,
+		pipelineLimit:  RedisPipelineLimitEnvVar,
+	}
+	redisPerSecondEnvVars = redisEnvNames{
+		socketType:     RedisPerSecondSocketTypeEnvVar,
+		url:            RedisPerSecondURLEnvVar,
+		redisType:      RedisPerSecondTypeEnvVar,
+		sentinelAuth:   RedisPerSecondSentinelAuthEnvVar,
+		poolSize:       RedisPerSecondPoolSizeEnvVar,
+		pipelineWindow: RedisPerSecondPipelineWindowEnvVar,
+		pipelineLimit:  RedisPerSecondPipelineLimitEnvVar,
+	}
+)
+
+// RedisEnv returns the environment variables of the rate limit service for the redis backend.
+// The URL sourced from a Secret and the sentinel passwords are set with a Secret key reference.
+func RedisEnv(redis *egv1a1.RateLimitRedisSettings) []corev1.EnvVar {
+	urlEnv := corev1.EnvVar{Name: RedisURLEnvVar}
+	if redis.URLRef != nil {
+		urlEnv.ValueFrom = &corev1.EnvVarSource{
+			SecretKeyRef: redis.URLRef.SecretKeyRef,
+		}
+	} else if redis.URL != nil {
+		urlEnv.Value = *redis.URL
+	}
+	env := redisConnectionEnv(redisEnvVars, urlEnv, &redis.RedisConnectionSettings)
+
+	if redis.TLS != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  RedisTLSEnvVar,
+			Value: "true",
+		})
+	}
+
+	if perSecond := redis.PerSecond; perSecond != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  RedisPerSecondEnvVar,
+			Value: "true",
+		})
+		urlEnv := corev1.EnvVar{Name: RedisPerSecondURLEnvVar}
+		if perSecond.URL != nil {
+			urlEnv.Value = *perSecond.URL
+		}
+		env = append(env, redisConnectionEnv(redisPerSecondEnvVars, urlEnv, &perSecond.RedisConnectionSettings)...)
+		if perSecond.TLS != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  RedisPerSecondTLSEnvVar,
+				Value: "true",
+			})
+		}
+	}
+
+	// The client certificate is used for both the main and the per second databases.
+	if RedisTLSCertificateRef(redis) != nil {
+		env = append(env, []corev1.EnvVar{
+			{
+				Name:  RedisTLSClientCertEnvVar,
+				Value: RedisTLSClientCertFilename,
+			},
+			{
+				Name:  RedisTLSClientKeyEnvVar,
+				Value: RedisTLSClientKeyFilename,
+			},
+		}...)
+	}
+
+	return env
+}
+
+// RedisTLSCertificateRef returns the client certificate used to connect to the redis databases.
+// The rate limit service uses one client certificate for the main and the per second databases.
+func RedisTLSCertificateRef(redis *egv1a1.RateLimitRedisSettings) *gwapiv1.SecretObjectReference {
+	if redis.TLS != nil && redis.TLS.CertificateRef != nil {
+		return redis.TLS.CertificateRef
+	}
+	if redis.PerSecond != nil && redis.PerSecond.TLS != nil {
+		return redis.PerSecond.TLS.CertificateRef
+	}
+	return nil
+}
+
+// redisConnectionEnv returns the environment variables of the connection to a redis database.
+// For Sentinel and Cluster deployments, the URL is built from the addresses.
+func redisConnectionEnv(names redisEnvNames, urlEnv corev1.EnvVar, settings *egv1a1.RedisConnectionSettings) []corev1.EnvVar {
+	var redisType string
+	switch {
+	case settings.Sentinel != nil:
+		redisType = "SENTINEL"
+		// The URL of a Sentinel deployment is the master name followed by the sentinel addresses.
+		urlEnv.Value = strings.Join(append([]string{settings.Sentinel.MasterName}, settings.Sentinel.Addresses...), ",")
+	case settings.Cluster != nil:
+		redisType = "CLUSTER"
+		urlEnv.Value = strings.Join(settings.Cluster.Addresses, ",")
+	}
+
+	env := []corev1.EnvVar{
+		{
+			Name:  names.socketType,
+			Value: "tcp",
+		},
+		urlEnv,
+	}
+	if redisType != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  names.redisType,
+			Value: redisType,
+		})
+	}
+	if settings.Sentinel != nil && settings.Sentinel.AuthRef != nil {
+		env = append(env, corev1.EnvVar{
+			Name: names.sentinelAuth,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: settings.Sentinel.AuthRef,
+			},
+		})
+	}
+	if settings.PoolSize != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  names.poolSize,
+			Value: strconv.FormatUint(uint64(*settings.PoolSize), 10),
+		})
+	}
+	if settings.PipelineWindow != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  names.pipelineWindow,
+			Value: string(*settings.PipelineWindow),
+		})
+	}
+	if settings.PipelineLimit != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  names.pipelineLimit,
+			Value: strconv.FormatUint(uint64(*settings.PipelineLimit), 10),
+		})
+	}
+	return env
+}
+
+// Validate validates the ratelimit database backend and its secret references.
 func Validate(ctx context.Context, client client.Client, gateway *egv1a1.EnvoyGateway, namespace string) error {
-	redis := gateway.RateLimit.Backend.Redis
+	if err := validation.ValidateRateLimitBackend(&gateway.RateLimit.Backend); err != nil {
+		return err
+	}
+
+	if memcached := memcachedBackend(gateway.RateLimit); memcached != nil &&
+		memcached.TLS != nil && memcached.TLS.CertificateRef != nil {
+		if _, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, memcached.TLS.CertificateRef, namespace); err != nil {
+			return err
+		}
+	}
+
+	redis := redisBackend(gateway.RateLimit)
 	if redis == nil {
 		return nil
 	}
 
 	if redis.URLRef != nil && redis.URLRef.SecretKeyRef != nil {
 		ref := redis.URLRef.SecretKeyRef
-		secret := &corev1.Secret{}
-		err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret)
-		switch {
-		case apierrors.IsNotFound(err):
-			// The Secret may be provisioned after the EnvoyGateway config (e.g. by an
-			// external controller such as Crossplane). The ratelimit Deployment consumes
-			// the URL via valueFrom.secretKeyRef, so the kubelet starts the container once
-			// the Secret and key exist. Don't block infra creation on a not-yet-present Secret.
-		case err != nil:
-			return fmt.Errorf("failed to get Secret %s in namespace %s: %w", ref.Name, namespace, err)
-		default:
-			// Validate the value only when present; a missing key is resolved later by the kubelet.
-			if value, ok := secret.Data[ref.Key]; ok {
-				if verr := validation.ValidateRedisURL(string(value)); verr != nil {
-					return fmt.Errorf("invalid Redis URL in Secret %s/%s key %q: %w", namespace, ref.Name, ref.Key, verr)
-				}
+		value, ok, err := getSecretKeyValue(ctx, client, namespace, ref)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if verr := validation.ValidateRedisURL(string(value)); verr != nil {
+				return fmt.Errorf("invalid Redis URL in Secret %s/%s key %q: %w", namespace, ref.Name, ref.Key, verr)
 			}
 		}
 	}
 
-	if redis.TLS != nil && redis.TLS.CertificateRef != nil {
-		_, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, redis.TLS.CertificateRef, namespace)
+	sentinels := []*egv1a1.RedisSentinelSettings{redis.Sentinel}
+	if redis.PerSecond != nil {
+		sentinels = append(sentinels, redis.PerSecond.Sentinel)
+	}
+	for _, sentinel := range sentinels {
+		if sentinel == nil || sentinel.AuthRef == nil {
+			continue
+		}
+		ref := sentinel.AuthRef
+		value, ok, err := getSecretKeyValue(ctx, client, namespace, ref)
+		if err != nil {
+			return err
+		}
+		if ok && len(value) == 0 {
+			return fmt.Errorf("empty Redis Sentinel password in Secret %s/%s key %q", namespace, ref.Name, ref.Key)
+		}
+	}
+
+	if certificateRef := RedisTLSCertificateRef(redis); certificateRef != nil {
+		_, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, certificateRef, namespace)
 		return err
 	}
 
 	return nil
 }
 
+// getSecretKeyValue returns the value of the Secret key referenced by the rate limit settings,
+// and whether it was found.
+// The Secret may be provisioned after the EnvoyGateway config (e.g. by an external controller
+// such as Crossplane). The ratelimit Deployment consumes the value via valueFrom.secretKeyRef,
+// so the kubelet starts the container once the Secret and key exist. A missing Secret or key
+// isn't an error, so as not to block infra creation on a not-yet-present Secret.
+func getSecretKeyValue(ctx context.Context, client client.Client, namespace string, ref *corev1.SecretKeySelector) ([]byte, bool, error) {
+	secret := &corev1.Secret{}
+	err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret)
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, false, nil
+	case err != nil:
+		return nil, false, fmt.Errorf("failed to get Secret %s in namespace %s: %w", ref.Name, namespace, err)
+	}
+	value, ok := secret.Data[ref.Key]
+	return value, ok, nil
+}
+
+// redisBackend returns the Redis settings of the rate limit service, if Redis is the backend type.
+func redisBackend(rateLimit *egv1a1.RateLimit) *egv1a1.RateLimitRedisSettings {
+	if rateLimit.Backend.Type != egv1a1.RedisBackendType {
+		return nil
+	}
+	return rateLimit.Backend.Redis
+}
+
+// memcachedBackend returns the Memcached settings of the rate limit service, if Memcached is the backend type.
+func memcachedBackend(rateLimit *egv1a1.RateLimit) *egv1a1.RateLimitMemcachedSettings {
+	if rateLimit.Backend.Type != egv1a1.MemcachedBackendType {
+		return nil
+	}
+	return rateLimit.Backend.Memcached
+}
+
 func enableTracing(rl *egv1a1.RateLimit) bool {
 	// Other fields can use the default values,
 	// but we have to make sure the user has the Provider.URL
@@ -243,6 +243,11 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 	if r.rateLimitHpa != nil {
 		replicas = nil
 	}
+	// The Local backend keeps the counters in the memory of the pod, so it can only
+	// enforce the limits consistently with a single replica.
+	if r.rateLimit.Backend.Type == egv1a1.LocalBackendType {
+		replicas = new(int32(1))
+	}
 
 	deployment := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
@@ -803,6 +803,112 @@ func TestDeployment(t *testing.T) {
 				},
 			},
 		},
+		{
+			caseName: "memcached-settings",
+			rateLimit: &egv1a1.RateLimit{
+				Backend: egv1a1.RateLimitDatabaseBackend{
+					Type: egv1a1.MemcachedBackendType,
+					Memcached: &egv1a1.RateLimitMemcachedSettings{
+						HostPorts:          []string{"memcached-0.memcached:11211", "memcached-1.memcached:11211"},
+						MaxIdleConnections: new(uint32(10)),
+						TLS: &egv1a1.MemcachedTLSSettings{
+							CertificateRef: &gwapiv1.SecretObjectReference{
+								Name: "memcached-tls",
+							},
+						},
+					},
+				},
+			},
+			deploy: cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment,
+		},
+		{
+			caseName: "memcached-srv",
+			rateLimit: &egv1a1.RateLimit{
+				Backend: egv1a1.RateLimitDatabaseBackend{
+					Type: egv1a1.MemcachedBackendType,
+					Memcached: &egv1a1.RateLimitMemcachedSettings{
+						SRV:        new("_memcache._tcp.memcached.svc.cluster.local"),
+						SRVRefresh: new(gwapiv1.Duration("30s")),
+					},
+				},
+			},
+			deploy: cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment,
+		},
+		{
+			caseName: "redis-sentinel",
+			rateLimit: &egv1a1.RateLimit{
+				Backend: egv1a1.RateLimitDatabaseBackend{
+					Type: egv1a1.RedisBackendType,
+					Redis: &egv1a1.RateLimitRedisSettings{
+						RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+							Sentinel: &egv1a1.RedisSentinelSettings{
+								MasterName: "mymaster",
+								Addresses:  []string{"redis-sentinel-0.redis:26379", "redis-sentinel-1.redis:26379"},
+								AuthRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "redis-sentinel-auth"},
+									Key:                  "password",
+								},
+							},
+							PoolSize: new(uint32(20)),
+						},
+					},
+				},
+			},
+			deploy: cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment,
+		},
+		{
+			caseName: "redis-cluster-per-second",
+			rateLimit: &egv1a1.RateLimit{
+				Backend: egv1a1.RateLimitDatabaseBackend{
+					Type: egv1a1.RedisBackendType,
+					Redis: &egv1a1.RateLimitRedisSettings{
+						RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+							Cluster: &egv1a1.RedisClusterSettings{
+								Addresses: []string{"redis-cluster-0.redis:6379", "redis-cluster-1.redis:6379"},
+							},
+							PipelineWindow: new(gwapiv1.Duration("150us")),
+							PipelineLimit:  new(uint32(8)),
+						},
+						PerSecond: &egv1a1.RateLimitRedisPerSecondSettings{
+							TLS: &egv1a1.RedisTLSSettings{
+								CertificateRef: &gwapiv1.SecretObjectReference{
+									Name: "ratelimit-persecond-cert",
+								},
+							},
+							RedisConnectionSettings: egv1a1.RedisConnectionSettings{
+								Sentinel: &egv1a1.RedisSentinelSettings{
+									MasterName: "persecond",
+									Addresses:  []string{"redis-sentinel-0.redis:26379"},
+								},
+							},
+						},
+					},
+				},
+			},
+			deploy: cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment,
+		},
+		{
+			// The Local backend always renders a single replica.
+			caseName: "local-backend",
+			rateLimit: &egv1a1.RateLimit{
+				Backend: egv1a1.RateLimitDatabaseBackend{
+					Type: egv1a1.LocalBackendType,
+					Local: &egv1a1.RateLimitLocalSettings{
+						Resources: &corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: resource.MustParse("128Mi"),
+							},
+						},
+					},
+				},
+			},
+			deploy: &egv1a1.KubernetesDeploymentSpec{
+				Replicas:  new(int32(3)),
+				Strategy:  egv1a1.DefaultKubernetesDeploymentStrategy(),
+				Container: cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment.Container,
+				Pod:       cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment.Pod,
+			},
+		},
 		{
 			// The replicas field must not be rendered when an HPA is configured, so that
 			// Envoy Gateway doesn't own spec.replicas and revert the replica count
@@ -1037,6 +1143,87 @@ func TestValidateRedisURLRef(t *testing.T) {
 	}
 }
 
+func TestValidateRedisSentinelAuthRef(t *testing.T) {
+	const ns = "envoy-gateway-system"
+
+	sentinel := func(name, key string) *egv1a1.RedisSentinelSettings {
+		return &egv1a1.RedisSentinelSettings{
+			MasterName: "mymaster",
+			Addresses:  []string{"sentinel.redis.svc:26379"},
+			AuthRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+	sentinelGW := func(name, key string, perSecond bool) *egv1a1.EnvoyGateway {
+		redis := &egv1a1.RateLimitRedisSettings{}
+		if perSecond {
+			redis.URL = new("redis.redis.svc:6379")
+			redis.PerSecond = &egv1a1.RateLimitRedisPerSecondSettings{
+				RedisConnectionSettings: egv1a1.RedisConnectionSettings{Sentinel: sentinel(name, key)},
+			}
+		} else {
+			redis.Sentinel = sentinel(name, key)
+		}
+		return &egv1a1.EnvoyGateway{
+			EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+				RateLimit: &egv1a1.RateLimit{
+					Backend: egv1a1.RateLimitDatabaseBackend{
+						Type:  egv1a1.RedisBackendType,
+						Redis: redis,
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name       string
+		secretData map[string][]byte
+		refName    string
+		refKey     string
+		expectErr  bool
+	}{
+		{name: "secret and key present", secretData: map[string][]byte{"password": []byte("secret")}, refName: "sentinel-auth", refKey: "password", expectErr: false},
+		// Non-blocking: the Secret/key may be provisioned after the config; the kubelet resolves it.
+		{name: "secret not yet created", secretData: map[string][]byte{"password": []byte("secret")}, refName: "absent", refKey: "password", expectErr: false},
+		{name: "key not yet present", secretData: map[string][]byte{"password": []byte("secret")}, refName: "sentinel-auth", refKey: "WRONG_KEY", expectErr: false},
+		// Blocking: a present-but-empty password is a misconfiguration, caught before rollout.
+		{name: "empty value", secretData: map[string][]byte{"password": []byte("")}, refName: "sentinel-auth", refKey: "password", expectErr: true},
+	}
+	for _, perSecond := range []bool{false, true} {
+		for _, tc := range cases {
+			t.Run(fmt.Sprintf("%s/perSecond=%t", tc.name, perSecond), func(t *testing.T) {
+				existing := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "sentinel-auth", Namespace: ns},
+					Data:       tc.secretData,
+				}
+				c := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(existing).Build()
+				err := Validate(context.Background(), c, sentinelGW(tc.refName, tc.refKey, perSecond), ns)
+				if tc.expectErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+			})
+		}
+	}
+
+	t.Run("secret get error", func(t *testing.T) {
+		c := fakeclient.NewClientBuilder().
+			WithScheme(envoygateway.GetScheme()).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+					return errors.New("api server unavailable")
+				},
+			}).
+			Build()
+		err := Validate(context.Background(), c, sentinelGW("sentinel-auth", "password", true), ns)
+		require.ErrorContains(t, err, "failed to get Secret")
+	})
+}
+
 func TestValidateRedisSettings(t *testing.T) {
 	const ns = "envoy-gateway-system"
 
@@ -1092,4 +1279,11 @@ func TestValidateRedisSettings(t *testing.T) {
 			},
 		}), ns))
 	})
+
+	t.Run("settings of another backend type", func(t *testing.T) {
+		c := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+		gw := redisGW(&egv1a1.RateLimitRedisSettings{URL: new("redis.redis.svc:6379")})
+		gw.RateLimit.Backend.Memcached = &egv1a1.RateLimitMemcachedSettings{HostPorts: []string{"memcached:11211"}}
+		require.ErrorContains(t, Validate(context.Background(), c, gw, ns), "ratelimit memcached settings can't be set with the Redis backend type")
+	})
 }
@@ -38,6 +38,9 @@ const (
 	// SystemTrustStoreSecretName is the shared SDS secret name used by all clusters that reference
 	// the system CA trust store when backend cluster deduplication is enabled.
 	SystemTrustStoreSecretName = "system_ca_certificates" //nolint:gosec // not a credential
+
+	// IPTagsHeader is the request header that the IP tags of the client IP are written to.
+	IPTagsHeader = "x-envoy-ip-tags"
 )
 
 var (
@@ -380,6 +383,8 @@ type HTTPListener struct {
 	ClientIPDetection *ClientIPDetectionSettings `json:"clientIPDetection,omitempty" yaml:"clientIPDetection,omitempty"`
 	// GeoIPProvider holds the shared GeoIP provider configuration used by request-time GeoIP filters.
 	GeoIPProvider *GeoIPProvider `json:"geoIPProvider,omitempty" yaml:"geoIPProvider,omitempty"`
+	// IPTagging holds the IP tag sets that the client IPs are tagged with.
+	IPTagging *IPTagging `json:"ipTagging,omitempty" yaml:"ipTagging,omitempty"`
 	// Path contains settings for path URI manipulations
 	Path PathSettings `json:"path,omitempty"`
 	// Host contains settings for Host/Authority header normalization
@@ -821,6 +826,26 @@ type HTTP2KeepaliveSettings struct {
 type GRPCSettings struct {
 	EnableGRPCWeb   *bool `json:"enableGRPCWeb,omitempty" yaml:"enableGRPCWeb,omitempty"`
 	EnableGRPCStats *bool `json:"enableGRPCStats,omitempty" yaml:"enableGRPCStats,omitempty"`
+	// GRPCStats holds the settings of the gRPC stats filter.
+	// The default settings are used if unset.
+	GRPCStats *GRPCStats `json:"grpcStats,omitempty" yaml:"grpcStats,omitempty"`
+}
+
+// GRPCStats holds the settings of the gRPC stats filter.
+// +k8s:deepcopy-gen=true
+type GRPCStats struct {
+	// Services is the allowlist of the methods for which the per-method stats are emitted.
+	// The stats are emitted for all the methods if empty.
+	Services []GRPCStatsService `json:"services,omitempty" yaml:"services,omitempty"`
+	// EnableUpstreamStats enables the per-method upstream request time histograms.
+	EnableUpstreamStats bool `json:"enableUpstreamStats,omitempty" yaml:"enableUpstreamStats,omitempty"`
+}
+
+// GRPCStatsService holds the methods of a gRPC service for which stats are emitted.
+// +k8s:deepcopy-gen=true
+type GRPCStatsService struct {
+	Name    string   `json:"name" yaml:"name"`
+	Methods []string `json:"methods" yaml:"methods"`
 }
 
 // ResponseOverride defines the configuration to override specific responses with a custom one.
@@ -1142,6 +1167,30 @@ type DNS struct {
 	LookupFamily *egv1a1.DNSLookupFamily `json:"lookupFamily,omitempty"`
 	// Name is a unique name for a DNS configuration.
 	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Resolver is the DNS resolver used to resolve the hostnames of the backends.
+	Resolver *DNSResolver `json:"resolver,omitempty" yaml:"resolver,omitempty"`
+}
+
+// DNSResolver holds the configuration of a DNS resolver.
+// +k8s:deepcopy-gen=true
+type DNSResolver struct {
+	// Type is the implementation of the DNS resolver.
+	Type egv1a1.DNSResolverType `json:"type" yaml:"type"`
+	// Addresses are the DNS servers used to resolve the hostnames.
+	Addresses []*DNSResolverAddress `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	// UseTCP sends the DNS queries over TCP.
+	UseTCP bool `json:"useTCP,omitempty" yaml:"useTCP,omitempty"`
+	// NoDefaultSearchDomain disables the search domains of the host.
+	NoDefaultSearchDomain bool `json:"noDefaultSearchDomain,omitempty" yaml:"noDefaultSearchDomain,omitempty"`
+}
+
+// DNSResolverAddress holds the address of a DNS server.
+// +k8s:deepcopy-gen=true
+type DNSResolverAddress struct {
+	// Host is the IP address of the DNS server.
+	Host string `json:"host" yaml:"host"`
+	// Port is the port of the DNS server.
+	Port uint32 `json:"port" yaml:"port"`
 }
 
 // SessionPersistence defines the desired state of SessionPersistence.
@@ -1182,6 +1231,15 @@ type Compression struct {
 	MinContentLength *uint32 `json:"minContentLength,omitempty" yaml:"minContentLength,omitempty"`
 }
 
+// Decompression holds the configuration for HTTP decompression.
+// +k8s:deepcopy-gen=true
+type Decompression struct {
+	// Types of the content encodings to be decompressed.
+	Types []egv1a1.CompressorType `json:"types" yaml:"types"`
+	// Response enables the decompression of the response bodies in addition to the request bodies.
+	Response bool `json:"response,omitempty" yaml:"response,omitempty"`
+}
+
 // ClusterTrafficFeatures holds the TrafficFeatures fields that translate to Envoy cluster (CDS)
 // configuration. Route- and HCM-scoped features live on TrafficFeatures instead.
 // +k8s:deepcopy-gen=true
@@ -1231,12 +1289,18 @@ type TrafficFeatures struct {
 	ResponseOverride *ResponseOverride `json:"responseOverride,omitempty" yaml:"responseOverride,omitempty"`
 	// Compression settings for HTTP Response
 	Compression []*Compression `json:"compression,omitempty" yaml:"compression,omitempty"`
+	// Decompression settings for HTTP Request and Response
+	Decompression *Decompression `json:"decompression,omitempty" yaml:"decompression,omitempty"`
 	// HTTPUpgrade defines the schema for upgrading the HTTP protocol.
 	HTTPUpgrade []HTTPUpgradeConfig `json:"httpUpgrade,omitempty" yaml:"httpUpgrade,omitempty"`
 	// Telemetry defines the schema for telemetry configuration.
 	Telemetry *BackendTelemetry `json:"telemetry,omitempty" yaml:"telemetry,omitempty"`
 	// RequestBuffer defines the schema for enabling buffered requests
 	RequestBuffer *RequestBuffer `json:"requestBuffer,omitempty" yaml:"requestBuffer,omitempty"`
+	// Cache defines the schema for caching HTTP responses.
+	Cache *Cache `json:"cache,omitempty" yaml:"cache,omitempty"`
+	// AdaptiveConcurrency defines the schema for adaptive concurrency limiting.
+	AdaptiveConcurrency *AdaptiveConcurrency `json:"adaptiveConcurrency,omitempty" yaml:"adaptiveConcurrency,omitempty"`
 }
 
 // ClusterFeatures returns the cluster-scoped subset of these traffic features, or nil if there are
@@ -1335,6 +1399,8 @@ type SecurityFeatures struct {
 	ExtAuth *ExtAuth `json:"extAuth,omitempty" yaml:"extAuth,omitempty"`
 	// Authorization defines the schema for the authorization.
 	Authorization *Authorization `json:"authorization,omitempty" yaml:"authorization,omitempty"`
+	// WAF defines the schema for the Web Application Firewall.
+	WAF *WAF `json:"waf,omitempty" yaml:"waf,omitempty"`
 }
 
 // EnvoyExtensionFeatures holds the information associated with the Envoy Extension Policy.
@@ -1348,6 +1414,8 @@ type EnvoyExtensionFeatures struct {
 	Luas []Lua `json:"luas,omitempty" yaml:"luas,omitempty"`
 	// Dynamic Module extensions
 	DynamicModules []DynamicModule `json:"dynamicModules,omitempty" yaml:"dynamicModules,omitempty"`
+	// gRPC-JSON transcoder extension
+	GRPCJSONTranscoder *GRPCJSONTranscoder `json:"grpcJSONTranscoder,omitempty" yaml:"grpcJSONTranscoder,omitempty"`
 }
 
 // UnstructuredRef holds unstructured data for an arbitrary k8s resource introduced by an extension
@@ -1888,6 +1956,23 @@ type Principal struct {
 	Headers []egv1a1.AuthorizationHeaderMatch `json:"headers,omitempty"`
 	// ClientIPGeoLocations defines the geolocation metadata to be matched.
 	ClientIPGeoLocations []egv1a1.ClientIPGeoLocation `json:"clientIPGeoLocations,omitempty"`
+	// ClientIPTags defines the IP tags of the client IP to be matched.
+	ClientIPTags []string `json:"clientIPTags,omitempty"`
+	// ClientCertificate defines the client certificate identity to be matched.
+	ClientCertificate *ClientCertificateMatch `json:"clientCertificate,omitempty"`
+}
+
+// ClientCertificateMatch defines the client certificate identity to be matched.
+// All the specified fields must match.
+//
+// +k8s:deepcopy-gen=true
+type ClientCertificateMatch struct {
+	// Subject defines the subject distinguished name to be matched.
+	Subject *StringMatch `json:"subject,omitempty"`
+	// DNSNames defines the DNS SANs to be matched, any of which matches.
+	DNSNames []*StringMatch `json:"dnsNames,omitempty"`
+	// URIs defines the URI SANs to be matched, any of which matches.
+	URIs []*StringMatch `json:"uris,omitempty"`
 }
 
 // FaultInjection defines the schema for injecting faults into requests.
@@ -1943,6 +2028,33 @@ type AdmissionControl struct {
 	SuccessCriteria *AdmissionControlSuccessCriteria `json:"successCriteria,omitempty" yaml:"successCriteria,omitempty"`
 }
 
+// AdaptiveConcurrency defines the schema for adaptive concurrency limiting with a gradient controller.
+//
+// +k8s:deepcopy-gen=true
+type AdaptiveConcurrency struct {
+	// SampleAggregatePercentile is the percentile of the sampled request latencies
+	// compared with the minRTT to compute the concurrency limit.
+	SampleAggregatePercentile *uint32 `json:"sampleAggregatePercentile,omitempty" yaml:"sampleAggregatePercentile,omitempty"`
+	// MinConcurrencyLimit is the lower bound of the concurrency limit, which is also
+	// the limit applied while the minRTT is measured.
+	MinConcurrencyLimit *uint32 `json:"minConcurrencyLimit,omitempty" yaml:"minConcurrencyLimit,omitempty"`
+	// MaxConcurrencyLimit is the upper bound of the concurrency limit.
+	MaxConcurrencyLimit *uint32 `json:"maxConcurrencyLimit,omitempty" yaml:"maxConcurrencyLimit,omitempty"`
+	// ConcurrencyUpdateInterval is the period of time over which the request latencies are
+	// sampled before the concurrency limit is recalculated.
+	ConcurrencyUpdateInterval *metav1.Duration `json:"concurrencyUpdateInterval,omitempty" yaml:"concurrencyUpdateInterval,omitempty"`
+	// MinRTTInterval is the time between two measurements of the minRTT.
+	MinRTTInterval *metav1.Duration `json:"minRTTInterval,omitempty" yaml:"minRTTInterval,omitempty"`
+	// MinRTTRequestCount is the number of requests sampled to measure the minRTT.
+	MinRTTRequestCount *uint32 `json:"minRTTRequestCount,omitempty" yaml:"minRTTRequestCount,omitempty"`
+	// MinRTTJitterPercent is the random delay added to the start of each minRTT measurement,
+	// as a percentage of MinRTTInterval.
+	MinRTTJitterPercent *uint32 `json:"minRTTJitterPercent,omitempty" yaml:"minRTTJitterPercent,omitempty"`
+	// MinRTTBufferPercent is the tolerance added to the measured minRTT, as a percentage
+	// of the measured value.
+	MinRTTBufferPercent *uint32 `json:"minRTTBufferPercent,omitempty" yaml:"minRTTBufferPercent,omitempty"`
+}
+
 // AdmissionControlSuccessCriteria defines the criteria for determining successful requests.
 //
 // +k8s:deepcopy-gen=true
@@ -2319,6 +2431,9 @@ type DestinationSetting struct {
 	// PreferLocal specifies whether to enable Zone Aware Routing for this destination's endpoints.
 	// This is derived from the backend service and depends on having Kubernetes Topology Aware Routing or Traffic Distribution enabled.
 	PreferLocal *PreferLocalZone `json:"preferLocal,omitempty" yaml:"preferLocal,omitempty"`
+	// DNSResolver is the DNS resolver of the FQDN and dynamic resolver destinations,
+	// derived from the Backend resource or the EnvoyProxy.
+	DNSResolver *DNSResolver `json:"dnsResolver,omitempty" yaml:"dnsResolver,omitempty"`
 	// Metadata is used to enrich envoy route metadata with user and provider-specific information
 	// The primary metadata for DestinationSettings comes from the Backend resource reference in BackendRef
 	Metadata *ResourceMetadata `json:"metadata,omitempty" yaml:"metadata,omitempty"`
@@ -2700,6 +2815,11 @@ type TCPRoute struct {
 	DNS *DNS `json:"dns,omitempty" yaml:"dns,omitempty"`
 	// Authorization defines the schema for the authorization.
 	Authorization *Authorization `json:"authorization,omitempty" yaml:"authorization,omitempty"`
+	// RateLimit defines the local rate limiting of the new connections to the route.
+	// Only the Local rate limit with rules matching the source CIDR is supported.
+	RateLimit *RateLimit `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+	// BandwidthLimit defines the bandwidth limiting of the connections to the route.
+	BandwidthLimit *BandwidthLimit `json:"bandwidthLimit,omitempty" yaml:"bandwidthLimit,omitempty"`
 }
 
 // IsDynamicResolverRoute returns true if the TCPRoute routes to a dynamic resolver backend.
@@ -2815,6 +2935,13 @@ type UDPRoute struct {
 	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty" yaml:"loadBalancer,omitempty"`
 	// DNS is used to configure how DNS resolution is handled by the Envoy Proxy cluster
 	DNS *DNS `json:"dns,omitempty" yaml:"dns,omitempty"`
+	// Timeout settings, only the UDP idle timeout of the sessions applies.
+	Timeout *Timeout `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// CircuitBreaker settings, the max connections limit the number of sessions
+	// to the backend.
+	CircuitBreaker *CircuitBreaker `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
+	// HealthCheck defines the configuration for active health checking on the upstream.
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty" yaml:"healthCheck,omitempty"`
 }
 
 // Validate the fields within the UDPListener structure
@@ -2863,6 +2990,9 @@ type RateLimit struct {
 
 	// Local rate limit settings.
 	Local *LocalRateLimit `json:"local,omitempty" yaml:"local,omitempty"`
+
+	// Quota rate limit settings.
+	Quota *QuotaRateLimit `json:"quota,omitempty" yaml:"quota,omitempty"`
 }
 
 // GlobalRateLimit holds the global rate limiting configuration.
@@ -2878,6 +3008,9 @@ type GlobalResources struct {
 	// EnvoyClientCertificate holds the client certificate secret for envoy to use when establishing a TLS connection to
 	// control plane components. For example, the rate limit service, WASM HTTP server, etc.
 	EnvoyClientCertificate *TLSCertificate `json:"envoyClientCertificate,omitempty" yaml:"envoyClientCertificate,omitempty"`
+	// RateLimitQuotaTLS holds the TLS config for envoy to use when establishing a TLS connection to
+	// the rate limit quota service.
+	RateLimitQuotaTLS *TLSUpstreamConfig `json:"rateLimitQuotaTLS,omitempty" yaml:"rateLimitQuotaTLS,omitempty"`
 	// ProxyServiceCluster holds the local cluster of EnvoyProxy instances
 	ProxyServiceCluster *RouteDestination `json:"proxyServiceCluster,omitempty" yaml:"proxyServiceCluster,omitempty"`
 	// HMACSecret holds the HMAC Secret used by the OIDC.
@@ -2902,6 +3035,24 @@ type GeoIPMaxMindProvider struct {
 	AnonymousIPDBPath *string `json:"anonymousIpDbPath,omitempty" yaml:"anonymousIpDbPath,omitempty"`
 }
 
+// IPTagging holds the IP tag sets that the client IPs are tagged with.
+// +k8s:deepcopy-gen=true
+type IPTagging struct {
+	// Tags are the IP tag sets.
+	Tags []*IPTag `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// FilePath is the path of a file with additional IP tag sets.
+	FilePath *string `json:"filePath,omitempty" yaml:"filePath,omitempty"`
+}
+
+// IPTag holds a named set of IP address ranges.
+// +k8s:deepcopy-gen=true
+type IPTag struct {
+	// Name is the name of the tag.
+	Name string `json:"name" yaml:"name"`
+	// CIDRs are the IP address ranges of the tag.
+	CIDRs []*CIDRMatch `json:"cidrs,omitempty" yaml:"cidrs,omitempty"`
+}
+
 // LocalRateLimit holds the local rate limiting configuration.
 // +k8s:deepcopy-gen=true
 type LocalRateLimit struct {
@@ -2919,6 +3070,21 @@ type LocalRateLimit struct {
 	DefaultXRateLimitOption *egv1a1.XRateLimitHeadersOption `json:"defaultXRateLimitOption,omitempty" yaml:"defaultXRateLimitOption,omitempty"`
 }
 
+// QuotaRateLimit holds the rate limit quota configuration.
+// +k8s:deepcopy-gen=true
+type QuotaRateLimit struct {
+	// Domain is the application domain of the buckets reported to the rate limit quota service.
+	Domain string `json:"domain" yaml:"domain"`
+	// Rules assign the requests to the buckets. Only the match conditions and Shared of
+	// the rules are used, the limits are assigned by the rate limit quota service.
+	Rules []*RateLimitRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+	// ReportingInterval is the interval at which the usage of the buckets is reported.
+	ReportingInterval metav1.Duration `json:"reportingInterval" yaml:"reportingInterval"`
+	// FallbackDenyAll denies the requests of the buckets without a quota assignment
+	// when set to true, otherwise they are allowed.
+	FallbackDenyAll bool `json:"fallbackDenyAll,omitempty" yaml:"fallbackDenyAll,omitempty"`
+}
+
 // RateLimitRule holds the match and limit configuration for ratelimiting.
 // +k8s:deepcopy-gen=true
 type RateLimitRule struct {
@@ -3769,6 +3935,15 @@ type Timeout struct {
 
 	// Timeout settings for HTTP.
 	HTTP *HTTPTimeout `json:"http,omitempty" yaml:"tcp,omitempty"`
+
+	// Timeout settings for UDP.
+	UDP *UDPTimeout `json:"udp,omitempty" yaml:"udp,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+type UDPTimeout struct {
+	// The idle timeout of a UDP session.
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -3857,6 +4032,28 @@ type Retry struct {
 
 	// PerRetry is the retry policy to be applied per retry attempt.
 	PerRetry *PerRetryPolicy `json:"perRetry,omitempty"`
+
+	// Hedge is the request hedging policy.
+	Hedge *HedgePolicy `json:"hedge,omitempty"`
+
+	// HostSelection defines how the upstream hosts are selected for the retry attempts.
+	HostSelection *RetryHostSelection `json:"hostSelection,omitempty"`
+}
+
+// HedgePolicy defines the request hedging policy.
+// +k8s:deepcopy-gen=true
+type HedgePolicy struct {
+	// Methods are the HTTP methods of the requests that are hedged and retried.
+	Methods []string `json:"methods,omitempty"`
+}
+
+// RetryHostSelection defines how the upstream hosts are selected for the retry attempts.
+// +k8s:deepcopy-gen=true
+type RetryHostSelection struct {
+	// AvoidPreviousHosts rejects the hosts that were already attempted for the request.
+	AvoidPreviousHosts bool `json:"avoidPreviousHosts"`
+	// MaxAttempts is the maximum number of times a host is selected for a retry attempt.
+	MaxAttempts *uint32 `json:"maxAttempts,omitempty"`
 }
 
 // RetryBudget defines the retry budget configuration.
@@ -4075,6 +4272,9 @@ type ExtProc struct {
 	// or cannot be reached. Defaults to 500 Internal Server Error.
 	// +optional
 	StatusOnError *int32 `json:"statusOnError,omitempty" yaml:"statusOnError,omitempty"`
+
+	// Match defines the conditions of a request for the external processor to be called.
+	Match *ExtensionMatch `json:"match,omitempty" yaml:"match,omitempty"`
 }
 
 // Lua holds the information associated with Lua extensions
@@ -4088,6 +4288,22 @@ type Lua struct {
 	// FilterContext is the filter context configuration for the Lua script.
 	// This is a JSON object passed to the Lua script via request_handle:filterContext().
 	FilterContext *apiextensionsv1.JSON
+	// Match defines the conditions of a request for the Lua script to be executed.
+	Match *ExtensionMatch `json:"match,omitempty" yaml:"match,omitempty"`
+}
+
+// ExtensionMatch defines the conditions of a request for an extension to be executed.
+// All the specified conditions must match.
+// +k8s:deepcopy-gen=true
+type ExtensionMatch struct {
+	// Headers defines the headers to be matched.
+	Headers []egv1a1.AuthorizationHeaderMatch `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Path defines the path to be matched.
+	Path *egv1a1.PathMatch `json:"path,omitempty" yaml:"path,omitempty"`
+	// Methods defines the methods to be matched, any of which matches.
+	Methods []gwapiv1.HTTPMethod `json:"methods,omitempty" yaml:"methods,omitempty"`
+	// CEL defines a CEL expression to be matched.
+	CEL *string `json:"cel,omitempty" yaml:"cel,omitempty"`
 }
 
 // Wasm holds the information associated with the Wasm extensions.
@@ -4124,6 +4340,9 @@ type Wasm struct {
 	// HostKeys is a list of keys for environment variables from the host envoy process
 	// that should be passed into the Wasm VM.
 	HostKeys []string `json:"hostKeys,omitempty"`
+
+	// Match defines the conditions of a request for the Wasm extension to be executed.
+	Match *ExtensionMatch `json:"match,omitempty"`
 }
 
 // HTTPWasmCode holds the information associated with the HTTP Wasm code source.
@@ -4180,6 +4399,57 @@ type RemoteDynamicModuleSource struct {
 	SHA256 string `json:"sha256"`
 }
 
+// WAF holds the information associated with the Web Application Firewall.
+// The WAF rule engine is either a Wasm extension or a dynamic module, and the
+// rules are passed to the engine in its JSON configuration.
+// +k8s:deepcopy-gen=true
+type WAF struct {
+	// Name is a unique name for the WAF configuration.
+	// The xds translator only generates one WAF filter for each unique name.
+	Name string `json:"name"`
+
+	// Wasm is the WAF rule engine loaded as a Wasm extension.
+	Wasm *Wasm `json:"wasm,omitempty"`
+
+	// DynamicModule is the WAF rule engine loaded as a dynamic module.
+	DynamicModule *DynamicModule `json:"dynamicModule,omitempty"`
+}
+
+// GRPCJSONTranscoder holds the information associated with the gRPC-JSON transcoder extension.
+// +k8s:deepcopy-gen=true
+type GRPCJSONTranscoder struct {
+	// Name is a unique name for the gRPC-JSON transcoder configuration.
+	// The xds translator only generates one gRPC-JSON transcoder filter for each unique name.
+	Name string `json:"name"`
+
+	// ProtoDescriptor is the binary FileDescriptorSet of the transcoded gRPC services.
+	// It may be read from a Secret, so it's redacted when the IR is printed.
+	ProtoDescriptor PrivateBytes `json:"protoDescriptor"`
+
+	// Services is the list of fully qualified gRPC service names to transcode.
+	Services []string `json:"services"`
+
+	// PrintOptions control how the gRPC responses are printed as JSON.
+	PrintOptions *GRPCJSONTranscoderPrintOptions `json:"printOptions,omitempty"`
+
+	// IgnoreUnknownQueryParameters ignores the query parameters that cannot be
+	// mapped to a field of the gRPC request message.
+	IgnoreUnknownQueryParameters bool `json:"ignoreUnknownQueryParameters,omitempty"`
+
+	// RejectUnknownQueryParameters rejects the requests with query parameters
+	// that cannot be mapped to a field of the gRPC request message.
+	RejectUnknownQueryParameters bool `json:"rejectUnknownQueryParameters,omitempty"`
+}
+
+// GRPCJSONTranscoderPrintOptions holds the JSON print options of the gRPC-JSON transcoder.
+// +k8s:deepcopy-gen=true
+type GRPCJSONTranscoderPrintOptions struct {
+	AddWhitespace              bool `json:"addWhitespace,omitempty"`
+	AlwaysPrintPrimitiveFields bool `json:"alwaysPrintPrimitiveFields,omitempty"`
+	AlwaysPrintEnumsAsInts     bool `json:"alwaysPrintEnumsAsInts,omitempty"`
+	PreserveProtoFieldNames    bool `json:"preserveProtoFieldNames,omitempty"`
+}
+
 // DestinationFilters contains HTTP filters that will be used with the DestinationSetting.
 // +k8s:deepcopy-gen=true
 type DestinationFilters struct {
@@ -4232,6 +4502,37 @@ type RequestBuffer struct {
 	Limit resource.Quantity `json:"limit" yaml:"limit"`
 }
 
+// Cache holds the information for the HTTP cache filter
+// +k8s:deepcopy-gen=true
+type Cache struct {
+	// Name is a unique name for a Cache configuration.
+	// The xds translator only generates one cache filter for each unique name.
+	Name string `json:"name" yaml:"name"`
+	// MaxSizeBytes is the maximum total size of the cached responses.
+	MaxSizeBytes uint64 `json:"maxSizeBytes" yaml:"maxSizeBytes"`
+	// MaxEntrySizeBytes is the maximum size of a single cached response.
+	MaxEntrySizeBytes *uint64 `json:"maxEntrySizeBytes,omitempty" yaml:"maxEntrySizeBytes,omitempty"`
+	// AllowedVaryHeaders defines the request headers that are allowed in the vary header of a cacheable response.
+	AllowedVaryHeaders []StringMatch `json:"allowedVaryHeaders,omitempty" yaml:"allowedVaryHeaders,omitempty"`
+	// Key defines how the cache key is computed from the request.
+	Key *CacheKey `json:"key,omitempty" yaml:"key,omitempty"`
+	// IgnoreRequestCacheControl ignores the no-cache directives of the request.
+	IgnoreRequestCacheControl bool `json:"ignoreRequestCacheControl,omitempty" yaml:"ignoreRequestCacheControl,omitempty"`
+}
+
+// CacheKey holds the information for customizing the cache key
+// +k8s:deepcopy-gen=true
+type CacheKey struct {
+	// ExcludeScheme excludes the URL scheme from the cache key.
+	ExcludeScheme bool `json:"excludeScheme,omitempty" yaml:"excludeScheme,omitempty"`
+	// ExcludeHost excludes the host from the cache key.
+	ExcludeHost bool `json:"excludeHost,omitempty" yaml:"excludeHost,omitempty"`
+	// IncludedQueryParameters defines the only query parameters included in the cache key.
+	IncludedQueryParameters []string `json:"includedQueryParameters,omitempty" yaml:"includedQueryParameters,omitempty"`
+	// ExcludedQueryParameters defines the query parameters excluded from the cache key.
+	ExcludedQueryParameters []string `json:"excludedQueryParameters,omitempty" yaml:"excludedQueryParameters,omitempty"`
+}
+
 // PreferLocalZone configures zone-aware routing to prefer sending traffic to the local locality zone.
 // +k8s:deepcopy-gen=true
 type PreferLocalZone struct {
@@ -284,6 +284,61 @@ func (in *ActiveHealthCheck) DeepCopy() *ActiveHealthCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrency) DeepCopyInto(out *AdaptiveConcurrency) {
+	*out = *in
+	if in.SampleAggregatePercentile != nil {
+		in, out := &in.SampleAggregatePercentile, &out.SampleAggregatePercentile
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinConcurrencyLimit != nil {
+		in, out := &in.MinConcurrencyLimit, &out.MinConcurrencyLimit
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxConcurrencyLimit != nil {
+		in, out := &in.MaxConcurrencyLimit, &out.MaxConcurrencyLimit
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConcurrencyUpdateInterval != nil {
+		in, out := &in.ConcurrencyUpdateInterval, &out.ConcurrencyUpdateInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MinRTTInterval != nil {
+		in, out := &in.MinRTTInterval, &out.MinRTTInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MinRTTRequestCount != nil {
+		in, out := &in.MinRTTRequestCount, &out.MinRTTRequestCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinRTTJitterPercent != nil {
+		in, out := &in.MinRTTJitterPercent, &out.MinRTTJitterPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinRTTBufferPercent != nil {
+		in, out := &in.MinRTTBufferPercent, &out.MinRTTBufferPercent
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrency.
+func (in *AdaptiveConcurrency) DeepCopy() *AdaptiveConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddHeader) DeepCopyInto(out *AddHeader) {
 	*out = *in
@@ -875,6 +930,63 @@ func (in *CSRF) DeepCopy() *CSRF {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cache) DeepCopyInto(out *Cache) {
+	*out = *in
+	if in.MaxEntrySizeBytes != nil {
+		in, out := &in.MaxEntrySizeBytes, &out.MaxEntrySizeBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.AllowedVaryHeaders != nil {
+		in, out := &in.AllowedVaryHeaders, &out.AllowedVaryHeaders
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(CacheKey)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
+	if in == nil {
+		return nil
+	}
+	out := new(Cache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheKey) DeepCopyInto(out *CacheKey) {
+	*out = *in
+	if in.IncludedQueryParameters != nil {
+		in, out := &in.IncludedQueryParameters, &out.IncludedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedQueryParameters != nil {
+		in, out := &in.ExcludedQueryParameters, &out.ExcludedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKey.
+func (in *CacheKey) DeepCopy() *CacheKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -925,6 +1037,48 @@ func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertificateMatch) DeepCopyInto(out *ClientCertificateMatch) {
+	*out = *in
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(StringMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.URIs != nil {
+		in, out := &in.URIs, &out.URIs
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertificateMatch.
+func (in *ClientCertificateMatch) DeepCopy() *ClientCertificateMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertificateMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnection) DeepCopyInto(out *ClientConnection) {
 	*out = *in
@@ -1463,6 +1617,11 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 		*out = new(v1alpha1.DNSLookupFamily)
 		**out = **in
 	}
+	if in.Resolver != nil {
+		in, out := &in.Resolver, &out.Resolver
+		*out = new(DNSResolver)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNS.
@@ -1475,6 +1634,67 @@ func (in *DNS) DeepCopy() *DNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSResolver) DeepCopyInto(out *DNSResolver) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]*DNSResolverAddress, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DNSResolverAddress)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSResolver.
+func (in *DNSResolver) DeepCopy() *DNSResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSResolverAddress) DeepCopyInto(out *DNSResolverAddress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSResolverAddress.
+func (in *DNSResolverAddress) DeepCopy() *DNSResolverAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSResolverAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Decompression) DeepCopyInto(out *Decompression) {
+	*out = *in
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]v1alpha1.CompressorType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Decompression.
+func (in *Decompression) DeepCopy() *Decompression {
+	if in == nil {
+		return nil
+	}
+	out := new(Decompression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DestinationEndpoint) DeepCopyInto(out *DestinationEndpoint) {
 	*out = *in
@@ -1603,6 +1823,11 @@ func (in *DestinationSetting) DeepCopyInto(out *DestinationSetting) {
 		*out = new(PreferLocalZone)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNSResolver != nil {
+		in, out := &in.DNSResolver, &out.DNSResolver
+		*out = new(DNSResolver)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = new(ResourceMetadata)
@@ -1718,6 +1943,11 @@ func (in *EnvoyExtensionFeatures) DeepCopyInto(out *EnvoyExtensionFeatures) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GRPCJSONTranscoder != nil {
+		in, out := &in.GRPCJSONTranscoder, &out.GRPCJSONTranscoder
+		*out = new(GRPCJSONTranscoder)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyExtensionFeatures.
@@ -1912,6 +2142,11 @@ func (in *ExtProc) DeepCopyInto(out *ExtProc) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(ExtensionMatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtProc.
@@ -1945,6 +2180,43 @@ func (in *ExtendedHTTPPathModifier) DeepCopy() *ExtendedHTTPPathModifier {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionMatch) DeepCopyInto(out *ExtensionMatch) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]v1alpha1.AuthorizationHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(v1alpha1.PathMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]v1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.CEL != nil {
+		in, out := &in.CEL, &out.CEL
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionMatch.
+func (in *ExtensionMatch) DeepCopy() *ExtensionMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtractFrom) DeepCopyInto(out *ExtractFrom) {
 	*out = *in
@@ -2126,6 +2398,51 @@ func (in *GRPCHealthChecker) DeepCopy() *GRPCHealthChecker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoder) DeepCopyInto(out *GRPCJSONTranscoder) {
+	*out = *in
+	if in.ProtoDescriptor != nil {
+		in, out := &in.ProtoDescriptor, &out.ProtoDescriptor
+		*out = make(PrivateBytes, len(*in))
+		copy(*out, *in)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrintOptions != nil {
+		in, out := &in.PrintOptions, &out.PrintOptions
+		*out = new(GRPCJSONTranscoderPrintOptions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoder.
+func (in *GRPCJSONTranscoder) DeepCopy() *GRPCJSONTranscoder {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoderPrintOptions) DeepCopyInto(out *GRPCJSONTranscoderPrintOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoderPrintOptions.
+func (in *GRPCJSONTranscoderPrintOptions) DeepCopy() *GRPCJSONTranscoderPrintOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoderPrintOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GRPCSettings) DeepCopyInto(out *GRPCSettings) {
 	*out = *in
@@ -2139,6 +2456,11 @@ func (in *GRPCSettings) DeepCopyInto(out *GRPCSettings) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.GRPCStats != nil {
+		in, out := &in.GRPCStats, &out.GRPCStats
+		*out = new(GRPCStats)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCSettings.
@@ -2151,6 +2473,48 @@ func (in *GRPCSettings) DeepCopy() *GRPCSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCStats) DeepCopyInto(out *GRPCStats) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]GRPCStatsService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCStats.
+func (in *GRPCStats) DeepCopy() *GRPCStats {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCStatsService) DeepCopyInto(out *GRPCStatsService) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCStatsService.
+func (in *GRPCStatsService) DeepCopy() *GRPCStatsService {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCStatsService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GRPCSuccessCriteria) DeepCopyInto(out *GRPCSuccessCriteria) {
 	*out = *in
@@ -2265,6 +2629,11 @@ func (in *GlobalResources) DeepCopyInto(out *GlobalResources) {
 		*out = new(TLSCertificate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimitQuotaTLS != nil {
+		in, out := &in.RateLimitQuotaTLS, &out.RateLimitQuotaTLS
+		*out = new(TLSUpstreamConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProxyServiceCluster != nil {
 		in, out := &in.ProxyServiceCluster, &out.ProxyServiceCluster
 		*out = new(RouteDestination)
@@ -2589,6 +2958,11 @@ func (in *HTTPListener) DeepCopyInto(out *HTTPListener) {
 		*out = new(GeoIPProvider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPTagging != nil {
+		in, out := &in.IPTagging, &out.IPTagging
+		*out = new(IPTagging)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Path = in.Path
 	if in.Host != nil {
 		in, out := &in.Host, &out.Host
@@ -3099,6 +3473,26 @@ func (in *HealthCheckSettings) DeepCopy() *HealthCheckSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HedgePolicy) DeepCopyInto(out *HedgePolicy) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HedgePolicy.
+func (in *HedgePolicy) DeepCopy() *HedgePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HedgePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostSettings) DeepCopyInto(out *HostSettings) {
 	*out = *in
@@ -3114,6 +3508,63 @@ func (in *HostSettings) DeepCopy() *HostSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTag) DeepCopyInto(out *IPTag) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]*CIDRMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CIDRMatch)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTag.
+func (in *IPTag) DeepCopy() *IPTag {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTagging) DeepCopyInto(out *IPTagging) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*IPTag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPTag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FilePath != nil {
+		in, out := &in.FilePath, &out.FilePath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTagging.
+func (in *IPTagging) DeepCopy() *IPTagging {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTagging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infra) DeepCopyInto(out *Infra) {
 	*out = *in
@@ -3451,6 +3902,11 @@ func (in *Lua) DeepCopyInto(out *Lua) {
 		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(ExtensionMatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lua.
@@ -3913,6 +4369,16 @@ func (in *Principal) DeepCopyInto(out *Principal) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClientIPTags != nil {
+		in, out := &in.ClientIPTags, &out.ClientIPTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(ClientCertificateMatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Principal.
@@ -4064,6 +4530,33 @@ func (in *QueryParamMatch) DeepCopy() *QueryParamMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaRateLimit) DeepCopyInto(out *QuotaRateLimit) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*RateLimitRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RateLimitRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	out.ReportingInterval = in.ReportingInterval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaRateLimit.
+func (in *QuotaRateLimit) DeepCopy() *QuotaRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Random) DeepCopyInto(out *Random) {
 	*out = *in
@@ -4092,6 +4585,11 @@ func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 		*out = new(LocalRateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(QuotaRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
@@ -4514,6 +5012,16 @@ func (in *Retry) DeepCopyInto(out *Retry) {
 		*out = new(PerRetryPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Hedge != nil {
+		in, out := &in.Hedge, &out.Hedge
+		*out = new(HedgePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostSelection != nil {
+		in, out := &in.HostSelection, &out.HostSelection
+		*out = new(RetryHostSelection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
@@ -4541,6 +5049,26 @@ func (in *RetryBudget) DeepCopy() *RetryBudget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryHostSelection) DeepCopyInto(out *RetryHostSelection) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryHostSelection.
+func (in *RetryHostSelection) DeepCopy() *RetryHostSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryHostSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryOn) DeepCopyInto(out *RetryOn) {
 	*out = *in
@@ -4691,6 +5219,11 @@ func (in *SecurityFeatures) DeepCopyInto(out *SecurityFeatures) {
 		*out = new(Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WAF != nil {
+		in, out := &in.WAF, &out.WAF
+		*out = new(WAF)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityFeatures.
@@ -5048,6 +5581,16 @@ func (in *TCPRoute) DeepCopyInto(out *TCPRoute) {
 		*out = new(Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRoute.
@@ -5376,6 +5919,11 @@ func (in *Timeout) DeepCopyInto(out *Timeout) {
 		*out = new(HTTPTimeout)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UDP != nil {
+		in, out := &in.UDP, &out.UDP
+		*out = new(UDPTimeout)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Timeout.
@@ -5487,6 +6035,11 @@ func (in *TrafficFeatures) DeepCopyInto(out *TrafficFeatures) {
 			}
 		}
 	}
+	if in.Decompression != nil {
+		in, out := &in.Decompression, &out.Decompression
+		*out = new(Decompression)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HTTPUpgrade != nil {
 		in, out := &in.HTTPUpgrade, &out.HTTPUpgrade
 		*out = make([]HTTPUpgradeConfig, len(*in))
@@ -5504,6 +6057,16 @@ func (in *TrafficFeatures) DeepCopyInto(out *TrafficFeatures) {
 		*out = new(RequestBuffer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdaptiveConcurrency != nil {
+		in, out := &in.AdaptiveConcurrency, &out.AdaptiveConcurrency
+		*out = new(AdaptiveConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficFeatures.
@@ -5555,6 +6118,21 @@ func (in *UDPRoute) DeepCopyInto(out *UDPRoute) {
 		*out = new(DNS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Timeout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPRoute.
@@ -5567,6 +6145,26 @@ func (in *UDPRoute) DeepCopy() *UDPRoute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDPTimeout) DeepCopyInto(out *UDPTimeout) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPTimeout.
+func (in *UDPTimeout) DeepCopy() *UDPTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(UDPTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLRewrite) DeepCopyInto(out *URLRewrite) {
 	*out = *in
@@ -5616,6 +6214,31 @@ func (in *UnstructuredRef) DeepCopy() *UnstructuredRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAF) DeepCopyInto(out *WAF) {
+	*out = *in
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(Wasm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DynamicModule != nil {
+		in, out := &in.DynamicModule, &out.DynamicModule
+		*out = new(DynamicModule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAF.
+func (in *WAF) DeepCopy() *WAF {
+	if in == nil {
+		return nil
+	}
+	out := new(WAF)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Wasm) DeepCopyInto(out *Wasm) {
 	*out = *in
@@ -5639,6 +6262,11 @@ func (in *Wasm) DeepCopyInto(out *Wasm) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(ExtensionMatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Wasm.
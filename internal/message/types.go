@@ -8,6 +8,7 @@ package message
 import (
 	"context"
 	"reflect"
+	"slices"
 
 	"github.com/telepresenceio/watchable"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -34,6 +35,10 @@ type ProviderResources struct {
 
 	// ExtensionStatuses is a group of gw-api extension resource statuses map.
 	ExtensionStatuses
+
+	// XdsRejections is a map from an IR key to the xDS updates
+	// rejected by the proxies configured from the IR.
+	XdsRejections watchable.Map[string, *XdsRejections]
 }
 
 func (p *ProviderResources) GetResources() []*resource.Resources {
@@ -75,6 +80,7 @@ func (p *ProviderResources) Close() {
 	p.GatewayAPIStatuses.Close()
 	p.PolicyStatuses.Close()
 	p.ExtensionStatuses.Close()
+	p.XdsRejections.Close()
 }
 
 // GatewayAPIStatuses contains gateway API resources statuses
@@ -138,6 +144,41 @@ func (e *ExtensionStatuses) Close() {
 	e.BackendStatuses.Close()
 }
 
+// XdsRejections contains the xDS updates rejected (NACKed) by the proxies of an IR key.
+type XdsRejections struct {
+	Rejections []XdsRejection
+}
+
+// DeepCopy creates a new XdsRejections.
+func (x *XdsRejections) DeepCopy() *XdsRejections {
+	if x == nil {
+		return nil
+	}
+	out := &XdsRejections{}
+	if x.Rejections != nil {
+		out.Rejections = make([]XdsRejection, len(x.Rejections))
+		for i, r := range x.Rejections {
+			out.Rejections[i] = r
+			out.Rejections[i].EnvoyPatchPolicies = slices.Clone(r.EnvoyPatchPolicies)
+		}
+	}
+	return out
+}
+
+// XdsRejection is the last update of a resource type rejected by a proxy.
+type XdsRejection struct {
+	// NodeID is the ID of the proxy that rejected the update.
+	NodeID string
+	// TypeURL is the type URL of the rejected resources.
+	TypeURL string
+	// Version is the version of the rejected update.
+	Version string
+	// Message is the error returned by the proxy.
+	Message string
+	// EnvoyPatchPolicies are the EnvoyPatchPolicies that patched the rejected resources.
+	EnvoyPatchPolicies []types.NamespacedName
+}
+
 type XdsIRWithContext struct {
 	XdsIR   *ir.Xds
 	Context context.Context
@@ -229,4 +270,6 @@ const (
 	GatewayClassStatusMessageName MessageName = "gatewayclass-status"
 	// EnvoyProxyStatusMessageName is a message containing updates to EnvoyProxy status
 	EnvoyProxyStatusMessageName MessageName = "envoyproxy-status"
+	// XdsRejectionsMessageName is a message containing updates to the xDS updates rejected by the proxies
+	XdsRejectionsMessageName MessageName = "xds-rejections"
 )
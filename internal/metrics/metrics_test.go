@@ -125,6 +125,10 @@ func metricFunc() {
 	currentIRsNum.With(NewLabel("ir-type").Value("xds")).Record(1)
 	currentIRsNum.With(NewLabel("ir-type").Value("xds")).Record(3)
 	currentIRsNum.With(NewLabel("ir-type").Value("xds")).Record(2)
+
+	// a deleted series is not exported
+	currentIRsNum.With(NewLabel("ir-type").Value("infra")).Record(1)
+	currentIRsNum.With(NewLabel("ir-type").Value("infra")).Delete()
 }
 
 func TestHistogram(t *testing.T) {
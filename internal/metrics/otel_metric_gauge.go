@@ -61,3 +61,12 @@ func (f *Gauge) With(labelValues ...LabelValue) *Gauge {
 
 	return m
 }
+
+// Delete removes the series of the gauge with its labels, so that it's no longer exported.
+// The series is recorded again after a new call to With with the same labels.
+func (f *Gauge) Delete() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.stores, attribute.NewSet(f.attrs...))
+}
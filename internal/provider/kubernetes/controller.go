@@ -136,6 +136,7 @@ type subscriptions struct {
 	backendStatuses              <-chan watchable.Snapshot[types.NamespacedName, *egv1a1.BackendStatus]
 	extensionPolicyStatuses      <-chan watchable.Snapshot[message.NamespacedNameAndGVK, *gwapiv1.PolicyStatus]
 	envoyProxyStatuses           <-chan watchable.Snapshot[types.NamespacedName, *egv1a1.EnvoyProxyStatus]
+	xdsRejections                <-chan watchable.Snapshot[string, *message.XdsRejections]
 }
 
 // newGatewayAPIController
@@ -261,6 +262,7 @@ func (r *gatewayAPIReconciler) subscribeToResources(ctx context.Context) {
 	r.subscriptions.backendStatuses = r.resources.BackendStatuses.Subscribe(ctx)
 	r.subscriptions.extensionPolicyStatuses = r.resources.ExtensionPolicyStatuses.Subscribe(ctx)
 	r.subscriptions.envoyProxyStatuses = r.resources.EnvoyProxyStatuses.Subscribe(ctx)
+	r.subscriptions.xdsRejections = r.resources.XdsRejections.Subscribe(ctx)
 }
 
 func (r *gatewayAPIReconciler) backendAPIDisabled() bool {
@@ -453,6 +455,15 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Reques
 			gcLogger.Error(err, "failed to process EnvoyTLSSecret")
 		}
 
+		// add the TLS Secrets of the rate limit quota service to the resourceTree
+		if err = r.processRateLimitQuotaTLSSecrets(ctx, gwcResource, gwcResourceMapping); err != nil {
+			if isTransientError(err) {
+				gcLogger.Error(err, "transient error processing rate limit quota TLS Secrets")
+				return reconcile.Result{}, err
+			}
+			gcLogger.Error(err, "failed to process rate limit quota TLS Secrets")
+		}
+
 		// Add all Gateways, their associated ListenerSets, Routes, and referenced resources to the resourceTree
 		if err = r.processGateways(ctx, managedGC, gwcResource, gwcResourceMapping); err != nil {
 			if isTransientError(err) {
@@ -1144,6 +1155,42 @@ func (r *gatewayAPIReconciler) processSecurityPolicyObjectRefs(
 				}
 			}
 		}
+
+		if waf := policy.Spec.WAF; waf != nil {
+			for _, ruleSet := range waf.RuleSets {
+				if err := r.processConfigMapRef(
+					ctx,
+					resourceMap,
+					resourceTree,
+					resource.KindSecurityPolicy,
+					policy.Namespace,
+					policy.Name,
+					gwapiv1.SecretObjectReference{
+						Group: &ruleSet.Group,
+						Kind:  &ruleSet.Kind,
+						Name:  ruleSet.Name,
+					}); err != nil {
+					// If the error is transient, we return it to allow Reconcile to retry.
+					if isTransientError(err) {
+						return err
+					}
+					r.log.Error(err, "failed to process WAF rule set ConfigMap", "policy", policy, "ruleSet", ruleSet)
+				}
+			}
+
+			if waf.Engine.Wasm != nil {
+				if err := r.processWasmCodeSourceRefs(
+					ctx,
+					resourceMap,
+					resourceTree,
+					resource.KindSecurityPolicy,
+					policy.Namespace,
+					policy.Name,
+					waf.Engine.Wasm); err != nil {
+					return err
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -1281,6 +1328,51 @@ func (r *gatewayAPIReconciler) processEnvoyTLSSecret(ctx context.Context, resour
 	return nil
 }
 
+// processRateLimitQuotaTLSSecrets adds the Secrets referenced by the TLS settings of the
+// rate limit quota service to the resourceTree. They are used by envoy to establish
+// TLS connections to the rate limit quota service.
+func (r *gatewayAPIReconciler) processRateLimitQuotaTLSSecrets(ctx context.Context, resourceTree *resource.Resources, resourceMap *resourceMappings) error {
+	var errs error
+	for _, nsName := range r.rateLimitQuotaTLSSecrets() {
+		var secret corev1.Secret
+		if err := r.client.Get(ctx, nsName, &secret); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		key := utils.NamespacedName(&secret).String()
+		if !resourceMap.allAssociatedSecrets.Has(key) {
+			resourceMap.allAssociatedSecrets.Insert(key)
+			resourceTree.Secrets = append(resourceTree.Secrets, &secret)
+			r.log.Info("processing rate limit quota TLS Secret", "namespace", secret.Namespace, "name", secret.Name)
+		}
+	}
+	return errs
+}
+
+// rateLimitQuotaTLSSecrets returns the Secrets referenced by the TLS settings of the rate limit quota service.
+func (r *gatewayAPIReconciler) rateLimitQuotaTLSSecrets() []types.NamespacedName {
+	if r.envoyGateway == nil {
+		return nil
+	}
+	tlsSettings := r.envoyGateway.RateLimitQuotaTLS()
+	if tlsSettings == nil {
+		return nil
+	}
+
+	var secrets []types.NamespacedName
+	for _, ref := range []*gwapiv1.SecretObjectReference{tlsSettings.CACertificateRef, tlsSettings.ClientCertificateRef} {
+		if ref == nil {
+			continue
+		}
+		secrets = append(secrets, types.NamespacedName{
+			Namespace: gatewayapi.NamespaceDerefOr(ref.Namespace, r.namespace),
+			Name:      string(ref.Name),
+		})
+	}
+	return secrets
+}
+
 // processSecretRef adds the referenced Secret to the resourceTree if it's valid.
 // - If it exists in the same namespace as the owner.
 // - If it exists in a different namespace, and there is a ReferenceGrant.
@@ -3030,6 +3122,8 @@ func (r *gatewayAPIReconciler) processGatewayParamsRef(ctx context.Context, gtw
 		}
 	}
 
+	r.processEnvoyProxyConfigMapRefs(ctx, ep, resourceMap, resourceTree)
+
 	resourceTree.EnvoyProxiesForGateways = append(resourceTree.EnvoyProxiesForGateways, ep)
 	return nil
 }
@@ -3056,10 +3150,38 @@ func (r *gatewayAPIReconciler) processGatewayClassParamsRef(ctx context.Context,
 	// It will be recomputed by the gateway-api layer
 	ep.Status = egv1a1.EnvoyProxyStatus{}
 	r.processEnvoyProxy(ep, resourceMap)
+	r.processEnvoyProxyConfigMapRefs(ctx, ep, resourceMap, resourceTree)
 	resourceTree.EnvoyProxyForGatewayClass = ep
 	return nil
 }
 
+// processEnvoyProxyConfigMapRefs adds the ConfigMaps referenced by the IP tags of the
+// EnvoyProxy to the resourceTree.
+// A missing ConfigMap shouldn't stop the Gateway infrastructure from coming up, the error
+// is surfaced to the status of the Gateway by the gateway-api layer.
+func (r *gatewayAPIReconciler) processEnvoyProxyConfigMapRefs(ctx context.Context, ep *egv1a1.EnvoyProxy,
+	resourceMap *resourceMappings, resourceTree *resource.Resources,
+) {
+	if ep.Spec.IPTagging == nil {
+		return
+	}
+	for _, tag := range ep.Spec.IPTagging.Tags {
+		if tag.ValueRef == nil || string(tag.ValueRef.Kind) != resource.KindConfigMap {
+			continue
+		}
+		if err := r.processConfigMapRef(ctx, resourceMap, resourceTree,
+			resource.KindEnvoyProxy, ep.Namespace, ep.Name,
+			gwapiv1.SecretObjectReference{
+				Group: new(tag.ValueRef.Group),
+				Kind:  new(tag.ValueRef.Kind),
+				Name:  tag.ValueRef.Name,
+			}); err != nil {
+			r.log.Error(err, "failed to process IP tag ConfigMap for EnvoyProxy",
+				"namespace", ep.Namespace, "name", ep.Name, "tag", tag.Name)
+		}
+	}
+}
+
 // processEnvoyProxy processes the parametersRef of the provided GatewayClass/Gateway.
 func (r *gatewayAPIReconciler) processEnvoyProxy(ep *egv1a1.EnvoyProxy, resourceMap *resourceMappings) {
 	key := utils.NamespacedName(ep).String()
@@ -3293,6 +3415,92 @@ func (r *gatewayAPIReconciler) processExtensionServerPolicies(
 	return nil
 }
 
+// processWasmCodeSourceRefs adds the pull Secret and the CA certificate referenced
+// in a Wasm code source to the resourceTree.
+// Only transient errors are returned, other errors are logged, and the policy
+// will be marked as invalid in its status when translating to IR.
+func (r *gatewayAPIReconciler) processWasmCodeSourceRefs(
+	ctx context.Context,
+	resourceMap *resourceMappings,
+	resourceTree *resource.Resources,
+	policyKind, policyNamespace, policyName string,
+	code *egv1a1.WasmCodeSource,
+) error {
+	if code.Image != nil && code.Image.PullSecretRef != nil {
+		if err := r.processSecretRef(
+			ctx,
+			resourceMap,
+			resourceTree,
+			policyKind,
+			policyNamespace,
+			policyName,
+			*code.Image.PullSecretRef); err != nil {
+			// If the error is transient, we return it to retry later
+			if isTransientError(err) {
+				return err
+			}
+			r.log.Error(err,
+				"failed to process Wasm Image PullSecretRef",
+				"kind", policyKind, "namespace", policyNamespace, "name", policyName,
+				"secretRef", code.Image.PullSecretRef)
+		}
+	}
+
+	var caCertRef *gwapiv1.SecretObjectReference
+	if code.HTTP != nil && code.HTTP.TLS != nil {
+		caCertRef = &code.HTTP.TLS.CACertificateRef
+	} else if code.Image != nil && code.Image.TLS != nil {
+		caCertRef = &code.Image.TLS.CACertificateRef
+	}
+	if caCertRef == nil {
+		return nil
+	}
+
+	kind := resource.KindSecret
+	if caCertRef.Kind != nil {
+		kind = string(*caCertRef.Kind)
+	}
+
+	var err error
+	switch kind {
+	case resource.KindSecret:
+		err = r.processSecretRef(
+			ctx,
+			resourceMap,
+			resourceTree,
+			policyKind,
+			policyNamespace,
+			policyName,
+			*caCertRef)
+	case resource.KindConfigMap:
+		err = r.processConfigMapRef(
+			ctx,
+			resourceMap,
+			resourceTree,
+			policyKind,
+			policyNamespace,
+			policyName,
+			*caCertRef)
+	case resource.KindClusterTrustBundle:
+		err = r.processClusterTrustBundleRef(
+			ctx,
+			resourceMap,
+			resourceTree,
+			*caCertRef)
+	}
+	if err != nil {
+		// If the error is transient, we return it to retry later
+		if isTransientError(err) {
+			return err
+		}
+		r.log.Error(err,
+			"failed to process Wasm TLS CA Cert Ref",
+			"kind", policyKind, "namespace", policyNamespace, "name", policyName,
+			"caCertRef", caCertRef)
+	}
+	return nil
+}
+
 // processEnvoyExtensionPolicyObjectRefs adds the referenced resources in EnvoyExtensionPolicies
 // to the resourceTree
 // - BackendRefs for ExtProcs
@@ -3329,88 +3537,15 @@ func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 
 		// Add the referenced SecretRefs, ConfigMapRefs, and ClusterTrustBundleRefs in EnvoyExtensionPolicies to the resourceTree
 		for _, wasm := range policy.Spec.Wasm {
-			if wasm.Code.Image != nil && wasm.Code.Image.PullSecretRef != nil {
-				if err := r.processSecretRef(
-					ctx,
-					resourceMap,
-					resourceTree,
-					resource.KindEnvoyExtensionPolicy,
-					policy.Namespace,
-					policy.Name,
-					*wasm.Code.Image.PullSecretRef); err != nil {
-					// If the error is transient, we return it to retry later
-					if isTransientError(err) {
-						return err
-					}
-					r.log.Error(err,
-						"failed to process Wasm Image PullSecretRef for EnvoyExtensionPolicy",
-						"policy", policy, "secretRef", wasm.Code.Image.PullSecretRef)
-				}
-			}
-
-			var caCertRef *gwapiv1.SecretObjectReference
-			if wasm.Code.HTTP != nil && wasm.Code.HTTP.TLS != nil {
-				caCertRef = &wasm.Code.HTTP.TLS.CACertificateRef
-			} else if wasm.Code.Image != nil && wasm.Code.Image.TLS != nil {
-				caCertRef = &wasm.Code.Image.TLS.CACertificateRef
-			}
-
-			if caCertRef != nil {
-				kind := resource.KindSecret
-				if caCertRef.Kind != nil {
-					kind = string(*caCertRef.Kind)
-				}
-
-				switch kind {
-				case resource.KindSecret:
-					if err := r.processSecretRef(
-						ctx,
-						resourceMap,
-						resourceTree,
-						resource.KindEnvoyExtensionPolicy,
-						policy.Namespace,
-						policy.Name,
-						*caCertRef); err != nil {
-						// If the error is transient, we return it to retry later
-						if isTransientError(err) {
-							return err
-						}
-						r.log.Error(err,
-							"failed to process Wasm TLS Secret CA Cert Ref for EnvoyExtensionPolicy",
-							"policy", policy, "secretRef", caCertRef)
-					}
-				case resource.KindConfigMap:
-					if err := r.processConfigMapRef(
-						ctx,
-						resourceMap,
-						resourceTree,
-						resource.KindEnvoyExtensionPolicy,
-						policy.Namespace,
-						policy.Name,
-						*caCertRef); err != nil {
-						// If the error is transient, we return it to retry later
-						if isTransientError(err) {
-							return err
-						}
-						r.log.Error(err,
-							"failed to process Wasm TLS ConfigMap CA Cert Ref for EnvoyExtensionPolicy",
-							"policy", policy, "configMapRef", caCertRef)
-					}
-				case resource.KindClusterTrustBundle:
-					if err := r.processClusterTrustBundleRef(
-						ctx,
-						resourceMap,
-						resourceTree,
-						*caCertRef); err != nil {
-						// If the error is transient, we return it to retry later
-						if isTransientError(err) {
-							return err
-						}
-						r.log.Error(err,
-							"failed to process Wasm TLS ClusterTrustBundle CA Cert Ref for EnvoyExtensionPolicy",
-							"policy", policy, "ctbRef", caCertRef)
-					}
-				}
+			if err := r.processWasmCodeSourceRefs(
+				ctx,
+				resourceMap,
+				resourceTree,
+				resource.KindEnvoyExtensionPolicy,
+				policy.Namespace,
+				policy.Name,
+				&wasm.Code); err != nil {
+				return err
 			}
 		}
 
@@ -3442,6 +3577,52 @@ func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 				}
 			}
 		}
+
+		// Add the referenced proto descriptor ConfigMap or Secret in the gRPC-JSON transcoder to the resource tree
+		if transcoder := policy.Spec.GRPCJSONTranscoder; transcoder != nil {
+			descriptorRef := transcoder.ProtoDescriptorRef
+			ref := gwapiv1.SecretObjectReference{
+				Group: &descriptorRef.Group,
+				Kind:  &descriptorRef.Kind,
+				Name:  descriptorRef.Name,
+			}
+			switch string(descriptorRef.Kind) {
+			case resource.KindSecret:
+				if err := r.processSecretRef(
+					ctx,
+					resourceMap,
+					resourceTree,
+					resource.KindEnvoyExtensionPolicy,
+					policy.Namespace,
+					policy.Name,
+					ref); err != nil {
+					// If the error is transient, we return it to retry later
+					if isTransientError(err) {
+						return err
+					}
+					r.log.Error(err,
+						"failed to process gRPC-JSON transcoder ProtoDescriptorRef for EnvoyExtensionPolicy",
+						"policy", policy, "secretRef", descriptorRef.Name)
+				}
+			case resource.KindConfigMap:
+				if err := r.processConfigMapRef(
+					ctx,
+					resourceMap,
+					resourceTree,
+					resource.KindEnvoyExtensionPolicy,
+					policy.Namespace,
+					policy.Name,
+					ref); err != nil {
+					// If the error is transient, we return it to retry later
+					if isTransientError(err) {
+						return err
+					}
+					r.log.Error(err,
+						"failed to process gRPC-JSON transcoder ProtoDescriptorRef for EnvoyExtensionPolicy",
+						"policy", policy, "configMapRef", descriptorRef.Name)
+				}
+			}
+		}
 	}
 	return nil
 }
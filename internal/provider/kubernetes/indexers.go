@@ -57,6 +57,7 @@ const (
 	backendEnvoyExtensionPolicyIndex = "backendEnvoyExtensionPolicyIndex"
 	backendEnvoyProxyTelemetryIndex  = "backendEnvoyProxyTelemetryIndex"
 	secretEnvoyProxyIndex            = "secretEnvoyProxyIndex"
+	configMapEnvoyProxyIndex         = "configMapEnvoyProxyIndex"
 	secretEnvoyExtensionPolicyIndex  = "secretEnvoyExtensionPolicyIndex"
 	httpRouteFilterHTTPRouteIndex    = "httpRouteFilterHTTPRouteIndex"
 	httpRouteFilterGRPCRouteIndex    = "httpRouteFilterGRPCRouteIndex"
@@ -292,6 +293,10 @@ func addEnvoyProxyIndexers(ctx context.Context, mgr manager.Manager) error {
 		return err
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.EnvoyProxy{}, configMapEnvoyProxyIndex, configMapEnvoyProxyIndexFunc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -323,6 +328,23 @@ func secretEnvoyProxyIndexFunc(rawObj client.Object) []string {
 	return secretReferences
 }
 
+func configMapEnvoyProxyIndexFunc(rawObj client.Object) []string {
+	ep := rawObj.(*egv1a1.EnvoyProxy)
+	var configMapReferences []string
+	if ep.Spec.IPTagging != nil {
+		for _, tag := range ep.Spec.IPTagging.Tags {
+			if tag.ValueRef != nil && string(tag.ValueRef.Kind) == resource.KindConfigMap {
+				configMapReferences = append(configMapReferences,
+					types.NamespacedName{
+						Namespace: ep.Namespace,
+						Name:      string(tag.ValueRef.Name),
+					}.String())
+			}
+		}
+	}
+	return configMapReferences
+}
+
 func accessLogRefs(ep *egv1a1.EnvoyProxy) []string {
 	var refs []string
 
@@ -829,6 +851,15 @@ func secretSecurityPolicyIndexFunc(rawObj client.Object) []string {
 	if securityPolicy.Spec.BasicAuth != nil {
 		secretReferences = append(secretReferences, securityPolicy.Spec.BasicAuth.Users)
 	}
+	if waf := securityPolicy.Spec.WAF; waf != nil && waf.Engine.Wasm != nil {
+		if image := waf.Engine.Wasm.Image; image != nil && image.PullSecretRef != nil {
+			secretReferences = append(secretReferences, *image.PullSecretRef)
+		}
+		if caCertRef := wasmCodeSourceCACertRef(waf.Engine.Wasm); caCertRef != nil &&
+			(caCertRef.Kind == nil || string(*caCertRef.Kind) == resource.KindSecret) {
+			secretReferences = append(secretReferences, *caCertRef)
+		}
+	}
 
 	for _, reference := range secretReferences {
 		values = append(values,
@@ -939,9 +970,45 @@ func configMapSecurityPolicyIndexFunc(rawObj client.Object) []string {
 		}
 	}
 
+	if waf := securityPolicy.Spec.WAF; waf != nil {
+		for _, ruleSet := range waf.RuleSets {
+			values = append(values,
+				types.NamespacedName{
+					Namespace: securityPolicy.Namespace,
+					Name:      string(ruleSet.Name),
+				}.String(),
+			)
+		}
+
+		if caCertRef := wasmCodeSourceCACertRef(waf.Engine.Wasm); caCertRef != nil &&
+			caCertRef.Kind != nil && string(*caCertRef.Kind) == resource.KindConfigMap {
+			values = append(values,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(caCertRef.Namespace, securityPolicy.Namespace),
+					Name:      string(caCertRef.Name),
+				}.String(),
+			)
+		}
+	}
+
 	return values
 }
 
+// wasmCodeSourceCACertRef returns the CA certificate referenced in the TLS
+// configuration of a Wasm code source, or nil if there is none.
+func wasmCodeSourceCACertRef(code *egv1a1.WasmCodeSource) *gwapiv1.SecretObjectReference {
+	switch {
+	case code == nil:
+		return nil
+	case code.HTTP != nil && code.HTTP.TLS != nil:
+		return &code.HTTP.TLS.CACertificateRef
+	case code.Image != nil && code.Image.TLS != nil:
+		return &code.Image.TLS.CACertificateRef
+	default:
+		return nil
+	}
+}
+
 // addCtpIndexers adds indexing on ClientTrafficPolicy, for ConfigMap or Secret objects that are
 // referenced in ClientTrafficPolicy objects. This helps in querying for ClientTrafficPolicies that are
 // affected by a particular ConfigMap or Secret CRUD.
@@ -1194,6 +1261,16 @@ func configMapEepIndexFunc(rawObj client.Object) []string {
 		}
 	}
 
+	if transcoder := eep.Spec.GRPCJSONTranscoder; transcoder != nil &&
+		string(transcoder.ProtoDescriptorRef.Kind) == resource.KindConfigMap {
+		configMapReferences = append(configMapReferences,
+			types.NamespacedName{
+				Namespace: eep.Namespace,
+				Name:      string(transcoder.ProtoDescriptorRef.Name),
+			}.String(),
+		)
+	}
+
 	return configMapReferences
 }
 
@@ -1397,6 +1474,15 @@ func secretEnvoyExtensionPolicyIndexFunc(rawObj client.Object) []string {
 		}
 	}
 
+	if transcoder := envoyExtensionPolicy.Spec.GRPCJSONTranscoder; transcoder != nil &&
+		string(transcoder.ProtoDescriptorRef.Kind) == resource.KindSecret {
+		ret = append(ret,
+			types.NamespacedName{
+				Namespace: envoyExtensionPolicy.Namespace,
+				Name:      string(transcoder.ProtoDescriptorRef.Name),
+			}.String())
+	}
+
 	return ret
 }
 
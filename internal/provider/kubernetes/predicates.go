@@ -8,6 +8,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	appsv1 "k8s.io/api/apps/v1"
 	certificatesv1b1 "k8s.io/api/certificates/v1beta1"
@@ -187,6 +188,10 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(secret *corev1.Secret)
 		return true
 	}
 
+	if r.isRateLimitQuotaTLSSecret(&nsName) {
+		return true
+	}
+
 	if r.epCRDExists {
 		if r.isEnvoyProxyReferencingSecret(&nsName) {
 			return true
@@ -473,6 +478,10 @@ func (r *gatewayAPIReconciler) isEnvoyTLSSecret(nsName *types.NamespacedName) bo
 	return *nsName == envoyTLSSecret
 }
 
+func (r *gatewayAPIReconciler) isRateLimitQuotaTLSSecret(nsName *types.NamespacedName) bool {
+	return slices.Contains(r.rateLimitQuotaTLSSecrets(), *nsName)
+}
+
 // validateServiceForReconcile tries finding the owning Gateway of the Service
 // if it exists, finds the Gateway's Deployment, and further updates the Gateway
 // status Ready condition. All Services are pushed for reconciliation.
@@ -994,6 +1003,18 @@ func (r *gatewayAPIReconciler) validateConfigMapForReconcile(obj client.Object)
 		}
 	}
 
+	epList := &egv1a1.EnvoyProxyList{}
+	if err := r.client.List(context.Background(), epList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(configMapEnvoyProxyIndex, utils.NamespacedName(configMap).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated EnvoyProxy")
+		return false
+	}
+
+	if len(epList.Items) > 0 {
+		return true
+	}
+
 	if !r.backendAPIDisabled() {
 		backendList := &egv1a1.BackendList{}
 		if err := r.client.List(context.Background(), backendList, &client.ListOptions{
@@ -508,6 +508,32 @@ func TestValidateConfigMapForReconcile(t *testing.T) {
 			configMap: test.GetConfigMap(types.NamespacedName{Namespace: "default", Name: "btls-ca"}, make(map[string]string), make(map[string]string)),
 			expect:    true,
 		},
+		{
+			name: "references EnvoyProxy IP tag config map",
+			configs: []client.Object{
+				&egv1a1.EnvoyProxy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "proxy-config",
+						Namespace: "envoy-gateway-system",
+					},
+					Spec: egv1a1.EnvoyProxySpec{
+						IPTagging: &egv1a1.EnvoyProxyIPTagging{
+							Tags: []egv1a1.IPTag{
+								{
+									Name: "internal",
+									ValueRef: &gwapiv1.LocalObjectReference{
+										Kind: resource.KindConfigMap,
+										Name: "internal-cidrs",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			configMap: test.GetConfigMap(types.NamespacedName{Namespace: "envoy-gateway-system", Name: "internal-cidrs"}, nil, nil),
+			expect:    true,
+		},
 		{
 			name:          "references BackendTLSPolicy CA config map but BackendTLSPolicy CRD is absent",
 			btlsCRDAbsent: true,
@@ -561,6 +587,7 @@ func TestValidateConfigMapForReconcile(t *testing.T) {
 			WithIndex(&egv1a1.Backend{}, configMapBackendIndex, configMapBackendIndexFunc).
 			WithIndex(&egv1a1.EnvoyExtensionPolicy{}, configMapEepIndex, configMapEepIndexFunc).
 			WithIndex(&egv1a1.SecurityPolicy{}, configMapSecurityPolicyIndex, configMapSecurityPolicyIndexFunc).
+			WithIndex(&egv1a1.EnvoyProxy{}, configMapEnvoyProxyIndex, configMapEnvoyProxyIndexFunc).
 			Build()
 		t.Run(tc.name, func(t *testing.T) {
 			res := r.validateConfigMapForReconcile(tc.configMap)
@@ -1158,6 +1185,7 @@ func TestValidateSecretForReconcile(t *testing.T) {
 			WithIndex(&gwapiv1.ListenerSet{}, secretListenerSetIndex, secretListenerSetIndexFunc).
 			WithIndex(&egv1a1.SecurityPolicy{}, secretSecurityPolicyIndex, secretSecurityPolicyIndexFunc).
 			WithIndex(&egv1a1.EnvoyProxy{}, secretEnvoyProxyIndex, secretEnvoyProxyIndexFunc).
+			WithIndex(&egv1a1.EnvoyProxy{}, configMapEnvoyProxyIndex, configMapEnvoyProxyIndexFunc).
 			WithIndex(&egv1a1.EnvoyExtensionPolicy{}, secretEnvoyExtensionPolicyIndex, secretEnvoyExtensionPolicyIndexFunc).
 			WithIndex(&egv1a1.Backend{}, secretBackendIndex, secretBackendIndexFunc).
 			WithIndex(&egv1a1.HTTPRouteFilter{}, secretHTTPRouteFilterIndex, secretRouteFilterIndexFunc).
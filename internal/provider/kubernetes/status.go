@@ -8,9 +8,11 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -649,6 +651,35 @@ func (r *gatewayAPIReconciler) updateStatusFromSubscriptions(ctx context.Context
 		}()
 	}
 
+	// Gateway status updater for the xDS configuration rejected by the proxies
+	go func() {
+		message.HandleSubscription(r.log,
+			message.Metadata{Runner: string(egv1a1.LogComponentProviderRunner), Message: message.XdsRejectionsMessageName},
+			r.subscriptions.xdsRejections,
+			func(update message.Update[string, *message.XdsRejections], errChan chan error) {
+				// The IR key is either the GatewayClass name if the Gateways are merged,
+				// or the namespace/name of the Gateway.
+				if namespace, name, found := strings.Cut(update.Key, "/"); found {
+					gtw := new(gwapiv1.Gateway)
+					if err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, gtw); err != nil {
+						if !kerrors.IsNotFound(err) {
+							r.log.Error(err, "failed to get gateway", "namespace", namespace, "name", name)
+							errChan <- err
+						}
+						return
+					}
+					r.updateGatewayStatus(gtw)
+					return
+				}
+				if err := r.updateStatusForGatewaysUnderGatewayClass(ctx, update.Key); err != nil {
+					r.log.Error(err, "failed to update status for gateways", "gatewayclass", update.Key)
+					errChan <- err
+				}
+			},
+		)
+		r.log.Info("xds rejections subscriber shutting down")
+	}()
+
 	// EnvoyProxy status updater
 	go func() {
 		message.HandleSubscription(r.log,
@@ -690,6 +721,15 @@ func (r *gatewayAPIReconciler) updateStatusFromSubscriptions(ctx context.Context
 	}()
 }
 
+// irKeyForGateway returns the key of the IR the Gateway is translated into, which is the
+// GatewayClass name if the Gateways of the class are merged.
+func (r *gatewayAPIReconciler) irKeyForGateway(gtw *gwapiv1.Gateway) string {
+	if r.isGatewayClassMerged(string(gtw.Spec.GatewayClassName)) {
+		return string(gtw.Spec.GatewayClassName)
+	}
+	return utils.NamespacedName(gtw).String()
+}
+
 // mergeRouteParentStatus merges the old and new RouteParentStatus.
 // This is needed because the RouteParentStatus doesn't support strategic merge patch yet.
 func mergeRouteParentStatus(ns string, old, new []gwapiv1.RouteParentStatus) []gwapiv1.RouteParentStatus {
@@ -765,6 +805,10 @@ func (r *gatewayAPIReconciler) updateStatusForGateway(ctx context.Context, gtw *
 		status.UpdateGatewayStatusProgrammedCondition(gtw, svc, envoyObj, r.nodeAddressesForGateway(ctx, gtw, svc), r.envoyGateway.Provider.IsInfraManagedRemotely())
 	}
 
+	// Surface the xDS configuration rejected by the proxies of the Gateway.
+	rejections, _ := r.resources.XdsRejections.Load(r.irKeyForGateway(gtw))
+	status.UpdateGatewayStatusXDSRejectedCondition(gtw, rejections)
+
 	key := utils.NamespacedName(gtw)
 
 	// publish status
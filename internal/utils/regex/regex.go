@@ -8,7 +8,9 @@ package regex
 import (
 	"fmt"
 	"regexp"
+	"regexp/syntax"
 	"strings"
+	"unicode"
 )
 
 // Validate validates a regex string.
@@ -41,3 +43,83 @@ func PathSeparatedPrefixRegex(prefix string) string {
 
 	return "^" + escapedPrefix + "(/.*|\\?.*|#.*|;.*|$)"
 }
+
+// ListElementRegex creates a regex pattern that matches a comma-separated list, such as the
+// value of the x-envoy-ip-tags header, that contains any one of the elements.
+func ListElementRegex(elements []string) string {
+	escaped := make([]string, 0, len(elements))
+	for _, element := range elements {
+		escaped = append(escaped, regexp.QuoteMeta(element))
+	}
+	return "^(.*,)?(" + strings.Join(escaped, "|") + ")(,.*)?$"
+}
+
+// ListElementPatternRegex creates a regex pattern that matches a comma-separated list, such as
+// the SANs of a client certificate, that contains an element fully matching the given regex.
+// The regex is rewritten so that it never matches across the commas, and its anchors match
+// the boundaries of the element.
+func ListElementPatternRegex(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf("regex %q is invalid: %w", pattern, err)
+	}
+	return "^(.*,)?(?:" + withinListElement(re).String() + ")(,.*)?$", nil
+}
+
+// withinListElement rewrites the regex so that it only matches within an element of a
+// comma-separated list.
+func withinListElement(re *syntax.Regexp) *syntax.Regexp {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+		// The element is fully matched, so the anchors always match its boundaries.
+		return &syntax.Regexp{Op: syntax.OpEmptyMatch}
+	case syntax.OpAnyChar:
+		return &syntax.Regexp{Op: syntax.OpCharClass, Rune: []rune{0, ',' - 1, ',' + 1, unicode.MaxRune}}
+	case syntax.OpAnyCharNotNL:
+		return &syntax.Regexp{Op: syntax.OpCharClass, Rune: []rune{0, '\n' - 1, '\n' + 1, ',' - 1, ',' + 1, unicode.MaxRune}}
+	case syntax.OpCharClass:
+		return &syntax.Regexp{Op: syntax.OpCharClass, Flags: re.Flags, Rune: removeComma(re.Rune)}
+	case syntax.OpLiteral:
+		if strings.ContainsRune(string(re.Rune), ',') {
+			return &syntax.Regexp{Op: syntax.OpNoMatch}
+		}
+		return re
+	}
+
+	rewritten := *re
+	rewritten.Sub = make([]*syntax.Regexp, 0, len(re.Sub))
+	for _, sub := range re.Sub {
+		sub = withinListElement(sub)
+		// Drop the replaced anchors from the concatenations, to keep the regex readable.
+		if re.Op == syntax.OpConcat && sub.Op == syntax.OpEmptyMatch {
+			continue
+		}
+		rewritten.Sub = append(rewritten.Sub, sub)
+	}
+	if re.Op == syntax.OpConcat && len(rewritten.Sub) < 2 {
+		if len(rewritten.Sub) == 0 {
+			return &syntax.Regexp{Op: syntax.OpEmptyMatch}
+		}
+		return rewritten.Sub[0]
+	}
+	return &rewritten
+}
+
+// removeComma removes the comma from the ranges of a character class.
+func removeComma(ranges []rune) []rune {
+	result := make([]rune, 0, len(ranges)+2)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo > ',' || hi < ',' {
+			result = append(result, lo, hi)
+			continue
+		}
+		if lo < ',' {
+			result = append(result, lo, ','-1)
+		}
+		if hi > ',' {
+			result = append(result, ','+1, hi)
+		}
+	}
+	return result
+}
@@ -245,3 +245,160 @@ func TestPathSeparatedPrefixRegex(t *testing.T) {
 		})
 	}
 }
+
+func TestListElementRegex(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements []string
+		value    string
+		want     bool
+	}{
+		{
+			name:     "single element",
+			elements: []string{"office"},
+			value:    "office",
+			want:     true,
+		},
+		{
+			name:     "first element of the list",
+			elements: []string{"office"},
+			value:    "office,vpn",
+			want:     true,
+		},
+		{
+			name:     "last element of the list",
+			elements: []string{"office"},
+			value:    "vpn,office",
+			want:     true,
+		},
+		{
+			name:     "any of the elements",
+			elements: []string{"office", "vpn"},
+			value:    "known-bad-bots,vpn",
+			want:     true,
+		},
+		{
+			name:     "element prefix",
+			elements: []string{"office"},
+			value:    "office-berlin,vpn",
+			want:     false,
+		},
+		{
+			name:     "escaped element",
+			elements: []string{"a.b"},
+			value:    "axb",
+			want:     false,
+		},
+		{
+			name:     "empty value",
+			elements: []string{"office"},
+			value:    "",
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern := ListElementRegex(tt.elements)
+
+			regex, err := regexp.Compile(pattern)
+			if err != nil {
+				t.Fatalf("Failed to compile regex pattern %q: %v", pattern, err)
+			}
+
+			got := regex.MatchString(tt.value)
+			if got != tt.want {
+				t.Errorf("ListElementRegex(%q).MatchString(%q) = %v, want %v (pattern: %q)",
+					tt.elements, tt.value, got, tt.want, pattern)
+			}
+		})
+	}
+}
+
+func TestListElementPatternRegex(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		value   string
+		want    bool
+	}{
+		{
+			name:    "anchored element",
+			pattern: "^spiffe://corp/ns/[^/]+/sa/billing$",
+			value:   "spiffe://corp/ns/default/sa/web,spiffe://corp/ns/default/sa/billing",
+			want:    true,
+		},
+		{
+			name:    "anchored alternation",
+			pattern: "^a.example.com$|^b.example.com$",
+			value:   "c.example.com,b.example.com",
+			want:    true,
+		},
+		{
+			name:    "anchored alternation without match",
+			pattern: "^a.example.com$|^b.example.com$",
+			value:   "c.example.com,xb.example.com",
+			want:    false,
+		},
+		{
+			name:    "unanchored element",
+			pattern: `[a-z]+\.example\.com`,
+			value:   "b.example.com",
+			want:    true,
+		},
+		{
+			name:    "partial element",
+			pattern: `example\.com`,
+			value:   "b.example.com",
+			want:    false,
+		},
+		{
+			name:    "wildcard doesn't span elements",
+			pattern: "a.*z",
+			value:   "a,z",
+			want:    false,
+		},
+		{
+			name:    "negated class doesn't span elements",
+			pattern: "a[^/]*z",
+			value:   "a,z",
+			want:    false,
+		},
+		{
+			name:    "literal comma never matches",
+			pattern: "a,z",
+			value:   "a,z",
+			want:    false,
+		},
+		{
+			name:    "case insensitive",
+			pattern: "(?i)^B.EXAMPLE.COM$",
+			value:   "a.example.com,b.example.com",
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, err := ListElementPatternRegex(tt.pattern)
+			if err != nil {
+				t.Fatalf("ListElementPatternRegex(%q) failed: %v", tt.pattern, err)
+			}
+
+			regex, err := regexp.Compile(pattern)
+			if err != nil {
+				t.Fatalf("Failed to compile regex pattern %q: %v", pattern, err)
+			}
+
+			got := regex.MatchString(tt.value)
+			if got != tt.want {
+				t.Errorf("ListElementPatternRegex(%q).MatchString(%q) = %v, want %v (pattern: %q)",
+					tt.pattern, tt.value, got, tt.want, pattern)
+			}
+		})
+	}
+
+	if _, err := ListElementPatternRegex("^[a-z"); err == nil {
+		t.Errorf("ListElementPatternRegex() with an invalid regex, want an error")
+	}
+}
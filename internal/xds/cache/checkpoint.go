@@ -0,0 +1,523 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	cachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// checkpointSuffix is the suffix of the files and Secret keys of the persisted snapshots.
+	checkpointSuffix = ".json.gz"
+	// checkpointSecretKey is the key of the snapshot in the Secrets of the persisted snapshots.
+	checkpointSecretKey = "snapshot" + checkpointSuffix
+	// checkpointLabel labels the Secrets of the persisted snapshots with the name of the checkpoint.
+	checkpointLabel = "gateway.envoyproxy.io/xds-snapshot-checkpoint"
+	// checkpointWriteInterval is how long the writes of the snapshots are delayed, so that
+	// the successive snapshots of an IR key are written once.
+	checkpointWriteInterval = time.Second
+	// checkpointFlushTimeout bounds the last write of the snapshots on shutdown.
+	checkpointFlushTimeout = 5 * time.Second
+)
+
+// Checkpointer persists the last snapshot of each IR key, so that it can be served
+// right away after a restart.
+type Checkpointer interface {
+	// Load returns the persisted snapshots, indexed by IR key.
+	Load(ctx context.Context) (map[string]*cachev3.Snapshot, error)
+	// Save persists the snapshot of the IR key.
+	Save(ctx context.Context, irKey string, snapshot *cachev3.Snapshot) error
+	// Delete removes the persisted snapshot of the IR key.
+	Delete(ctx context.Context, irKey string) error
+}
+
+// checkpoint is the persisted form of a snapshot.
+type checkpoint struct {
+	IRKey string `json:"irKey"`
+	// Resources maps the type URLs to their resources.
+	Resources map[string]checkpointResources `json:"resources"`
+}
+
+type checkpointResources struct {
+	Version string `json:"version"`
+	// Items are the resources, marshaled as Any and sorted by name.
+	Items [][]byte `json:"items,omitempty"`
+}
+
+// checkpointKey returns the file name of the IR key.
+// The IR keys are made of Kubernetes names, which never contain an underscore.
+func checkpointKey(irKey string) string {
+	return strings.ReplaceAll(irKey, "/", "_") + checkpointSuffix
+}
+
+// contentVersion returns a version derived from the versions of the resources,
+// the same resources always get the same version.
+func contentVersion(versions map[string]string) string {
+	names := make([]string, 0, len(versions))
+	for name := range versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write([]byte(versions[name]))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// encodeCheckpoint returns the snapshot as compressed JSON.
+// It includes the secrets, such as the TLS private keys, so the checkpointers encrypt it
+// or store it in a Secret.
+func encodeCheckpoint(irKey string, snapshot *cachev3.Snapshot) ([]byte, error) {
+	cp := checkpoint{
+		IRKey:     irKey,
+		Resources: make(map[string]checkpointResources),
+	}
+	for i, resources := range snapshot.Resources {
+		typeURL, err := cachev3.GetResponseTypeURL(cachetypes.ResponseType(i))
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(resources.Items))
+		for name := range resources.Items {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		items := make([][]byte, 0, len(names))
+		for _, name := range names {
+			a, err := anypb.New(resources.Items[name].Resource)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal %s %s: %w", typeURL, name, err)
+			}
+			b, err := proto.MarshalOptions{Deterministic: true}.Marshal(a)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal %s %s: %w", typeURL, name, err)
+			}
+			items = append(items, b)
+		}
+		cp.Resources[typeURL] = checkpointResources{
+			Version: resources.Version,
+			Items:   items,
+		}
+	}
+
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err = zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err = zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeCheckpoint returns the IR key and the snapshot of the compressed JSON,
+// the resource types keep their persisted version.
+func decodeCheckpoint(data []byte) (string, *cachev3.Snapshot, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, err
+	}
+	data, err = io.ReadAll(zr)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var cp checkpoint
+	if err = json.Unmarshal(data, &cp); err != nil {
+		return "", nil, err
+	}
+	if cp.IRKey == "" {
+		return "", nil, errors.New("missing the IR key")
+	}
+
+	resources := make(map[resourcev3.Type][]cachetypes.Resource, len(cp.Resources))
+	for typeURL, items := range cp.Resources {
+		for _, item := range items.Items {
+			a := &anypb.Any{}
+			if err = proto.Unmarshal(item, a); err != nil {
+				return "", nil, fmt.Errorf("failed to unmarshal %s: %w", typeURL, err)
+			}
+			msg, err := a.UnmarshalNew()
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to unmarshal %s: %w", typeURL, err)
+			}
+			resources[typeURL] = append(resources[typeURL], msg)
+		}
+	}
+
+	snapshot, err := cachev3.NewSnapshot("", resources)
+	if err != nil {
+		return "", nil, err
+	}
+	for typeURL, items := range cp.Resources {
+		if i := cachev3.GetResponseType(typeURL); i != cachetypes.UnknownType {
+			snapshot.Resources[i].Version = items.Version
+		}
+	}
+	if err = snapshot.ConstructVersionMap(); err != nil {
+		return "", nil, err
+	}
+	return cp.IRKey, snapshot, nil
+}
+
+type fileCheckpointer struct {
+	dir  string
+	aead cipher.AEAD
+}
+
+// NewFileCheckpointer returns a Checkpointer that writes the snapshots to the directory,
+// one file per IR key, encrypted with AES-256-GCM. The key is base64 encoded.
+func NewFileCheckpointer(dir string, key []byte) (Checkpointer, error) {
+	rawKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(key)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	if len(rawKey) != 32 {
+		return nil, fmt.Errorf("invalid encryption key: got %d bytes, want 32", len(rawKey))
+	}
+	block, err := aes.NewCipher(rawKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &fileCheckpointer{dir: dir, aead: aead}, nil
+}
+
+// seal encrypts the data of the file, the file name is authenticated with it so that
+// the snapshot of an IR key is never loaded from the file of another one.
+func (f *fileCheckpointer) seal(fileName string, data []byte) ([]byte, error) {
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return f.aead.Seal(nonce, nonce, data, []byte(fileName)), nil
+}
+
+// open decrypts the data of the file.
+func (f *fileCheckpointer) open(fileName string, data []byte) ([]byte, error) {
+	if len(data) < f.aead.NonceSize() {
+		return nil, errors.New("truncated data")
+	}
+	nonce, ciphertext := data[:f.aead.NonceSize()], data[f.aead.NonceSize():]
+	return f.aead.Open(nil, nonce, ciphertext, []byte(fileName))
+}
+
+func (f *fileCheckpointer) Load(_ context.Context) (map[string]*cachev3.Snapshot, error) {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	snapshots := make(map[string]*cachev3.Snapshot)
+	var errs error
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), checkpointSuffix) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(f.dir, entry.Name()))
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if data, err = f.open(entry.Name(), data); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to decrypt %s: %w", entry.Name(), err))
+			continue
+		}
+		irKey, snapshot, err := decodeCheckpoint(data)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to decode %s: %w", entry.Name(), err))
+			continue
+		}
+		snapshots[irKey] = snapshot
+	}
+	return snapshots, errs
+}
+
+func (f *fileCheckpointer) Save(_ context.Context, irKey string, snapshot *cachev3.Snapshot) error {
+	data, err := encodeCheckpoint(irKey, snapshot)
+	if err != nil {
+		return err
+	}
+	fileName := checkpointKey(irKey)
+	if data, err = f.seal(fileName, data); err != nil {
+		return err
+	}
+	if err = os.MkdirAll(f.dir, 0o750); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that a crash never leaves a partial snapshot.
+	// The file is only readable by its owner.
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(f.dir, fileName))
+}
+
+func (f *fileCheckpointer) Delete(_ context.Context, irKey string) error {
+	err := os.Remove(filepath.Join(f.dir, checkpointKey(irKey)))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+type secretCheckpointer struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+}
+
+// NewSecretCheckpointer returns a Checkpointer that writes the snapshots to Secrets,
+// one Secret per IR key, so that each snapshot can use the whole size limit of a Secret.
+// The Secrets are named after the name and the IR key, and labeled with the name.
+func NewSecretCheckpointer(client kubernetes.Interface, namespace, name string) Checkpointer {
+	return &secretCheckpointer{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// secretName returns the name of the Secret of the IR key.
+func (c *secretCheckpointer) secretName(irKey string) string {
+	h := sha256.Sum256([]byte(irKey))
+	return c.name + "-" + hex.EncodeToString(h[:8])
+}
+
+func (c *secretCheckpointer) Load(ctx context.Context) (map[string]*cachev3.Snapshot, error) {
+	secrets, err := c.client.CoreV1().Secrets(c.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: checkpointLabel + "=" + c.name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make(map[string]*cachev3.Snapshot, len(secrets.Items))
+	var errs error
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		irKey, snapshot, err := decodeCheckpoint(secret.Data[checkpointSecretKey])
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to decode the Secret %s: %w", secret.Name, err))
+			continue
+		}
+		snapshots[irKey] = snapshot
+	}
+	return snapshots, errs
+}
+
+func (c *secretCheckpointer) Save(ctx context.Context, irKey string, snapshot *cachev3.Snapshot) error {
+	data, err := encodeCheckpoint(irKey, snapshot)
+	if err != nil {
+		return err
+	}
+	if len(data) > corev1.MaxSecretSize {
+		// The previous snapshot is deleted, so that it isn't served after a restart
+		// in place of the current one.
+		if err = c.Delete(ctx, irKey); err != nil {
+			return err
+		}
+		return fmt.Errorf("the compressed snapshot is %d bytes, over the %d bytes limit of a Secret, "+
+			"it isn't persisted, use the File type instead", len(data), corev1.MaxSecretSize)
+	}
+
+	name := c.secretName(irKey)
+	secrets := c.client.CoreV1().Secrets(c.namespace)
+	// The replicas of Envoy Gateway write the same Secrets, so the update is retried on
+	// conflicts, and skipped if the snapshot is unchanged.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := secrets.Get(ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: c.namespace,
+					Name:      name,
+					Labels:    map[string]string{checkpointLabel: c.name},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{checkpointSecretKey: data},
+			}
+			_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+			if kerrors.IsAlreadyExists(err) {
+				// Another replica created it, retry as an update.
+				return kerrors.NewConflict(corev1.Resource("secrets"), name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if bytes.Equal(secret.Data[checkpointSecretKey], data) {
+			return nil
+		}
+		secret.Data = map[string][]byte{checkpointSecretKey: data}
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (c *secretCheckpointer) Delete(ctx context.Context, irKey string) error {
+	err := c.client.CoreV1().Secrets(c.namespace).Delete(ctx, c.secretName(irKey), metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// asyncCheckpointer delays and coalesces the writes of the snapshots, and runs them in
+// the background, so that a slow storage, such as an overloaded API server, never delays
+// the snapshot updates.
+type asyncCheckpointer struct {
+	Checkpointer
+	log *zap.SugaredLogger
+	// pending maps the IR keys to their last snapshot, nil if the snapshot is deleted.
+	pending map[string]*cachev3.Snapshot
+	mu      sync.Mutex
+	// flushMu serializes the writes, so that a flush returns once the previous one is written.
+	flushMu sync.Mutex
+	notify  chan struct{}
+}
+
+// newAsyncCheckpointer returns a Checkpointer whose writes are run in the background
+// until ctx is done.
+func newAsyncCheckpointer(ctx context.Context, checkpointer Checkpointer, log *zap.SugaredLogger) *asyncCheckpointer {
+	a := &asyncCheckpointer{
+		Checkpointer: checkpointer,
+		log:          log,
+		pending:      make(map[string]*cachev3.Snapshot),
+		notify:       make(chan struct{}, 1),
+	}
+	go a.run(ctx)
+	return a
+}
+
+func (a *asyncCheckpointer) Save(_ context.Context, irKey string, snapshot *cachev3.Snapshot) error {
+	a.enqueue(irKey, snapshot)
+	return nil
+}
+
+func (a *asyncCheckpointer) Delete(_ context.Context, irKey string) error {
+	a.enqueue(irKey, nil)
+	return nil
+}
+
+func (a *asyncCheckpointer) enqueue(irKey string, snapshot *cachev3.Snapshot) {
+	a.mu.Lock()
+	a.pending[irKey] = snapshot
+	a.mu.Unlock()
+
+	select {
+	case a.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (a *asyncCheckpointer) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			// Write the last snapshots, without delaying the shutdown for too long.
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), checkpointFlushTimeout)
+			a.flush(flushCtx)
+			cancel()
+			return
+		case <-a.notify:
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(checkpointWriteInterval):
+			a.flush(ctx)
+		}
+	}
+}
+
+// flush writes the pending snapshots.
+func (a *asyncCheckpointer) flush(ctx context.Context) {
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+
+	a.mu.Lock()
+	pending := a.pending
+	a.pending = make(map[string]*cachev3.Snapshot)
+	a.mu.Unlock()
+
+	irKeys := make([]string, 0, len(pending))
+	for irKey := range pending {
+		irKeys = append(irKeys, irKey)
+	}
+	sort.Strings(irKeys)
+	for _, irKey := range irKeys {
+		if snapshot := pending[irKey]; snapshot != nil {
+			if err := a.Checkpointer.Save(ctx, irKey, snapshot); err != nil {
+				xdsSnapshotCheckpointErrorsTotal.Increment()
+				a.log.Errorf("Failed to persist the snapshot of %s: %v", irKey, err)
+			}
+		} else if err := a.Checkpointer.Delete(ctx, irKey); err != nil {
+			xdsSnapshotCheckpointErrorsTotal.Increment()
+			a.log.Errorf("Failed to delete the persisted snapshot of %s: %v", irKey, err)
+		}
+	}
+}
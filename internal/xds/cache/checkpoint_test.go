@@ -0,0 +1,265 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newCheckpointResources(listener, endpoint string) map[resourcev3.Type][]types.Resource {
+	return map[resourcev3.Type][]types.Resource{
+		resourcev3.ListenerType: {&listenerv3.Listener{Name: listener}},
+		resourcev3.ClusterType:  {&clusterv3.Cluster{Name: "cluster-1"}},
+		resourcev3.EndpointType: {&endpointv3.ClusterLoadAssignment{
+			ClusterName: "cluster-1",
+			Endpoints: []*endpointv3.LocalityLbEndpoints{{
+				LbEndpoints: []*endpointv3.LbEndpoint{{HostIdentifier: &endpointv3.LbEndpoint_Endpoint{
+					Endpoint: &endpointv3.Endpoint{Hostname: endpoint},
+				}}},
+			}},
+		}},
+	}
+}
+
+// newTestFileCheckpointer returns a file checkpointer of the directory, whose snapshots
+// are encrypted with a fixed key.
+func newTestFileCheckpointer(t *testing.T, dir string) Checkpointer {
+	t.Helper()
+	checkpointer, err := NewFileCheckpointer(dir, []byte(base64.StdEncoding.EncodeToString(make([]byte, 32))))
+	require.NoError(t, err)
+	return checkpointer
+}
+
+// flushCheckpoint writes the pending snapshots of the cache right away.
+func flushCheckpoint(t *testing.T, sc *snapshotCache) {
+	t.Helper()
+	sc.checkpointer.(*asyncCheckpointer).flush(t.Context())
+}
+
+// TestGenerateNewSnapshotContentVersions verifies that the resource types get the same
+// version for the same resources, regardless of the order of the updates.
+func TestGenerateNewSnapshotContentVersions(t *testing.T) {
+	const irKey = "envoy-gateway/gateway-1"
+
+	sc1 := newTestSnapshotCache(t)
+	require.NoError(t, sc1.SetCheckpointer(t.Context(), newTestFileCheckpointer(t, t.TempDir())))
+	require.NoError(t, sc1.GenerateNewSnapshot(irKey, newCheckpointResources("listener-1", "endpoint-1"), context.Background()))
+	first := sc1.lastSnapshot[irKey]
+	require.NoError(t, sc1.GenerateNewSnapshot(irKey, newCheckpointResources("listener-1", "endpoint-2"), context.Background()))
+	second := sc1.lastSnapshot[irKey]
+
+	// Only the endpoints changed.
+	require.Equal(t, first.GetVersion(resourcev3.ListenerType), second.GetVersion(resourcev3.ListenerType))
+	require.Equal(t, first.GetVersion(resourcev3.ClusterType), second.GetVersion(resourcev3.ClusterType))
+	require.NotEqual(t, first.GetVersion(resourcev3.EndpointType), second.GetVersion(resourcev3.EndpointType))
+
+	// Another cache gets the same versions for the same resources.
+	sc2 := newTestSnapshotCache(t)
+	require.NoError(t, sc2.SetCheckpointer(t.Context(), newTestFileCheckpointer(t, t.TempDir())))
+	require.NoError(t, sc2.GenerateNewSnapshot(irKey, newCheckpointResources("listener-1", "endpoint-2"), context.Background()))
+	for _, typeURL := range []string{resourcev3.ListenerType, resourcev3.ClusterType, resourcev3.EndpointType} {
+		require.Equal(t, second.GetVersion(typeURL), sc2.lastSnapshot[irKey].GetVersion(typeURL))
+	}
+}
+
+// TestFileCheckpointerWarmStart verifies that the persisted snapshots are served after a restart,
+// until they are translated again or expire.
+func TestFileCheckpointerWarmStart(t *testing.T) {
+	dir := t.TempDir()
+
+	sc := newTestSnapshotCache(t)
+	require.NoError(t, sc.SetCheckpointer(t.Context(), newTestFileCheckpointer(t, dir)))
+	require.NoError(t, sc.GenerateNewSnapshot("envoy-gateway/gateway-1", newCheckpointResources("listener-1", "endpoint-1"), context.Background()))
+	require.NoError(t, sc.GenerateNewSnapshot("envoy-gateway/gateway-2", newCheckpointResources("listener-2", "endpoint-2"), context.Background()))
+	require.NoError(t, sc.GenerateNewSnapshot("envoy-gateway/gateway-3", newCheckpointResources("listener-3", "endpoint-3"), context.Background()))
+	// A deleted snapshot is removed from the checkpoint.
+	require.NoError(t, sc.GenerateNewSnapshot("envoy-gateway/gateway-3", nil, context.Background()))
+	flushCheckpoint(t, sc)
+	_, err := os.Stat(filepath.Join(dir, "envoy-gateway_gateway-1.json.gz"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "envoy-gateway_gateway-3.json.gz"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// The snapshots are served right away after a restart.
+	restarted := newTestSnapshotCache(t)
+	require.NoError(t, restarted.SetCheckpointer(t.Context(), newTestFileCheckpointer(t, dir)))
+	require.ElementsMatch(t, []string{"envoy-gateway/gateway-1", "envoy-gateway/gateway-2"}, restarted.GetIrKeys())
+	require.Equal(t, sc.GetSnapshotVersions(), restarted.GetSnapshotVersions())
+	warm := restarted.lastSnapshot["envoy-gateway/gateway-1"]
+	for typeURL, resources := range sc.lastSnapshot["envoy-gateway/gateway-1"].VersionMap {
+		require.Len(t, warm.GetResources(typeURL), len(resources))
+		for name, resource := range sc.lastSnapshot["envoy-gateway/gateway-1"].GetResources(typeURL) {
+			require.True(t, proto.Equal(resource, warm.GetResources(typeURL)[name]), "%s %s", typeURL, name)
+		}
+	}
+
+	// The same resources translated again keep the persisted snapshot.
+	require.NoError(t, restarted.GenerateNewSnapshot("envoy-gateway/gateway-1", newCheckpointResources("listener-1", "endpoint-1"), context.Background()))
+	require.Same(t, warm, restarted.lastSnapshot["envoy-gateway/gateway-1"])
+
+	// The snapshots that haven't been translated again expire.
+	require.Equal(t, []string{"envoy-gateway/gateway-2"}, restarted.ExpireWarmSnapshots(context.Background()))
+	require.Equal(t, []string{"envoy-gateway/gateway-1"}, restarted.GetIrKeys())
+	flushCheckpoint(t, restarted)
+	_, err = os.Stat(filepath.Join(dir, "envoy-gateway_gateway-2.json.gz"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.Empty(t, restarted.ExpireWarmSnapshots(context.Background()))
+}
+
+// TestSecretCheckpointer verifies that the snapshots are persisted to Secrets, one per IR key.
+func TestSecretCheckpointer(t *testing.T) {
+	ctx := t.Context()
+	client := fake.NewClientset()
+	checkpointer := NewSecretCheckpointer(client, "envoy-gateway-system", "envoy-gateway-xds-snapshots")
+
+	// Nothing is loaded before the Secrets exist.
+	snapshots, err := checkpointer.Load(ctx)
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	sc := newTestSnapshotCache(t)
+	require.NoError(t, sc.SetCheckpointer(ctx, checkpointer))
+	require.NoError(t, sc.GenerateNewSnapshot("envoy-gateway/gateway-1", newCheckpointResources("listener-1", "endpoint-1"), ctx))
+	require.NoError(t, sc.GenerateNewSnapshot("envoy-gateway/gateway-2", newCheckpointResources("listener-2", "endpoint-2"), ctx))
+	flushCheckpoint(t, sc)
+
+	secrets, err := client.CoreV1().Secrets("envoy-gateway-system").List(ctx, metav1.ListOptions{
+		LabelSelector: checkpointLabel + "=envoy-gateway-xds-snapshots",
+	})
+	require.NoError(t, err)
+	require.Len(t, secrets.Items, 2)
+	for _, secret := range secrets.Items {
+		require.Contains(t, secret.Data, "snapshot.json.gz")
+	}
+
+	snapshots, err = checkpointer.Load(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, sc.lastSnapshot["envoy-gateway/gateway-2"].GetVersion(resourcev3.ListenerType),
+		snapshots["envoy-gateway/gateway-2"].GetVersion(resourcev3.ListenerType))
+
+	require.NoError(t, checkpointer.Delete(ctx, "envoy-gateway/gateway-1"))
+	// Deleting a missing snapshot is a no-op.
+	require.NoError(t, checkpointer.Delete(ctx, "envoy-gateway/gateway-3"))
+	snapshots, err = checkpointer.Load(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Contains(t, snapshots, "envoy-gateway/gateway-2")
+
+	// A snapshot over the size limit of a Secret isn't persisted, and the previous one
+	// is deleted so that it isn't served after a restart.
+	large := make([]byte, corev1.MaxSecretSize)
+	_, err = rand.Read(large)
+	require.NoError(t, err)
+	resources := newCheckpointResources("listener-2", "endpoint-2")
+	resources[resourcev3.SecretType] = []types.Resource{&tlsv3.Secret{
+		Name: "secret-1",
+		Type: &tlsv3.Secret_GenericSecret{GenericSecret: &tlsv3.GenericSecret{
+			Secret: &corev3.DataSource{Specifier: &corev3.DataSource_InlineBytes{InlineBytes: large}},
+		}},
+	}}
+	snapshot, err := cachev3.NewSnapshot("1", resources)
+	require.NoError(t, err)
+	require.ErrorContains(t, checkpointer.Save(ctx, "envoy-gateway/gateway-2", snapshot), "over the 1048576 bytes limit of a Secret")
+	snapshots, err = checkpointer.Load(ctx)
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+}
+
+// TestCheckpointSecrets verifies that the secrets are persisted encrypted, and are served
+// right away after a restart.
+func TestCheckpointSecrets(t *testing.T) {
+	const irKey = "envoy-gateway/gateway-1"
+	dir := t.TempDir()
+	resources := newCheckpointResources("listener-1", "endpoint-1")
+	resources[resourcev3.SecretType] = []types.Resource{&tlsv3.Secret{Name: "secret-1"}}
+
+	sc := newTestSnapshotCache(t)
+	require.NoError(t, sc.SetCheckpointer(t.Context(), newTestFileCheckpointer(t, dir)))
+	require.NoError(t, sc.GenerateNewSnapshot(irKey, resources, context.Background()))
+	flushCheckpoint(t, sc)
+
+	restarted := newTestSnapshotCache(t)
+	require.NoError(t, restarted.SetCheckpointer(t.Context(), newTestFileCheckpointer(t, dir)))
+	warm := restarted.lastSnapshot[irKey]
+	require.Len(t, warm.GetResources(resourcev3.ListenerType), 1)
+	require.Len(t, warm.GetResources(resourcev3.SecretType), 1)
+	require.Equal(t, sc.GetSnapshotVersions(), restarted.GetSnapshotVersions())
+
+	// The snapshots can't be read with another key.
+	otherKey := make([]byte, 32)
+	otherKey[0] = 1
+	other, err := NewFileCheckpointer(dir, []byte(base64.StdEncoding.EncodeToString(otherKey)))
+	require.NoError(t, err)
+	snapshots, err := other.Load(t.Context())
+	require.ErrorContains(t, err, "failed to decrypt")
+	require.Empty(t, snapshots)
+}
+
+// TestNewFileCheckpointer verifies the validation of the encryption key.
+func TestNewFileCheckpointer(t *testing.T) {
+	_, err := NewFileCheckpointer(t.TempDir(), []byte("not base64"))
+	require.Error(t, err)
+	_, err = NewFileCheckpointer(t.TempDir(), []byte(base64.StdEncoding.EncodeToString(make([]byte, 16))))
+	require.ErrorContains(t, err, "got 16 bytes, want 32")
+	// The key file may end with a newline.
+	_, err = NewFileCheckpointer(t.TempDir(), []byte(base64.StdEncoding.EncodeToString(make([]byte, 32))+"\n"))
+	require.NoError(t, err)
+}
+
+// TestAsyncCheckpointer verifies that the writes are run in the background, and that
+// the successive writes of an IR key are coalesced.
+func TestAsyncCheckpointer(t *testing.T) {
+	dir := t.TempDir()
+	snapshot, err := cachev3.NewSnapshot("1", newCheckpointResources("listener-1", "endpoint-1"))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	checkpointer := newAsyncCheckpointer(ctx, newTestFileCheckpointer(t, dir), zap.NewNop().Sugar())
+	require.NoError(t, checkpointer.Save(ctx, "envoy-gateway/gateway-1", snapshot))
+	require.NoError(t, checkpointer.Save(ctx, "envoy-gateway/gateway-2", snapshot))
+	require.NoError(t, checkpointer.Delete(ctx, "envoy-gateway/gateway-2"))
+
+	// Nothing is written until the write interval elapses.
+	_, err = os.Stat(filepath.Join(dir, "envoy-gateway_gateway-1.json.gz"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(filepath.Join(dir, "envoy-gateway_gateway-1.json.gz"))
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+	_, err = os.Stat(filepath.Join(dir, "envoy-gateway_gateway-2.json.gz"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// The pending writes are flushed on shutdown.
+	require.NoError(t, checkpointer.Save(ctx, "envoy-gateway/gateway-3", snapshot))
+	cancel()
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(filepath.Join(dir, "envoy-gateway_gateway-3.json.gz"))
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -29,6 +29,26 @@ var (
 		"Total number of xds updates rejected (NACKed) by Envoy, by node id and resource type.",
 	)
 
+	xdsNACKActive = metrics.NewGauge(
+		"xds_nack_active",
+		"Set to 1 while the last xds update is rejected (NACKed) by Envoy, by node id and resource type. The series is removed once a later update is accepted or the proxy disconnects.",
+	)
+
+	xdsSnapshotChangedResourcesTotal = metrics.NewCounter(
+		"xds_snapshot_changed_resources_total",
+		"Total number of xds resources added, changed or removed by the snapshot updates, by resource type.",
+	)
+
+	xdsPushResourcesTotal = metrics.NewCounter(
+		"xds_push_resources_total",
+		"Total number of xds resources pushed to Envoy, by resource type and stream type.",
+	)
+
+	xdsSnapshotCheckpointErrorsTotal = metrics.NewCounter(
+		"xds_snapshot_checkpoint_errors_total",
+		"Total number of xds snapshots that failed to be persisted or deleted by the snapshot checkpoint.",
+	)
+
 	nodeIDLabel        = metrics.NewLabel("nodeID")
 	streamIDLabel      = metrics.NewLabel("streamID")
 	isDeltaStreamLabel = metrics.NewLabel("isDeltaStream")
@@ -16,18 +16,20 @@ package cache
 import (
 	"context"
 	"fmt"
-	"math"
+	"maps"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	cachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	serverv3 "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	statusv3 "google.golang.org/genproto/googleapis/rpc/status"
 
 	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/internal/metrics"
@@ -54,6 +56,55 @@ type SnapshotCacheWithCallbacks interface {
 	GenerateNewSnapshot(string, types.XdsResources, context.Context) error
 	SnapshotHasIrKey(string) bool
 	GetIrKeys() []string
+	GetSnapshotVersions() map[string]string
+	GetNodeStatuses() []NodeStatus
+	GetRejections(string) []Rejection
+	SetRejectionHandler(RejectionHandler)
+	SetCheckpointer(context.Context, Checkpointer) error
+	ExpireWarmSnapshots(context.Context) []string
+}
+
+// RejectionHandler is notified when the xDS updates rejected by the proxies of an IR key
+// change, the current rejections can be retrieved with GetRejections.
+type RejectionHandler func(irKey string)
+
+// NodeStatus is the xDS status of a proxy connected to the xDS server.
+type NodeStatus struct {
+	// NodeID is the ID of the proxy node.
+	NodeID string `json:"nodeID"`
+	// IRKey is the key of the IR the proxy is configured from.
+	IRKey string `json:"irKey"`
+	// Streams is the number of the open xDS streams of the proxy.
+	Streams int `json:"streams"`
+	// SnapshotVersion is the version of the snapshot held for the proxy.
+	SnapshotVersion string `json:"snapshotVersion,omitempty"`
+	// ACKed maps the type URLs to the last version acknowledged by the proxy.
+	ACKed map[string]string `json:"acked,omitempty"`
+	// NACKed maps the type URLs to the last update rejected by the proxy.
+	// The entry is removed once the proxy acknowledges a later update.
+	NACKed map[string]RejectedUpdate `json:"nacked,omitempty"`
+}
+
+// RejectedUpdate is an xDS update rejected (NACKed) by a proxy.
+type RejectedUpdate struct {
+	// Version is the version of the rejected update, it's empty if the update was
+	// superseded by a later one when the proxy rejected it.
+	Version string `json:"version,omitempty"`
+	// Message is the error returned by the proxy.
+	Message string `json:"message"`
+}
+
+// Rejection is the last update of a type URL rejected by a proxy.
+type Rejection struct {
+	NodeID  string
+	TypeURL string
+	RejectedUpdate
+}
+
+// nodeAckState tracks the updates acknowledged and rejected by a node.
+type nodeAckState struct {
+	acked  map[string]string
+	nacked map[string]RejectedUpdate
 }
 
 type snapshotMap map[string]*cachev3.Snapshot
@@ -64,20 +115,42 @@ type nodeFrequencyMap map[string]int
 
 type streamDurationMap map[int64]time.Time
 
+type nodeAckStateMap map[string]*nodeAckState
+
+// sentResponse is the last response of a type URL sent on a stream.
+type sentResponse struct {
+	nonce   string
+	version string
+}
+
+type streamResponsesMap map[int64]map[string]sentResponse
+
 type snapshotCache struct {
 	cachev3.SnapshotCache
 	streamIDNodeInfo    nodeInfoMap
 	nodeFrequency       nodeFrequencyMap
 	streamDuration      streamDurationMap
 	deltaStreamDuration streamDurationMap
-	snapshotVersion     int64
+	nodeAckState        nodeAckStateMap
+	streamResponses     streamResponsesMap
 	lastSnapshot        snapshotMap
+	rejectionHandler    RejectionHandler
+	checkpointer        Checkpointer
+	warmSnapshots       map[string]struct{}
 	log                 *zap.SugaredLogger
 	mu                  sync.Mutex
 }
 
 // GenerateNewSnapshot takes a table of resources (the output from the IR->xDS
 // translator) and updates the snapshot version.
+//
+// The version of each resource is tracked, so that the update is skipped if no resource
+// changed, and the delta xDS streams only receive the changed resources. The version of
+// each resource type is derived from its resources, so that the state-of-the-world streams
+// only re-send the resource types that changed, and the proxies that reconnect after a
+// restart don't receive the same resources again.
+//
+// With a checkpointer, the new snapshot is persisted in the background.
 func (s *snapshotCache) GenerateNewSnapshot(irKey string, resources types.XdsResources, ctx context.Context) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -85,29 +158,47 @@ func (s *snapshotCache) GenerateNewSnapshot(irKey string, resources types.XdsRes
 	_, span := tracer.Start(ctx, "SnapshotCache.GenerateNewSnapshot")
 	defer span.End()
 
-	sc := trace.SpanContextFromContext(ctx)
-	version := sc.TraceID().String()
-	if !sc.IsValid() {
-		version = s.newSnapshotVersion()
-	}
+	// The persisted snapshot is replaced by the translated one.
+	delete(s.warmSnapshots, irKey)
 
-	// Create a snapshot with all xDS resources.
+	// Create a snapshot with all xDS resources, their versions are set below.
 	snapshot, err := cachev3.NewSnapshot(
-		version,
+		"",
 		resources,
 	)
 	if err != nil {
 		xdsSnapshotCreateTotal.WithFailure(metrics.ReasonError).Increment()
 		return err
 	}
-	xdsSnapshotCreateTotal.WithSuccess().Increment()
 
 	// Delete snapshot from cache if resources are nil
 	if resources == nil {
+		xdsSnapshotCreateTotal.WithSuccess().Increment()
 		delete(s.lastSnapshot, irKey)
+		if s.checkpointer != nil {
+			_ = s.checkpointer.Delete(ctx, irKey)
+		}
 	} else {
+		// Build the resource versions up front, the delta xDS streams use them to find
+		// the changed resources.
+		if err = snapshot.ConstructVersionMap(); err != nil {
+			xdsSnapshotCreateTotal.WithFailure(metrics.ReasonError).Increment()
+			return err
+		}
+		xdsSnapshotCreateTotal.WithSuccess().Increment()
+
+		changed := changedResourceTypes(s.lastSnapshot[irKey], snapshot)
+		if s.lastSnapshot[irKey] != nil && len(changed) == 0 {
+			s.log.Debugf("Skipping the snapshot of %s, no resource changed", irKey)
+			return nil
+		}
+		setContentVersions(snapshot)
+
 		// Update snapshot in cache
 		s.lastSnapshot[irKey] = snapshot
+		if s.checkpointer != nil {
+			_ = s.checkpointer.Save(ctx, irKey, snapshot)
+		}
 	}
 
 	for _, node := range s.getNodeIDs(irKey) {
@@ -124,17 +215,93 @@ func (s *snapshotCache) GenerateNewSnapshot(irKey string, resources types.XdsRes
 	return nil
 }
 
-// newSnapshotVersion increments the current snapshotVersion
-// and returns as a string.
-func (s *snapshotCache) newSnapshotVersion() string {
-	// Reset the snapshotVersion if it ever hits max size.
-	if s.snapshotVersion == math.MaxInt64 {
-		s.snapshotVersion = 0
+// changedResourceTypes compares the resource versions of the snapshots, records the number
+// of changed resources, and returns the type URLs with changed resources, sorted.
+// All the types of the new snapshot are changed if there is no previous snapshot.
+func changedResourceTypes(previous, snapshot *cachev3.Snapshot) []string {
+	var changed []string
+	for typeURL, versions := range snapshot.VersionMap {
+		var previousVersions map[string]string
+		if previous != nil {
+			previousVersions = previous.VersionMap[typeURL]
+		}
+		count := 0
+		for name, version := range versions {
+			if previousVersions[name] != version {
+				count++
+			}
+		}
+		for name := range previousVersions {
+			if _, found := versions[name]; !found {
+				count++
+			}
+		}
+		if count > 0 || (previous == nil && len(versions) > 0) {
+			changed = append(changed, typeURL)
+			xdsSnapshotChangedResourcesTotal.With(typeURLLabel.Value(typeURL)).Add(float64(count))
+		}
 	}
+	sort.Strings(changed)
+	return changed
+}
 
-	// Increment the snapshot version & return as string.
-	s.snapshotVersion++
-	return strconv.FormatInt(s.snapshotVersion, 10)
+// setContentVersions sets the version of each resource type of the snapshot to a hash of
+// its resources.
+func setContentVersions(snapshot *cachev3.Snapshot) {
+	for i := range snapshot.Resources {
+		typeURL, err := cachev3.GetResponseTypeURL(cachetypes.ResponseType(i))
+		if err != nil {
+			continue
+		}
+		snapshot.Resources[i].Version = contentVersion(snapshot.VersionMap[typeURL])
+	}
+}
+
+// SetCheckpointer persists the snapshots with the checkpointer, and serves the previously
+// persisted snapshots until their IR keys are translated again.
+// The snapshots are written in the background until ctx is done.
+// It must be called before the first snapshot is generated.
+func (s *snapshotCache) SetCheckpointer(ctx context.Context, checkpointer Checkpointer) error {
+	// The snapshots are loaded before taking the lock, since this may take a while.
+	snapshots, err := checkpointer.Load(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpointer = newAsyncCheckpointer(ctx, checkpointer, s.log)
+	for irKey, snapshot := range snapshots {
+		if _, found := s.lastSnapshot[irKey]; found {
+			continue
+		}
+		s.log.Infof("Serving the persisted snapshot of %s until it's translated", irKey)
+		s.lastSnapshot[irKey] = snapshot
+		s.warmSnapshots[irKey] = struct{}{}
+	}
+
+	// The snapshots that could be decoded are still served.
+	return err
+}
+
+// ExpireWarmSnapshots discards the persisted snapshots of the IR keys that haven't been
+// translated since startup, and returns these keys.
+// The proxies that are already connected keep their configuration.
+func (s *snapshotCache) ExpireWarmSnapshots(ctx context.Context) []string {
+	s.mu.Lock()
+	irKeys := make([]string, 0, len(s.warmSnapshots))
+	for irKey := range s.warmSnapshots {
+		delete(s.lastSnapshot, irKey)
+		irKeys = append(irKeys, irKey)
+	}
+	clear(s.warmSnapshots)
+	checkpointer := s.checkpointer
+	s.mu.Unlock()
+
+	sort.Strings(irKeys)
+	for _, irKey := range irKeys {
+		s.log.Infof("Discarding the persisted snapshot of %s, it hasn't been translated since startup", irKey)
+		_ = checkpointer.Delete(ctx, irKey)
+	}
+	return irKeys
 }
 
 // NewSnapshotCache gives you a fresh SnapshotCache.
@@ -151,6 +318,9 @@ func NewSnapshotCache(ads bool, logger logging.Logger) SnapshotCacheWithCallback
 		nodeFrequency:       make(nodeFrequencyMap),
 		streamDuration:      make(streamDurationMap),
 		deltaStreamDuration: make(streamDurationMap),
+		nodeAckState:        make(nodeAckStateMap),
+		streamResponses:     make(streamResponsesMap),
+		warmSnapshots:       make(map[string]struct{}),
 	}
 }
 
@@ -180,7 +350,12 @@ func (s *snapshotCache) OnStreamOpen(_ context.Context, streamID int64, _ string
 }
 
 func (s *snapshotCache) OnStreamClosed(streamID int64, node *corev3.Node) {
-	// TODO: something with the node?
+	var notify func()
+	defer func() {
+		if notify != nil {
+			notify()
+		}
+	}()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -195,10 +370,14 @@ func (s *snapshotCache) OnStreamClosed(streamID int64, node *corev3.Node) {
 
 	delete(s.streamIDNodeInfo, streamID)
 	delete(s.streamDuration, streamID)
+	delete(s.streamResponses, streamID)
 
 	s.nodeFrequency[node.Id] -= 1
 	if s.nodeFrequency[node.Id] <= 0 {
 		delete(s.nodeFrequency, node.Id)
+		if s.clearNodeAckState(node.Id) {
+			notify = s.rejectionNotifier(node.Cluster)
+		}
 
 		// Only snapshots for nodes with active connections are updated, we need to clear
 		// the snapshot for this node so it doesn't get stale data when it reconnects.
@@ -207,6 +386,13 @@ func (s *snapshotCache) OnStreamClosed(streamID int64, node *corev3.Node) {
 }
 
 func (s *snapshotCache) OnStreamRequest(streamID int64, req *discoveryv3.DiscoveryRequest) error {
+	// The rejection handler is called once the lock is released.
+	var notify func()
+	defer func() {
+		if notify != nil {
+			notify()
+		}
+	}()
 	s.mu.Lock()
 	// We could do this a little earlier than the defer, since the last half of this func is only logging
 	// but that seemed like a premature optimization.
@@ -231,6 +417,17 @@ func (s *snapshotCache) OnStreamRequest(streamID int64, req *discoveryv3.Discove
 	var errorCode int32
 	var errorMessage string
 
+	// A request with a response nonce either acknowledges or rejects the last response.
+	// A rejection carries the last acknowledged version, the rejected version is the
+	// version of the response with the nonce.
+	version := req.VersionInfo
+	if req.ErrorDetail != nil {
+		version = s.responseVersion(streamID, req.GetTypeUrl(), req.ResponseNonce)
+	}
+	if req.ResponseNonce != "" && s.recordAck(nodeID, req.GetTypeUrl(), version, req.ErrorDetail) {
+		notify = s.rejectionNotifier(cluster)
+	}
+
 	// If no snapshot has been generated yet, we can't do anything, so don't mess with this request.
 	// go-control-plane will respond with an empty response, then send an update when a snapshot is generated.
 	if s.lastSnapshot[cluster] == nil {
@@ -276,9 +473,16 @@ func (s *snapshotCache) OnStreamRequest(streamID int64, req *discoveryv3.Discove
 	return nil
 }
 
-func (s *snapshotCache) OnStreamResponse(_ context.Context, streamID int64, _ *discoveryv3.DiscoveryRequest, _ *discoveryv3.DiscoveryResponse) {
+func (s *snapshotCache) OnStreamResponse(_ context.Context, streamID int64, _ *discoveryv3.DiscoveryRequest, resp *discoveryv3.DiscoveryResponse) {
+	if resp != nil {
+		recordPush(resp.GetTypeUrl(), false, len(resp.GetResources()))
+	}
+
 	s.mu.Lock()
 	node := s.streamIDNodeInfo[streamID]
+	if resp != nil {
+		s.recordResponse(streamID, resp.GetTypeUrl(), resp.GetNonce(), resp.GetVersionInfo())
+	}
 	s.mu.Unlock()
 	if node == nil {
 		s.log.Errorf("Tried to send a response to a node we haven't seen yet on stream %d", streamID)
@@ -302,7 +506,12 @@ func (s *snapshotCache) OnDeltaStreamOpen(_ context.Context, streamID int64, _ s
 }
 
 func (s *snapshotCache) OnDeltaStreamClosed(streamID int64, node *corev3.Node) {
-	// TODO: something with the node?
+	var notify func()
+	defer func() {
+		if notify != nil {
+			notify()
+		}
+	}()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -317,10 +526,14 @@ func (s *snapshotCache) OnDeltaStreamClosed(streamID int64, node *corev3.Node) {
 
 	delete(s.streamIDNodeInfo, streamID)
 	delete(s.deltaStreamDuration, streamID)
+	delete(s.streamResponses, streamID)
 
 	s.nodeFrequency[node.Id] -= 1
 	if s.nodeFrequency[node.Id] <= 0 {
 		delete(s.nodeFrequency, node.Id)
+		if s.clearNodeAckState(node.Id) {
+			notify = s.rejectionNotifier(node.Cluster)
+		}
 
 		// Only snapshots for nodes with active connections are updated, we need to clear
 		// the snapshot for this node so it doesn't get stale data when it reconnects.
@@ -329,6 +542,13 @@ func (s *snapshotCache) OnDeltaStreamClosed(streamID int64, node *corev3.Node) {
 }
 
 func (s *snapshotCache) OnStreamDeltaRequest(streamID int64, req *discoveryv3.DeltaDiscoveryRequest) error {
+	// The rejection handler is called once the lock is released.
+	var notify func()
+	defer func() {
+		if notify != nil {
+			notify()
+		}
+	}()
 	s.mu.Lock()
 	// We could do this a little earlier than with a defer, since the last half of this func is logging
 	// but that seemed like a premature optimization.
@@ -353,6 +573,13 @@ func (s *snapshotCache) OnStreamDeltaRequest(streamID int64, req *discoveryv3.De
 	nodeID := s.streamIDNodeInfo[streamID].Id
 	cluster := s.streamIDNodeInfo[streamID].Cluster
 
+	// The incremental requests don't carry the version, the acknowledged or rejected
+	// version is the version of the response with the nonce.
+	version := s.responseVersion(streamID, req.GetTypeUrl(), req.ResponseNonce)
+	if req.ResponseNonce != "" && s.recordAck(nodeID, req.GetTypeUrl(), version, req.ErrorDetail) {
+		notify = s.rejectionNotifier(cluster)
+	}
+
 	// If no snapshot has been written into the snapshotCache yet, we can't do anything, so don't mess with
 	// this request. go-control-plane will respond with an empty response, then send an update when a
 	// snapshot is generated.
@@ -400,9 +627,16 @@ func (s *snapshotCache) OnStreamDeltaRequest(streamID int64, req *discoveryv3.De
 	return nil
 }
 
-func (s *snapshotCache) OnStreamDeltaResponse(streamID int64, _ *discoveryv3.DeltaDiscoveryRequest, _ *discoveryv3.DeltaDiscoveryResponse) {
+func (s *snapshotCache) OnStreamDeltaResponse(streamID int64, _ *discoveryv3.DeltaDiscoveryRequest, resp *discoveryv3.DeltaDiscoveryResponse) {
+	if resp != nil {
+		recordPush(resp.GetTypeUrl(), true, len(resp.GetResources())+len(resp.GetRemovedResources()))
+	}
+
 	s.mu.Lock()
 	node := s.streamIDNodeInfo[streamID]
+	if resp != nil {
+		s.recordResponse(streamID, resp.GetTypeUrl(), resp.GetNonce(), resp.GetSystemVersionInfo())
+	}
 	s.mu.Unlock()
 	if node == nil {
 		s.log.Errorf("Tried to send a response to a node we haven't seen yet on stream %d", streamID)
@@ -411,6 +645,14 @@ func (s *snapshotCache) OnStreamDeltaResponse(streamID int64, _ *discoveryv3.Del
 	}
 }
 
+// recordPush records the number of resources of a response sent to a proxy.
+func recordPush(typeURL string, isDelta bool, resources int) {
+	xdsPushResourcesTotal.With(
+		typeURLLabel.Value(typeURL),
+		isDeltaStreamLabel.Value(strconv.FormatBool(isDelta)),
+	).Add(float64(resources))
+}
+
 func (s *snapshotCache) OnFetchRequest(_ context.Context, _ *discoveryv3.DiscoveryRequest) error {
 	return nil
 }
@@ -442,3 +684,209 @@ func (s *snapshotCache) GetIrKeys() []string {
 
 	return irKeys
 }
+
+// GetSnapshotVersions returns the version of the last snapshot of each IR key.
+func (s *snapshotCache) GetSnapshotVersions() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	versions := make(map[string]string, len(s.lastSnapshot))
+	for key, snapshot := range s.lastSnapshot {
+		if snapshot != nil {
+			versions[key] = snapshotVersion(snapshot)
+		}
+	}
+
+	return versions
+}
+
+// GetNodeStatuses returns the status of the nodes with open xDS streams, sorted by node ID.
+func (s *snapshotCache) GetNodeStatuses() []NodeStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make(map[string]*NodeStatus)
+	for _, node := range s.streamIDNodeInfo {
+		if node == nil {
+			continue
+		}
+		if status, ok := statuses[node.Id]; ok {
+			status.Streams++
+			continue
+		}
+		status := &NodeStatus{
+			NodeID:  node.Id,
+			IRKey:   node.Cluster,
+			Streams: 1,
+		}
+		if snapshot, err := s.GetSnapshot(node.Id); err == nil {
+			if snapshot, ok := snapshot.(*cachev3.Snapshot); ok {
+				status.SnapshotVersion = snapshotVersion(snapshot)
+			}
+		}
+		if state := s.nodeAckState[node.Id]; state != nil {
+			status.ACKed = maps.Clone(state.acked)
+			status.NACKed = maps.Clone(state.nacked)
+		}
+		statuses[node.Id] = status
+	}
+
+	result := make([]NodeStatus, 0, len(statuses))
+	for _, status := range statuses {
+		result = append(result, *status)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].NodeID < result[j].NodeID
+	})
+
+	return result
+}
+
+// snapshotVersion returns the version of the snapshot, which is derived from the versions of
+// its resource types with resources, so it changes whenever any of them changes.
+func snapshotVersion(snapshot *cachev3.Snapshot) string {
+	versions := make(map[string]string, len(snapshot.Resources))
+	for i := range snapshot.Resources {
+		if len(snapshot.Resources[i].Items) == 0 {
+			continue
+		}
+		if typeURL, err := cachev3.GetResponseTypeURL(cachetypes.ResponseType(i)); err == nil {
+			versions[typeURL] = snapshot.Resources[i].Version
+		}
+	}
+	return contentVersion(versions)
+}
+
+// GetRejections returns the last updates rejected by the nodes of the IR key that
+// haven't been superseded by an acknowledged update, sorted by node ID and type URL.
+func (s *snapshotCache) GetRejections(irKey string) []Rejection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rejections(irKey)
+}
+
+// SetRejectionHandler sets the handler notified when the rejected updates of an IR key change.
+func (s *snapshotCache) SetRejectionHandler(handler RejectionHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rejectionHandler = handler
+}
+
+// rejections must be called with the lock held.
+func (s *snapshotCache) rejections(irKey string) []Rejection {
+	var rejections []Rejection
+	seen := make(map[string]bool)
+	for _, node := range s.streamIDNodeInfo {
+		if node == nil || node.Cluster != irKey || seen[node.Id] {
+			continue
+		}
+		seen[node.Id] = true
+		state := s.nodeAckState[node.Id]
+		if state == nil {
+			continue
+		}
+		for typeURL, update := range state.nacked {
+			rejections = append(rejections, Rejection{
+				NodeID:         node.Id,
+				TypeURL:        typeURL,
+				RejectedUpdate: update,
+			})
+		}
+	}
+	sort.Slice(rejections, func(i, j int) bool {
+		if rejections[i].NodeID != rejections[j].NodeID {
+			return rejections[i].NodeID < rejections[j].NodeID
+		}
+		return rejections[i].TypeURL < rejections[j].TypeURL
+	})
+
+	return rejections
+}
+
+// rejectionNotifier returns the function notifying the rejection handler of a change
+// of the rejected updates of the IR key. It must be called with the lock held, while
+// the returned function must be called without it.
+func (s *snapshotCache) rejectionNotifier(irKey string) func() {
+	handler := s.rejectionHandler
+	if handler == nil {
+		return nil
+	}
+	return func() {
+		handler(irKey)
+	}
+}
+
+// recordResponse records the last response of the type URL sent on the stream.
+// It must be called with the lock held.
+func (s *snapshotCache) recordResponse(streamID int64, typeURL, nonce, version string) {
+	responses := s.streamResponses[streamID]
+	if responses == nil {
+		responses = make(map[string]sentResponse)
+		s.streamResponses[streamID] = responses
+	}
+	responses[typeURL] = sentResponse{nonce: nonce, version: version}
+}
+
+// responseVersion returns the version of the response of the type URL sent on the stream
+// with the nonce, or an empty string if it's not the last response of the type URL.
+// It must be called with the lock held.
+func (s *snapshotCache) responseVersion(streamID int64, typeURL, nonce string) string {
+	if response, ok := s.streamResponses[streamID][typeURL]; ok && response.nonce == nonce {
+		return response.version
+	}
+	return ""
+}
+
+// recordAck records whether the node acknowledged or rejected the update of the type URL with
+// the version, and returns whether the rejected updates of the node changed.
+// An empty version is an update superseded by a later response, it doesn't change the
+// acknowledged version. It must be called with the lock held.
+func (s *snapshotCache) recordAck(nodeID, typeURL, version string, errorDetail *statusv3.Status) bool {
+	state := s.nodeAckState[nodeID]
+	if state == nil {
+		state = &nodeAckState{
+			acked:  make(map[string]string),
+			nacked: make(map[string]RejectedUpdate),
+		}
+		s.nodeAckState[nodeID] = state
+	}
+
+	if errorDetail != nil {
+		rejected := RejectedUpdate{
+			Version: version,
+			Message: errorDetail.Message,
+		}
+		previous, found := state.nacked[typeURL]
+		state.nacked[typeURL] = rejected
+		xdsNACKActive.With(nodeIDLabel.Value(nodeID), typeURLLabel.Value(typeURL)).Record(1)
+		return !found || previous != rejected
+	}
+
+	if version != "" {
+		state.acked[typeURL] = version
+	}
+	if _, found := state.nacked[typeURL]; !found {
+		return false
+	}
+	delete(state.nacked, typeURL)
+	xdsNACKActive.With(nodeIDLabel.Value(nodeID), typeURLLabel.Value(typeURL)).Delete()
+	return true
+}
+
+// clearNodeAckState drops the state of a disconnected node, and returns whether the node
+// had rejected updates. It must be called with the lock held.
+func (s *snapshotCache) clearNodeAckState(nodeID string) bool {
+	state := s.nodeAckState[nodeID]
+	delete(s.nodeAckState, nodeID)
+	if state == nil || len(state.nacked) == 0 {
+		return false
+	}
+	// Delete the series of the node, so that the number of series doesn't grow with the
+	// proxies that come and go.
+	for typeURL := range state.nacked {
+		xdsNACKActive.With(nodeIDLabel.Value(nodeID), typeURLLabel.Value(typeURL)).Delete()
+	}
+	return true
+}
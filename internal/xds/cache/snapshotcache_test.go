@@ -11,8 +11,12 @@ import (
 	"sync"
 	"testing"
 
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/stretchr/testify/require"
@@ -124,3 +128,158 @@ func TestOnStreamDeltaResponseConcurrentAccess(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+// TestGetNodeStatuses verifies that the ACKs and NACKs of the connected nodes are tracked.
+func TestGetNodeStatuses(t *testing.T) {
+	sc := newTestSnapshotCache(t)
+	snap, err := cachev3.NewSnapshot("1", map[resourcev3.Type][]types.Resource{resourcev3.ListenerType: nil, resourcev3.ClusterType: nil})
+	require.NoError(t, err)
+	sc.lastSnapshot["gateway-1"] = snap
+
+	var notified []string
+	sc.SetRejectionHandler(func(irKey string) {
+		notified = append(notified, irKey)
+	})
+
+	node := &corev3.Node{Id: "envoy-1", Cluster: "gateway-1"}
+	require.NoError(t, sc.OnStreamOpen(context.Background(), 1, ""))
+	require.NoError(t, sc.OnStreamOpen(context.Background(), 2, ""))
+	require.NoError(t, sc.OnStreamRequest(1, &discoveryv3.DiscoveryRequest{Node: node, TypeUrl: resourcev3.ListenerType}))
+	require.NoError(t, sc.OnStreamRequest(2, &discoveryv3.DiscoveryRequest{Node: node, TypeUrl: resourcev3.ClusterType}))
+
+	// ACK of the listeners and NACK of the clusters. The NACK carries the last accepted
+	// version, the rejected version is the version of the response with the nonce.
+	sc.OnStreamResponse(context.Background(), 2, nil, &discoveryv3.DiscoveryResponse{
+		TypeUrl:     resourcev3.ClusterType,
+		VersionInfo: "2",
+		Nonce:       "b",
+	})
+	require.NoError(t, sc.OnStreamRequest(1, &discoveryv3.DiscoveryRequest{
+		Node:          node,
+		TypeUrl:       resourcev3.ListenerType,
+		VersionInfo:   "1",
+		ResponseNonce: "a",
+	}))
+	require.NoError(t, sc.OnStreamRequest(2, &discoveryv3.DiscoveryRequest{
+		Node:          node,
+		TypeUrl:       resourcev3.ClusterType,
+		VersionInfo:   "1",
+		ResponseNonce: "b",
+		ErrorDetail:   &statusv3.Status{Code: 13, Message: "invalid cluster"},
+	}))
+
+	require.Equal(t, map[string]string{"gateway-1": snapshotVersion(snap)}, sc.GetSnapshotVersions())
+	require.Equal(t, []NodeStatus{{
+		NodeID:          "envoy-1",
+		IRKey:           "gateway-1",
+		Streams:         2,
+		SnapshotVersion: snapshotVersion(snap),
+		ACKed:           map[string]string{resourcev3.ListenerType: "1"},
+		NACKed:          map[string]RejectedUpdate{resourcev3.ClusterType: {Version: "2", Message: "invalid cluster"}},
+	}}, sc.GetNodeStatuses())
+	require.Equal(t, []Rejection{{
+		NodeID:         "envoy-1",
+		TypeURL:        resourcev3.ClusterType,
+		RejectedUpdate: RejectedUpdate{Version: "2", Message: "invalid cluster"},
+	}}, sc.GetRejections("gateway-1"))
+	require.Empty(t, sc.GetRejections("gateway-2"))
+	require.Equal(t, []string{"gateway-1"}, notified)
+
+	// The same rejection again doesn't notify the handler.
+	require.NoError(t, sc.OnStreamRequest(2, &discoveryv3.DiscoveryRequest{
+		Node:          node,
+		TypeUrl:       resourcev3.ClusterType,
+		ResponseNonce: "b",
+		ErrorDetail:   &statusv3.Status{Code: 13, Message: "invalid cluster"},
+	}))
+	require.Equal(t, []string{"gateway-1"}, notified)
+
+	// A later ACK clears the NACK.
+	require.NoError(t, sc.OnStreamRequest(2, &discoveryv3.DiscoveryRequest{
+		Node:          node,
+		TypeUrl:       resourcev3.ClusterType,
+		VersionInfo:   "1",
+		ResponseNonce: "c",
+	}))
+	statuses := sc.GetNodeStatuses()
+	require.Len(t, statuses, 1)
+	require.Equal(t, map[string]string{resourcev3.ListenerType: "1", resourcev3.ClusterType: "1"}, statuses[0].ACKed)
+	require.Empty(t, statuses[0].NACKed)
+	require.Empty(t, sc.GetRejections("gateway-1"))
+	require.Equal(t, []string{"gateway-1", "gateway-1"}, notified)
+
+	// The node is removed once all its streams are closed.
+	sc.OnStreamClosed(1, node)
+	sc.OnStreamClosed(2, node)
+	require.Empty(t, sc.GetNodeStatuses())
+}
+
+// TestGenerateNewSnapshotVersions verifies that the version of each resource type of a snapshot
+// is derived from its resources, so it's only updated when they change.
+func TestGenerateNewSnapshotVersions(t *testing.T) {
+	const irKey = "gateway-1"
+
+	newResources := func(port uint32, endpoint string) map[resourcev3.Type][]types.Resource {
+		return map[resourcev3.Type][]types.Resource{
+			resourcev3.ListenerType: {&listenerv3.Listener{Name: "listener-1", Address: &corev3.Address{
+				Address: &corev3.Address_SocketAddress{SocketAddress: &corev3.SocketAddress{
+					Address:       "0.0.0.0",
+					PortSpecifier: &corev3.SocketAddress_PortValue{PortValue: port},
+				}},
+			}}},
+			resourcev3.ClusterType: {&clusterv3.Cluster{Name: "cluster-1"}},
+			resourcev3.EndpointType: {&endpointv3.ClusterLoadAssignment{
+				ClusterName: "cluster-1",
+				Endpoints: []*endpointv3.LocalityLbEndpoints{{
+					LbEndpoints: []*endpointv3.LbEndpoint{{HostIdentifier: &endpointv3.LbEndpoint_Endpoint{
+						Endpoint: &endpointv3.Endpoint{Hostname: endpoint},
+					}}},
+				}},
+			}},
+		}
+	}
+	versions := func(sc *snapshotCache) map[string]string {
+		snapshot := sc.lastSnapshot[irKey]
+		return map[string]string{
+			resourcev3.ListenerType: snapshot.GetVersion(resourcev3.ListenerType),
+			resourcev3.ClusterType:  snapshot.GetVersion(resourcev3.ClusterType),
+			resourcev3.EndpointType: snapshot.GetVersion(resourcev3.EndpointType),
+		}
+	}
+
+	sc := newTestSnapshotCache(t)
+	require.NoError(t, sc.GenerateNewSnapshot(irKey, newResources(10080, "endpoint-1"), context.Background()))
+	initial := versions(sc)
+	for typeURL, version := range initial {
+		require.Equal(t, contentVersion(sc.lastSnapshot[irKey].GetVersionMap(typeURL)), version)
+	}
+	initialSnapshotVersion := sc.GetSnapshotVersions()[irKey]
+
+	// An update without changes is skipped.
+	previous := sc.lastSnapshot[irKey]
+	require.NoError(t, sc.GenerateNewSnapshot(irKey, newResources(10080, "endpoint-1"), context.Background()))
+	require.Same(t, previous, sc.lastSnapshot[irKey])
+
+	// An update of the endpoints only updates the endpoints.
+	require.NoError(t, sc.GenerateNewSnapshot(irKey, newResources(10080, "endpoint-2"), context.Background()))
+	updated := versions(sc)
+	require.Equal(t, initial[resourcev3.ListenerType], updated[resourcev3.ListenerType])
+	require.Equal(t, initial[resourcev3.ClusterType], updated[resourcev3.ClusterType])
+	require.NotEqual(t, initial[resourcev3.EndpointType], updated[resourcev3.EndpointType])
+	require.NotEqual(t, initialSnapshotVersion, sc.GetSnapshotVersions()[irKey])
+
+	// An update of the listeners only updates the listeners.
+	require.NoError(t, sc.GenerateNewSnapshot(irKey, newResources(10443, "endpoint-2"), context.Background()))
+	require.NotEqual(t, updated[resourcev3.ListenerType], versions(sc)[resourcev3.ListenerType])
+	require.Equal(t, updated[resourcev3.ClusterType], versions(sc)[resourcev3.ClusterType])
+	require.Equal(t, updated[resourcev3.EndpointType], versions(sc)[resourcev3.EndpointType])
+
+	// The same resources get the same versions again.
+	require.NoError(t, sc.GenerateNewSnapshot(irKey, newResources(10080, "endpoint-1"), context.Background()))
+	require.Equal(t, initial, versions(sc))
+	require.Equal(t, initialSnapshotVersion, sc.GetSnapshotVersions()[irKey])
+
+	// The snapshot is deleted when the resources are nil.
+	require.NoError(t, sc.GenerateNewSnapshot(irKey, nil, context.Background()))
+	require.False(t, sc.SnapshotHasIrKey(irKey))
+}
@@ -8,11 +8,15 @@ package runner
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net"
+	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/service/cluster/v3"
@@ -37,6 +41,7 @@ import (
 	extension "github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/infrastructure/host"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/ratelimit"
+	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/message"
 	"github.com/envoyproxy/gateway/internal/xds/bootstrap"
 	"github.com/envoyproxy/gateway/internal/xds/cache"
@@ -64,6 +69,12 @@ const (
 	defaultKubernetesIssuer = "https://kubernetes.default.svc.cluster.local"
 
 	defaultMaxConnectionAgeGrace = 2 * time.Minute
+
+	// defaultSnapshotCheckpointSecret is the default prefix of the names of the Secrets the xDS snapshots are persisted to.
+	defaultSnapshotCheckpointSecret = "envoy-gateway-xds-snapshots"
+	// defaultWarmStartTimeout is the default time the persisted xDS snapshots are served
+	// for the IR keys that haven't been translated since startup.
+	defaultWarmStartTimeout = 5 * time.Minute
 )
 
 var tracer = otel.Tracer("envoy-gateway/xds")
@@ -90,6 +101,23 @@ type Config struct {
 
 type Runner struct {
 	Config
+
+	// cacheMu guards the assignment of cache in Start against the status accessors,
+	// which are called by the admin server and can run before the runner starts.
+	cacheMu sync.RWMutex
+
+	// patchedResourcesMu guards patchedResources.
+	patchedResourcesMu sync.Mutex
+	// patchedResources maps the IR keys to the resources patched by the EnvoyPatchPolicies,
+	// it's used to find the policies that generated a resource rejected by the proxies.
+	patchedResources map[string][]patchedResource
+}
+
+// patchedResource is an xDS resource patched by an EnvoyPatchPolicy.
+type patchedResource struct {
+	policy  ktypes.NamespacedName
+	typeURL string
+	name    string
 }
 
 func New(cfg *Config) *Runner {
@@ -147,10 +175,44 @@ func getRandomMaxConnectionAge() time.Duration {
 // Close implements Runner interface.
 func (r *Runner) Close() error { return nil }
 
+// snapshotCache returns the snapshot cache, or nil if the runner hasn't started.
+func (r *Runner) snapshotCache() cache.SnapshotCacheWithCallbacks {
+	r.cacheMu.RLock()
+	defer r.cacheMu.RUnlock()
+	return r.cache
+}
+
+// GetSnapshotVersions returns the version of the last snapshot of each IR key.
+// It returns an empty map until the runner starts.
+func (r *Runner) GetSnapshotVersions() map[string]string {
+	c := r.snapshotCache()
+	if c == nil {
+		return map[string]string{}
+	}
+	return c.GetSnapshotVersions()
+}
+
+// GetNodeStatuses returns the xDS status of the connected proxies.
+// It returns an empty list until the runner starts.
+func (r *Runner) GetNodeStatuses() []cache.NodeStatus {
+	c := r.snapshotCache()
+	if c == nil {
+		return []cache.NodeStatus{}
+	}
+	return c.GetNodeStatuses()
+}
+
 // Start starts the xds-server runner
 func (r *Runner) Start(ctx context.Context) error {
 	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
-	r.cache = cache.NewSnapshotCache(true, r.Logger)
+	snapshotCache := cache.NewSnapshotCache(true, r.Logger)
+	snapshotCache.SetRejectionHandler(r.publishRejections)
+	r.cacheMu.Lock()
+	r.cache = snapshotCache
+	r.cacheMu.Unlock()
+	if err := r.setupSnapshotCheckpoint(ctx); err != nil {
+		return err
+	}
 
 	// Set up the gRPC server and register the xDS handler.
 	// Create SnapshotCache before start subscribeAndTranslate,
@@ -230,6 +292,70 @@ func (r *Runner) Start(ctx context.Context) error {
 	return err
 }
 
+// setupSnapshotCheckpoint persists the xDS snapshots, and serves the previously persisted ones
+// until their IR keys are translated again, if the snapshot checkpoint is enabled.
+func (r *Runner) setupSnapshotCheckpoint(ctx context.Context) error {
+	if r.EnvoyGateway.XDSServer == nil || r.EnvoyGateway.XDSServer.SnapshotCheckpoint == nil {
+		return nil
+	}
+	cfg := r.EnvoyGateway.XDSServer.SnapshotCheckpoint
+
+	var checkpointer cache.Checkpointer
+	switch cfg.Type {
+	case egv1a1.FileXDSSnapshotCheckpointType:
+		if cfg.File == nil {
+			return fmt.Errorf("xdsServer.snapshotCheckpoint.file must be set for the File type")
+		}
+		key, err := os.ReadFile(cfg.File.EncryptionKeyPath)
+		if err != nil {
+			return fmt.Errorf("failed to read the xDS snapshot checkpoint encryption key: %w", err)
+		}
+		if checkpointer, err = cache.NewFileCheckpointer(cfg.File.Path, key); err != nil {
+			return fmt.Errorf("invalid xdsServer.snapshotCheckpoint.file: %w", err)
+		}
+	case egv1a1.SecretXDSSnapshotCheckpointType:
+		if !r.EnvoyGateway.Provider.IsRunningOnKubernetes() {
+			return fmt.Errorf("the Secret xDS snapshot checkpoint requires the Kubernetes provider")
+		}
+		clientset, err := kubejwt.GetKubernetesClient()
+		if err != nil {
+			return fmt.Errorf("failed to create Kubernetes client: %w", err)
+		}
+		name := defaultSnapshotCheckpointSecret
+		if cfg.Secret != nil && cfg.Secret.Name != nil {
+			name = *cfg.Secret.Name
+		}
+		checkpointer = cache.NewSecretCheckpointer(clientset, r.ControllerNamespace, name)
+	default:
+		return fmt.Errorf("unsupported xdsServer.snapshotCheckpoint.type %q", cfg.Type)
+	}
+
+	warmStartTimeout := defaultWarmStartTimeout
+	if cfg.WarmStartTimeout != nil {
+		d, err := time.ParseDuration(string(*cfg.WarmStartTimeout))
+		if err != nil {
+			return fmt.Errorf("invalid xdsServer.snapshotCheckpoint.warmStartTimeout: %w", err)
+		}
+		warmStartTimeout = d
+	}
+
+	// A corrupted checkpoint shouldn't prevent the xDS server from starting,
+	// the snapshots are generated again once the resources are translated.
+	if err := r.cache.SetCheckpointer(ctx, checkpointer); err != nil {
+		r.Logger.Error(err, "failed to load the persisted xDS snapshots")
+	}
+	r.Logger.Info("enabled the xDS snapshot checkpoint", "type", cfg.Type, "warmStartTimeout", warmStartTimeout)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-time.After(warmStartTimeout):
+			r.cache.ExpireWarmSnapshots(ctx)
+		}
+	}()
+	return nil
+}
+
 func (r *Runner) serveXdsServer(ctx context.Context) {
 	addr := net.JoinHostPort(XdsServerAddress, strconv.Itoa(bootstrap.DefaultXdsServerPort))
 	l, err := net.Listen("tcp", addr)
@@ -294,11 +420,14 @@ func (r *Runner) translateFromSubscription(sub <-chan watchable.Snapshot[string,
 			)
 
 			if update.Delete {
+				r.setPatchedResources(key, nil)
 				if err := r.cache.GenerateNewSnapshot(key, nil, traceCtx); err != nil {
 					traceLogger.Error(err, "failed to delete the snapshot")
 					errChan <- err
 				}
 			} else {
+				r.setPatchedResources(key, val.XdsIR.EnvoyPatchPolicies)
+
 				// Translate to xds resources
 				t := &translator.Translator{
 					ControllerNamespace: r.ControllerNamespace,
@@ -318,6 +447,9 @@ func (r *Runner) translateFromSubscription(sub <-chan watchable.Snapshot[string,
 						ServiceURL: ratelimit.GetServiceURL(r.ControllerNamespace, r.DNSDomain),
 						FailClosed: r.EnvoyGateway.RateLimit.FailClosed,
 					}
+					if r.EnvoyGateway.Provider.IsRunningOnHost() {
+						t.GlobalRateLimit.ServiceURL = host.GetRateLimitServiceURL()
+					}
 					if r.EnvoyGateway.RateLimit.Timeout != nil {
 						d, err := time.ParseDuration(string(*r.EnvoyGateway.RateLimit.Timeout))
 						if err != nil {
@@ -329,6 +461,23 @@ func (r *Runner) translateFromSubscription(sub <-chan watchable.Snapshot[string,
 					}
 				}
 
+				// Set the rate limit quota service URL if quota rate limiting is enabled.
+				if r.EnvoyGateway.RateLimitQuota != nil {
+					t.RateLimitQuota = &translator.RateLimitQuotaSettings{
+						ServiceURL: r.EnvoyGateway.RateLimitQuota.URL,
+						TLSEnabled: r.EnvoyGateway.RateLimitQuota.TLS != nil,
+					}
+					if r.EnvoyGateway.RateLimitQuota.Timeout != nil {
+						d, err := time.ParseDuration(string(*r.EnvoyGateway.RateLimitQuota.Timeout))
+						if err != nil {
+							traceLogger.Error(err, "invalid rateLimitQuota timeout")
+							errChan <- err
+						} else {
+							t.RateLimitQuota.Timeout = d
+						}
+					}
+				}
+
 				_, translateSpan := tracer.Start(traceCtx, "Translator.Translate")
 				result, err := t.Translate(val.XdsIR)
 				translateSpan.End()
@@ -399,6 +548,152 @@ func (r *Runner) translateFromSubscription(sub <-chan watchable.Snapshot[string,
 	r.Logger.Info("subscriber shutting down")
 }
 
+// setPatchedResources records the resources patched by the EnvoyPatchPolicies of the IR key.
+func (r *Runner) setPatchedResources(irKey string, policies []*ir.EnvoyPatchPolicy) {
+	var resources []patchedResource
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		nn := ktypes.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}
+		for _, patch := range policy.JSONPatches {
+			if patch == nil {
+				continue
+			}
+			resources = append(resources, patchedResource{policy: nn, typeURL: patch.Type, name: patch.Name})
+		}
+	}
+
+	r.patchedResourcesMu.Lock()
+	defer r.patchedResourcesMu.Unlock()
+	if len(resources) == 0 {
+		delete(r.patchedResources, irKey)
+		return
+	}
+	if r.patchedResources == nil {
+		r.patchedResources = make(map[string][]patchedResource)
+	}
+	r.patchedResources[irKey] = resources
+}
+
+// PatchPolicyRejectionError is an xDS update rejected by the proxies, attributed to
+// an EnvoyPatchPolicy that patched a resource of the rejected type.
+type PatchPolicyRejectionError struct {
+	// Policy is the EnvoyPatchPolicy that patched the rejected resource.
+	Policy ktypes.NamespacedName
+	// TypeURL is the type URL of the rejected resource.
+	TypeURL string
+	// Resource is the name of the patched resource named in the rejection, or empty
+	// if the rejection doesn't name any of the patched resources.
+	Resource string
+}
+
+func (e *PatchPolicyRejectionError) Error() string {
+	if e.Resource == "" {
+		return fmt.Sprintf("EnvoyPatchPolicy %s patched a rejected resource of type %s", e.Policy, e.TypeURL)
+	}
+	return fmt.Sprintf("EnvoyPatchPolicy %s patched the rejected resource %s of type %s", e.Policy, e.Resource, e.TypeURL)
+}
+
+// rejectingPolicies returns a PatchPolicyRejectionError for each EnvoyPatchPolicy that likely
+// generated the rejected update, joined in a single error, or nil if there is none.
+// The policies that patched a resource of the rejected type named in the error message are
+// preferred, otherwise all the policies that patched a resource of the rejected type are returned.
+func (r *Runner) rejectingPolicies(irKey, typeURL, message string) error {
+	r.patchedResourcesMu.Lock()
+	defer r.patchedResourcesMu.Unlock()
+
+	var named, typed []error
+	seen := map[ktypes.NamespacedName]bool{}
+	seenNamed := map[ktypes.NamespacedName]bool{}
+	for _, res := range r.patchedResources[irKey] {
+		if res.typeURL != typeURL {
+			continue
+		}
+		if !seen[res.policy] {
+			seen[res.policy] = true
+			typed = append(typed, &PatchPolicyRejectionError{Policy: res.policy, TypeURL: typeURL})
+		}
+		if res.name != "" && !seenNamed[res.policy] && containsResourceName(message, res.name) {
+			seenNamed[res.policy] = true
+			named = append(named, &PatchPolicyRejectionError{Policy: res.policy, TypeURL: typeURL, Resource: res.name})
+		}
+	}
+	if len(named) > 0 {
+		return errors.Join(named...)
+	}
+	return errors.Join(typed...)
+}
+
+// containsResourceName returns true if the message names the resource, that is, the name
+// appears in the message and isn't part of a longer name.
+func containsResourceName(message, name string) bool {
+	for i := 0; ; {
+		j := strings.Index(message[i:], name)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(name)
+		if (start == 0 || !isResourceNameByte(message[start-1])) &&
+			(end == len(message) || !isResourceNameByte(message[end])) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+func isResourceNameByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9' ||
+		b == '/' || b == '-' || b == '_' || b == '.'
+}
+
+// rejectionPolicies returns the EnvoyPatchPolicies of the PatchPolicyRejectionErrors in err.
+func rejectionPolicies(err error) []ktypes.NamespacedName {
+	if err == nil {
+		return nil
+	}
+	var policies []ktypes.NamespacedName
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			policies = append(policies, rejectionPolicies(e)...)
+		}
+		return policies
+	}
+	var rejectionErr *PatchPolicyRejectionError
+	if errors.As(err, &rejectionErr) {
+		policies = append(policies, rejectionErr.Policy)
+	}
+	return policies
+}
+
+// publishRejections publishes the xDS updates of the IR key currently rejected by the proxies,
+// so they can be reported in the status of the Gateways.
+func (r *Runner) publishRejections(irKey string) {
+	if r.cache == nil || r.ProviderResources == nil {
+		return
+	}
+
+	rejections := r.cache.GetRejections(irKey)
+	if len(rejections) == 0 {
+		r.ProviderResources.XdsRejections.Delete(irKey)
+		return
+	}
+
+	value := &message.XdsRejections{
+		Rejections: make([]message.XdsRejection, 0, len(rejections)),
+	}
+	for _, rejection := range rejections {
+		value.Rejections = append(value.Rejections, message.XdsRejection{
+			NodeID:             rejection.NodeID,
+			TypeURL:            rejection.TypeURL,
+			Version:            rejection.Version,
+			Message:            rejection.Message,
+			EnvoyPatchPolicies: rejectionPolicies(r.rejectingPolicies(irKey, rejection.TypeURL, rejection.Message)),
+		})
+	}
+	r.ProviderResources.XdsRejections.Store(irKey, value)
+}
+
 func (r *Runner) loadTLSConfig() (*tls.Config, error) {
 	var certPath, keyPath, caPath string
 
@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -16,26 +17,36 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	cachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tsaarni/certyaml"
 	"go.opentelemetry.io/otel/trace"
+	statusv3 "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/crypto"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/internal/message"
 	"github.com/envoyproxy/gateway/internal/xds/bootstrap"
+	"github.com/envoyproxy/gateway/internal/xds/cache"
 )
 
 func newTestTraceContext() context.Context {
@@ -276,9 +287,22 @@ func TestRunner(t *testing.T) {
 	ctx, cancel := context.WithCancel(newTestTraceContext())
 	defer cancel()
 
+	// The status accessors return empty results before the runner starts,
+	// and can be called concurrently with Start.
+	require.Empty(t, r.GetSnapshotVersions())
+	require.Empty(t, r.GetNodeStatuses())
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		for range 100 {
+			r.GetSnapshotVersions()
+			r.GetNodeStatuses()
+		}
+	})
+
 	// Start
 	err = r.Start(ctx)
 	require.NoError(t, err)
+	wg.Wait()
 	defer func() {
 		cancel()
 		time.Sleep(100 * time.Millisecond) // Allow graceful shutdown
@@ -751,3 +775,182 @@ func TestLoadTLSConfig_HostMode(t *testing.T) {
 	require.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
 	require.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
 }
+
+func TestPublishRejections(t *testing.T) {
+	pResources := new(message.ProviderResources)
+	defer pResources.Close()
+	r := New(&Config{
+		Server: config.Server{
+			Logger: logging.DefaultLogger(os.Stdout, egv1a1.LogLevelInfo),
+		},
+		ProviderResources: pResources,
+	})
+	r.cache = cache.NewSnapshotCache(true, r.Logger)
+	r.cache.SetRejectionHandler(r.publishRejections)
+
+	r.setPatchedResources("default/eg", []*ir.EnvoyPatchPolicy{
+		{
+			EnvoyPatchPolicyStatus: ir.EnvoyPatchPolicyStatus{Namespace: "default", Name: "patch-listener"},
+			JSONPatches: []*ir.JSONPatchConfig{
+				{Type: string(egv1a1.ListenerEnvoyResourceType), Name: "default/eg/http"},
+			},
+		},
+		{
+			EnvoyPatchPolicyStatus: ir.EnvoyPatchPolicyStatus{Namespace: "default", Name: "patch-other-listener"},
+			JSONPatches: []*ir.JSONPatchConfig{
+				{Type: string(egv1a1.ListenerEnvoyResourceType), Name: "default/eg/https"},
+			},
+		},
+		{
+			EnvoyPatchPolicyStatus: ir.EnvoyPatchPolicyStatus{Namespace: "default", Name: "patch-cluster"},
+			JSONPatches: []*ir.JSONPatchConfig{
+				{Type: string(egv1a1.ClusterEnvoyResourceType), Name: "httproute/default/backend/rule/0"},
+			},
+		},
+	})
+
+	node := &corev3.Node{Id: "envoy-1", Cluster: "default/eg"}
+	require.NoError(t, r.cache.OnStreamOpen(context.Background(), 1, ""))
+	require.NoError(t, r.cache.OnStreamRequest(1, &discoveryv3.DiscoveryRequest{Node: node, TypeUrl: string(egv1a1.ListenerEnvoyResourceType)}))
+	require.NoError(t, r.cache.OnStreamRequest(1, &discoveryv3.DiscoveryRequest{
+		Node:          node,
+		TypeUrl:       string(egv1a1.ListenerEnvoyResourceType),
+		ResponseNonce: "a",
+		ErrorDetail:   &statusv3.Status{Code: 13, Message: "Error adding/updating listener(s) default/eg/http: invalid filter"},
+	}))
+
+	rejections, ok := pResources.XdsRejections.Load("default/eg")
+	require.True(t, ok)
+	require.Equal(t, []message.XdsRejection{{
+		NodeID:             "envoy-1",
+		TypeURL:            string(egv1a1.ListenerEnvoyResourceType),
+		Message:            "Error adding/updating listener(s) default/eg/http: invalid filter",
+		EnvoyPatchPolicies: []ktypes.NamespacedName{{Namespace: "default", Name: "patch-listener"}},
+	}}, rejections.Rejections)
+
+	// The rejection is cleared once the proxy accepts an update.
+	require.NoError(t, r.cache.OnStreamRequest(1, &discoveryv3.DiscoveryRequest{
+		Node:          node,
+		TypeUrl:       string(egv1a1.ListenerEnvoyResourceType),
+		VersionInfo:   "2",
+		ResponseNonce: "b",
+	}))
+	_, ok = pResources.XdsRejections.Load("default/eg")
+	require.False(t, ok)
+}
+
+func TestRejectingPolicies(t *testing.T) {
+	r := New(&Config{})
+	r.setPatchedResources("default/eg", []*ir.EnvoyPatchPolicy{
+		{
+			EnvoyPatchPolicyStatus: ir.EnvoyPatchPolicyStatus{Namespace: "default", Name: "a"},
+			JSONPatches: []*ir.JSONPatchConfig{
+				{Type: string(egv1a1.ClusterEnvoyResourceType), Name: "cluster-a"},
+			},
+		},
+		{
+			EnvoyPatchPolicyStatus: ir.EnvoyPatchPolicyStatus{Namespace: "default", Name: "b"},
+			JSONPatches: []*ir.JSONPatchConfig{
+				{Type: string(egv1a1.ClusterEnvoyResourceType), Name: "cluster-b"},
+			},
+		},
+	})
+
+	clusterType := string(egv1a1.ClusterEnvoyResourceType)
+	err := r.rejectingPolicies("default/eg", clusterType, "Error adding/updating cluster(s) cluster-b: invalid cluster")
+	var rejectionErr *PatchPolicyRejectionError
+	require.ErrorAs(t, err, &rejectionErr)
+	require.Equal(t, &PatchPolicyRejectionError{
+		Policy:   ktypes.NamespacedName{Namespace: "default", Name: "b"},
+		TypeURL:  clusterType,
+		Resource: "cluster-b",
+	}, rejectionErr)
+	require.Equal(t, []ktypes.NamespacedName{{Namespace: "default", Name: "b"}}, rejectionPolicies(err))
+
+	// A name that is part of a longer name doesn't match, so all the policies
+	// patching the type are returned.
+	err = r.rejectingPolicies("default/eg", clusterType, "Error adding/updating cluster(s) cluster-bc: invalid cluster")
+	require.Equal(t, []ktypes.NamespacedName{{Namespace: "default", Name: "a"}, {Namespace: "default", Name: "b"}},
+		rejectionPolicies(err))
+	// Without a resource name in the error, all the policies patching the type are returned.
+	require.Equal(t, []ktypes.NamespacedName{{Namespace: "default", Name: "a"}, {Namespace: "default", Name: "b"}},
+		rejectionPolicies(r.rejectingPolicies("default/eg", clusterType, "invalid cluster")))
+	require.NoError(t, r.rejectingPolicies("default/eg", string(egv1a1.ListenerEnvoyResourceType), "invalid listener"))
+	require.NoError(t, r.rejectingPolicies("default/other", clusterType, "invalid cluster cluster-b"))
+
+	r.setPatchedResources("default/eg", nil)
+	require.NoError(t, r.rejectingPolicies("default/eg", clusterType, "invalid cluster cluster-b"))
+}
+
+func TestSetupSnapshotCheckpoint(t *testing.T) {
+	newRunner := func(checkpoint *egv1a1.XDSSnapshotCheckpoint) *Runner {
+		r := New(&Config{
+			Server: config.Server{
+				EnvoyGateway: &egv1a1.EnvoyGateway{
+					EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+						Provider:  egv1a1.DefaultEnvoyGatewayProvider(),
+						XDSServer: &egv1a1.XDSServer{SnapshotCheckpoint: checkpoint},
+					},
+				},
+				Logger: logging.DefaultLogger(os.Stdout, egv1a1.LogLevelInfo),
+			},
+		})
+		r.cache = cache.NewSnapshotCache(true, r.Logger)
+		return r
+	}
+
+	t.Run("file", func(t *testing.T) {
+		dir := t.TempDir()
+		keyPath := filepath.Join(t.TempDir(), "key")
+		require.NoError(t, os.WriteFile(keyPath, []byte(base64.StdEncoding.EncodeToString(make([]byte, 32))), 0o600))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		r := newRunner(&egv1a1.XDSSnapshotCheckpoint{
+			Type: egv1a1.FileXDSSnapshotCheckpointType,
+			File: &egv1a1.XDSSnapshotCheckpointFile{Path: dir, EncryptionKeyPath: keyPath},
+		})
+		require.NoError(t, r.setupSnapshotCheckpoint(ctx))
+		require.NoError(t, r.cache.GenerateNewSnapshot("envoy-gateway/eg", map[string][]cachetypes.Resource{
+			resourcev3.ListenerType: {&listenerv3.Listener{Name: "envoy-gateway/eg/http"}},
+		}, ctx))
+		versions := r.GetSnapshotVersions()
+		// The snapshot is written in the background.
+		require.Eventually(t, func() bool {
+			_, err := os.Stat(filepath.Join(dir, "envoy-gateway_eg.json.gz"))
+			return err == nil
+		}, 5*time.Second, 50*time.Millisecond)
+
+		// The snapshot is served after a restart, and expires if it isn't translated again.
+		timeout := gwapiv1.Duration("100ms")
+		restarted := newRunner(&egv1a1.XDSSnapshotCheckpoint{
+			Type:             egv1a1.FileXDSSnapshotCheckpointType,
+			File:             &egv1a1.XDSSnapshotCheckpointFile{Path: dir, EncryptionKeyPath: keyPath},
+			WarmStartTimeout: &timeout,
+		})
+		require.NoError(t, restarted.setupSnapshotCheckpoint(ctx))
+		require.Equal(t, versions, restarted.GetSnapshotVersions())
+		require.Eventually(t, func() bool {
+			return len(restarted.GetSnapshotVersions()) == 0
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("file without encryption key", func(t *testing.T) {
+		r := newRunner(&egv1a1.XDSSnapshotCheckpoint{
+			Type: egv1a1.FileXDSSnapshotCheckpointType,
+			File: &egv1a1.XDSSnapshotCheckpointFile{
+				Path:              t.TempDir(),
+				EncryptionKeyPath: filepath.Join(t.TempDir(), "missing"),
+			},
+		})
+		require.Error(t, r.setupSnapshotCheckpoint(context.Background()))
+	})
+
+	t.Run("secret requires Kubernetes", func(t *testing.T) {
+		r := newRunner(&egv1a1.XDSSnapshotCheckpoint{
+			Type: egv1a1.SecretXDSSnapshotCheckpointType,
+		})
+		r.EnvoyGateway.Provider = &egv1a1.EnvoyGatewayProvider{Type: egv1a1.ProviderTypeCustom}
+		require.Error(t, r.setupSnapshotCheckpoint(context.Background()))
+	})
+}
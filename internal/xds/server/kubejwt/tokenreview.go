@@ -34,35 +34,46 @@ func GetKubernetesClient() (*kubernetes.Clientset, error) {
 	return clientset, nil
 }
 
-func (i *JWTAuthInterceptor) validateKubeJWT(ctx context.Context, token, nodeID string) error {
+// ReviewToken verifies the token with the Kubernetes TokenReview API and returns
+// the review status. An error is only returned if the API call fails, the caller
+// is responsible for checking the authentication result in the status.
+func ReviewToken(ctx context.Context, client kubernetes.Interface, token string, audiences []string) (*authenticationv1.TokenReviewStatus, error) {
 	tokenReview := &authenticationv1.TokenReview{
 		Spec: authenticationv1.TokenReviewSpec{
 			Token:     token,
-			Audiences: []string{i.audience},
+			Audiences: audiences,
 		},
 	}
 
-	tokenReview, err := i.clientset.AuthenticationV1().TokenReviews().Create(ctx, tokenReview, metav1.CreateOptions{})
+	tokenReview, err := client.AuthenticationV1().TokenReviews().Create(ctx, tokenReview, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &tokenReview.Status, nil
+}
+
+func (i *JWTAuthInterceptor) validateKubeJWT(ctx context.Context, token, nodeID string) error {
+	reviewStatus, err := ReviewToken(ctx, i.clientset, token, []string{i.audience})
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to call TokenReview API to verify service account JWT: %v", err)
 	}
 
-	if tokenReview.Status.Error != "" {
-		return status.Errorf(codes.Unauthenticated, "token review found error: %s", tokenReview.Status.Error)
+	if reviewStatus.Error != "" {
+		return status.Errorf(codes.Unauthenticated, "token review found error: %s", reviewStatus.Error)
 	}
 
-	if !slices.Contains(tokenReview.Status.User.Groups, "system:serviceaccounts") {
+	if !slices.Contains(reviewStatus.User.Groups, "system:serviceaccounts") {
 		return status.Error(codes.Unauthenticated, "the token is not a service account")
 	}
 
-	if !tokenReview.Status.Authenticated {
+	if !reviewStatus.Authenticated {
 		return status.Error(codes.Unauthenticated, "token is not authenticated")
 	}
 
 	// Check if the node ID in the request matches the pod name in the token review response.
 	// This is used to prevent a client from accessing the xDS resource of another one.
-	if tokenReview.Status.User.Extra != nil {
-		podName := tokenReview.Status.User.Extra[serviceaccount.PodNameKey]
+	if reviewStatus.User.Extra != nil {
+		podName := reviewStatus.User.Extra[serviceaccount.PodNameKey]
 		if podName[0] == "" {
 			return status.Error(codes.Unauthenticated, "pod name not found in token review response")
 		}
@@ -0,0 +1,181 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	adaptiveconcurrencyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/proto"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	// defaultConcurrencyUpdateInterval is the default period of time over which the request
+	// latencies are sampled before the concurrency limit is recalculated, Envoy requires it.
+	defaultConcurrencyUpdateInterval = 100 * time.Millisecond
+	// defaultMinRTTInterval is the default time between two measurements of the minRTT,
+	// Envoy requires it when the minRTT is measured dynamically.
+	defaultMinRTTInterval = 60 * time.Second
+)
+
+func init() {
+	registerHTTPFilter(&adaptiveConcurrency{})
+}
+
+type adaptiveConcurrency struct{}
+
+var _ httpFilter = &adaptiveConcurrency{}
+
+// patchHCM builds and appends the adaptive concurrency Filters to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: Envoy's adaptive_concurrency filter doesn't support per-route configuration, and the
+// concurrency limit is computed per filter instance. This method creates a filter for each route
+// so that each route gets its own concurrency limit, the filter is disabled by default and
+// enabled on the route level.
+func (*adaptiveConcurrency) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	var errs error
+	for _, route := range irListener.Routes {
+		if !routeContainsAdaptiveConcurrency(route) {
+			continue
+		}
+		if hcmContainsFilter(mgr, adaptiveConcurrencyFilterName(route)) {
+			continue
+		}
+		filter, err := buildHCMAdaptiveConcurrencyFilter(route)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// buildHCMAdaptiveConcurrencyFilter returns an adaptive concurrency HTTP filter for the route.
+func buildHCMAdaptiveConcurrencyFilter(route *ir.HTTPRoute) (*hcmv3.HttpFilter, error) {
+	config, err := buildAdaptiveConcurrencyConfig(route.Traffic.AdaptiveConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	configAny, err := proto.ToAnyWithValidation(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     adaptiveConcurrencyFilterName(route),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: configAny,
+		},
+	}, nil
+}
+
+// buildAdaptiveConcurrencyConfig builds the gradient controller configuration from the IR.
+func buildAdaptiveConcurrencyConfig(ac *ir.AdaptiveConcurrency) (*adaptiveconcurrencyv3.AdaptiveConcurrency, error) {
+	if ac == nil {
+		return nil, errors.New("adaptiveConcurrency cannot be nil")
+	}
+
+	limitParams := &adaptiveconcurrencyv3.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+		ConcurrencyUpdateInterval: durationpb.New(defaultConcurrencyUpdateInterval),
+	}
+	if ac.ConcurrencyUpdateInterval != nil {
+		limitParams.ConcurrencyUpdateInterval = durationpb.New(ac.ConcurrencyUpdateInterval.Duration)
+	}
+	if ac.MaxConcurrencyLimit != nil {
+		limitParams.MaxConcurrencyLimit = wrapperspb.UInt32(*ac.MaxConcurrencyLimit)
+	}
+
+	minRTTParams := &adaptiveconcurrencyv3.GradientControllerConfig_MinimumRTTCalculationParams{
+		Interval: durationpb.New(defaultMinRTTInterval),
+	}
+	if ac.MinRTTInterval != nil {
+		minRTTParams.Interval = durationpb.New(ac.MinRTTInterval.Duration)
+	}
+	if ac.MinRTTRequestCount != nil {
+		minRTTParams.RequestCount = wrapperspb.UInt32(*ac.MinRTTRequestCount)
+	}
+	if ac.MinRTTJitterPercent != nil {
+		minRTTParams.Jitter = &typev3.Percent{Value: float64(*ac.MinRTTJitterPercent)}
+	}
+	if ac.MinConcurrencyLimit != nil {
+		minRTTParams.MinConcurrency = wrapperspb.UInt32(*ac.MinConcurrencyLimit)
+	}
+	if ac.MinRTTBufferPercent != nil {
+		minRTTParams.Buffer = &typev3.Percent{Value: float64(*ac.MinRTTBufferPercent)}
+	}
+
+	gradient := &adaptiveconcurrencyv3.GradientControllerConfig{
+		ConcurrencyLimitParams: limitParams,
+		MinRttCalcParams:       minRTTParams,
+	}
+	if ac.SampleAggregatePercentile != nil {
+		gradient.SampleAggregatePercentile = &typev3.Percent{Value: float64(*ac.SampleAggregatePercentile)}
+	}
+
+	return &adaptiveconcurrencyv3.AdaptiveConcurrency{
+		ConcurrencyControllerConfig: &adaptiveconcurrencyv3.AdaptiveConcurrency_GradientControllerConfig{
+			GradientControllerConfig: gradient,
+		},
+		// The filter is enabled whenever the policy is configured.
+		Enabled: &corev3.RuntimeFeatureFlag{
+			DefaultValue: wrapperspb.Bool(true),
+		},
+	}, nil
+}
+
+func adaptiveConcurrencyFilterName(route *ir.HTTPRoute) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterAdaptiveConcurrency, route.Name)
+}
+
+// routeContainsAdaptiveConcurrency returns true if adaptive concurrency exists for the provided route.
+func routeContainsAdaptiveConcurrency(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.Traffic != nil && irRoute.Traffic.AdaptiveConcurrency != nil
+}
+
+func (*adaptiveConcurrency) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route so the adaptive concurrency filter of the route is
+// enabled if applicable.
+func (*adaptiveConcurrency) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute, _ *ir.HTTPListener) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsAdaptiveConcurrency(irRoute) {
+		return nil
+	}
+
+	return enableFilterOnRoute(route, adaptiveConcurrencyFilterName(irRoute), &routev3.FilterConfig{
+		Config: &anypb.Any{},
+	})
+}
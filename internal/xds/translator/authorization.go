@@ -8,6 +8,7 @@ package translator
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -21,6 +22,7 @@ import (
 	rbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	networkinput "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	sslinputv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/ssl/v3"
 	ipmatcherv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/ip/v3"
 	metadatav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/metadata/v3"
 	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
@@ -199,6 +201,12 @@ func buildRBACPerRoute(authorization *ir.Authorization) (*rbacv3.RBACPerRoute, e
 			// Predicates for GeoIP metadata.
 			geoIPPredicate *matcherv3.Matcher_MatcherList_Predicate
 
+			// Predicate for client IP tags.
+			ipTagsPredicate *matcherv3.Matcher_MatcherList_Predicate
+
+			// Predicate for the client certificate identity.
+			clientCertPredicate *matcherv3.Matcher_MatcherList_Predicate
+
 			// Predicates for IP ranges.
 			ipPredicate *matcherv3.Matcher_MatcherList_Predicate
 
@@ -272,6 +280,18 @@ func buildRBACPerRoute(authorization *ir.Authorization) (*rbacv3.RBACPerRoute, e
 			}
 		}
 
+		if len(rule.Principal.ClientIPTags) > 0 {
+			if ipTagsPredicate, err = buildIPTagsPredicate(rule.Principal.ClientIPTags); err != nil {
+				return nil, err
+			}
+		}
+
+		if rule.Principal.ClientCertificate != nil {
+			if clientCertPredicate, err = buildClientCertificatePredicate(rule.Principal.ClientCertificate); err != nil {
+				return nil, err
+			}
+		}
+
 		if rule.CEL != nil {
 			if celPredicate, err = buildCELPredicate(*rule.CEL); err != nil {
 				return nil, err
@@ -292,6 +312,12 @@ func buildRBACPerRoute(authorization *ir.Authorization) (*rbacv3.RBACPerRoute, e
 		if geoIPPredicate != nil {
 			allPredicates = append(allPredicates, geoIPPredicate)
 		}
+		if ipTagsPredicate != nil {
+			allPredicates = append(allPredicates, ipTagsPredicate)
+		}
+		if clientCertPredicate != nil {
+			allPredicates = append(allPredicates, clientCertPredicate)
+		}
 		if celPredicate != nil {
 			allPredicates = append(allPredicates, celPredicate)
 		}
@@ -864,6 +890,139 @@ func buildHeaderPredicate(name string, values []string, ignoreCase bool) ([]*mat
 	return predicates, nil
 }
 
+// buildIPTagsPredicate matches the requests whose client IP is tagged with any of the
+// given tags. The tags are added to the request header by the ip_tagging filter as a
+// comma-separated list.
+func buildIPTagsPredicate(tags []string) (*matcherv3.Matcher_MatcherList_Predicate, error) {
+	headerMatchInput, err := proto.ToAnyWithValidation(&envoymatcherv3.HttpRequestHeaderMatchInput{
+		HeaderName: ir.IPTagsHeader,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buildHTTPHeaderSinglePredicate(headerMatchInput, &matcherv3.StringMatcher{
+		MatchPattern: &matcherv3.StringMatcher_SafeRegex{
+			SafeRegex: &matcherv3.RegexMatcher{
+				Regex:      regex.ListElementRegex(tags),
+				EngineType: &matcherv3.RegexMatcher_GoogleRe2{GoogleRe2: &matcherv3.RegexMatcher_GoogleRE2{}},
+			},
+		},
+	}), nil
+}
+
+// buildClientCertificatePredicate matches the connections whose client certificate has the
+// given subject, DNS SANs and URI SANs. The inputs are available for both HTTP and TCP.
+func buildClientCertificatePredicate(cert *ir.ClientCertificateMatch) (*matcherv3.Matcher_MatcherList_Predicate, error) {
+	var predicates []*matcherv3.Matcher_MatcherList_Predicate
+
+	if cert.Subject != nil {
+		subjectInput, err := proto.ToAnyWithValidation(&sslinputv3.SubjectInput{})
+		if err != nil {
+			return nil, err
+		}
+		stringMatcher, err := buildStringMatcher(*cert.Subject)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, buildSinglePredicate("subject", subjectInput, stringMatcher))
+	}
+
+	for _, san := range []struct {
+		name    string
+		input   googleproto.Message
+		matches []*ir.StringMatch
+	}{
+		{name: "dns_san", input: &sslinputv3.DnsSanInput{}, matches: cert.DNSNames},
+		{name: "uri_san", input: &sslinputv3.UriSanInput{}, matches: cert.URIs},
+	} {
+		if len(san.matches) == 0 {
+			continue
+		}
+		sanInput, err := proto.ToAnyWithValidation(san.input)
+		if err != nil {
+			return nil, err
+		}
+
+		// One of the SANs must match one of the given values.
+		sanPredicates := make([]*matcherv3.Matcher_MatcherList_Predicate, 0, len(san.matches))
+		for _, match := range san.matches {
+			sanRegex, err := sanListRegex(*match)
+			if err != nil {
+				return nil, err
+			}
+			sanPredicates = append(sanPredicates, buildSinglePredicate(san.name, sanInput, &matcherv3.StringMatcher{
+				MatchPattern: &matcherv3.StringMatcher_SafeRegex{
+					SafeRegex: &matcherv3.RegexMatcher{
+						Regex:      sanRegex,
+						EngineType: &matcherv3.RegexMatcher_GoogleRe2{GoogleRe2: &matcherv3.RegexMatcher_GoogleRE2{}},
+					},
+				},
+			}))
+		}
+		if len(sanPredicates) > 1 {
+			predicates = append(predicates, &matcherv3.Matcher_MatcherList_Predicate{
+				MatchType: &matcherv3.Matcher_MatcherList_Predicate_OrMatcher{
+					OrMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+						Predicate: sanPredicates,
+					},
+				},
+			})
+		} else {
+			predicates = append(predicates, sanPredicates...)
+		}
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, errors.New("at least one of subject, dnsNames, or uris must be specified in clientCertificate")
+	case 1:
+		return predicates[0], nil
+	default:
+		return &matcherv3.Matcher_MatcherList_Predicate{
+			MatchType: &matcherv3.Matcher_MatcherList_Predicate_AndMatcher{
+				AndMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+					Predicate: predicates,
+				},
+			},
+		}, nil
+	}
+}
+
+// sanListRegex returns a regex that matches the comma-separated list of SANs returned by
+// the SAN inputs, if one of the SANs matches the given string match.
+// The match never spans several SANs.
+func sanListRegex(match ir.StringMatch) (string, error) {
+	var element string
+	switch {
+	case match.Prefix != nil:
+		element = regexp.QuoteMeta(*match.Prefix) + "[^,]*"
+	case match.Suffix != nil:
+		element = "[^,]*" + regexp.QuoteMeta(*match.Suffix)
+	case match.SafeRegex != nil:
+		return regex.ListElementPatternRegex(*match.SafeRegex)
+	default:
+		element = regexp.QuoteMeta(ptr.Deref(match.Exact, ""))
+	}
+	return "^(.*,)?" + element + "(,.*)?$", nil
+}
+
+func buildSinglePredicate(inputName string, input *anypb.Any, stringMatcher *matcherv3.StringMatcher) *matcherv3.Matcher_MatcherList_Predicate {
+	return &matcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &cncfv3.TypedExtensionConfig{
+					Name:        inputName,
+					TypedConfig: input,
+				},
+				Matcher: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+					ValueMatch: stringMatcher,
+				},
+			},
+		},
+	}
+}
+
 func buildPathPredicate(path *egv1a1.PathMatch) (*matcherv3.Matcher_MatcherList_Predicate, error) {
 	if path == nil {
 		return nil, nil
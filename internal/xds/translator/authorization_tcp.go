@@ -118,9 +118,36 @@ func buildTCPRBACActions() (*anypb.Any, *anypb.Any, error) {
 }
 
 func buildTCPPrincipalPredicate(principal *ir.Principal) (*matcherv3.Matcher_MatcherList_Predicate, error) {
-	// only build predicate for CIDR
-	if len(principal.ClientCIDRs) == 0 {
+	// only build predicates for CIDR and client certificate
+	var predicates []*matcherv3.Matcher_MatcherList_Predicate
+	if len(principal.ClientCIDRs) > 0 {
+		ipPredicate, err := buildIPPredicate(principal.ClientCIDRs)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, ipPredicate)
+	}
+	if principal.ClientCertificate != nil {
+		clientCertPredicate, err := buildClientCertificatePredicate(principal.ClientCertificate)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, clientCertPredicate)
+	}
+
+	switch len(predicates) {
+	case 0:
 		return nil, nil
+	case 1:
+		return predicates[0], nil
+	default:
+		// All the principals must match.
+		return &matcherv3.Matcher_MatcherList_Predicate{
+			MatchType: &matcherv3.Matcher_MatcherList_Predicate_AndMatcher{
+				AndMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+					Predicate: predicates,
+				},
+			},
+		}, nil
 	}
-	return buildIPPredicate(principal.ClientCIDRs)
 }
@@ -0,0 +1,169 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"path"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	asyncfilesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/async_files/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	filesystemhttpcachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/file_system_http_cache/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/proto"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	// cacheRootPath is the directory under which each cache filter stores its responses.
+	cacheRootPath = "/tmp/envoy-gateway/cache"
+	// cacheFileManagerID is the ID of the async file manager shared by all the cache filters.
+	cacheFileManagerID = "envoy-gateway-cache"
+)
+
+func init() {
+	registerHTTPFilter(&cache{})
+}
+
+type cache struct{}
+
+var _ httpFilter = &cache{}
+
+// patchHCM builds and appends the cache Filters to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: this method creates a cache filter for each unique Cache config, the
+// filter is disabled by default. It is enabled on the route level.
+func (*cache) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	var errs error
+	for _, route := range irListener.Routes {
+		if !routeContainsCache(route) {
+			continue
+		}
+		if hcmContainsFilter(mgr, cacheFilterName(route.Traffic.Cache)) {
+			continue
+		}
+		filter, err := buildHCMCacheFilter(route.Traffic.Cache)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// buildHCMCacheFilter returns a cache filter backed by a size-bounded file system cache,
+// which evicts the least recently used responses once it is full.
+func buildHCMCacheFilter(ca *ir.Cache) (*hcmv3.HttpFilter, error) {
+	store := &filesystemhttpcachev3.FileSystemHttpCacheConfig{
+		ManagerConfig: &asyncfilesv3.AsyncFileManagerConfig{
+			Id: cacheFileManagerID,
+			ManagerType: &asyncfilesv3.AsyncFileManagerConfig_ThreadPool_{
+				ThreadPool: &asyncfilesv3.AsyncFileManagerConfig_ThreadPool{},
+			},
+		},
+		CachePath:         path.Join(cacheRootPath, ca.Name),
+		CreateCachePath:   true,
+		MaxCacheSizeBytes: wrapperspb.UInt64(ca.MaxSizeBytes),
+	}
+	if ca.MaxEntrySizeBytes != nil {
+		store.MaxIndividualCacheEntrySizeBytes = wrapperspb.UInt64(*ca.MaxEntrySizeBytes)
+	}
+	storeAny, err := proto.ToAnyWithValidation(store)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheProto := &cachev3.CacheConfig{
+		TypedConfig:                     storeAny,
+		IgnoreRequestCacheControlHeader: ca.IgnoreRequestCacheControl,
+	}
+	for i := range ca.AllowedVaryHeaders {
+		cacheProto.AllowedVaryHeaders = append(cacheProto.AllowedVaryHeaders,
+			buildXdsStringMatcher(&ca.AllowedVaryHeaders[i]))
+	}
+	if ca.Key != nil {
+		cacheProto.KeyCreatorParams = &cachev3.CacheConfig_KeyCreatorParams{
+			ExcludeScheme:           ca.Key.ExcludeScheme,
+			ExcludeHost:             ca.Key.ExcludeHost,
+			QueryParametersIncluded: buildQueryParameterPresentMatchers(ca.Key.IncludedQueryParameters),
+			QueryParametersExcluded: buildQueryParameterPresentMatchers(ca.Key.ExcludedQueryParameters),
+		}
+	}
+
+	cacheAny, err := proto.ToAnyWithValidation(cacheProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     cacheFilterName(ca),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: cacheAny,
+		},
+	}, nil
+}
+
+func buildQueryParameterPresentMatchers(names []string) []*routev3.QueryParameterMatcher {
+	if len(names) == 0 {
+		return nil
+	}
+	matchers := make([]*routev3.QueryParameterMatcher, 0, len(names))
+	for _, name := range names {
+		matchers = append(matchers, &routev3.QueryParameterMatcher{
+			Name: name,
+			QueryParameterMatchSpecifier: &routev3.QueryParameterMatcher_PresentMatch{
+				PresentMatch: true,
+			},
+		})
+	}
+	return matchers
+}
+
+func cacheFilterName(ca *ir.Cache) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterCache, ca.Name)
+}
+
+// routeContainsCache returns true if Cache exists for the provided route.
+func routeContainsCache(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.Traffic != nil && irRoute.Traffic.Cache != nil
+}
+
+func (*cache) patchResources(_ *types.ResourceVersionTable, _ []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route so the cache filter is enabled if applicable.
+func (*cache) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute, _ *ir.HTTPListener) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsCache(irRoute) {
+		return nil
+	}
+
+	return enableFilterOnRoute(route, cacheFilterName(irRoute.Traffic.Cache), &routev3.FilterConfig{
+		Config: &anypb.Any{},
+	})
+}
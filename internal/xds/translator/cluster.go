@@ -6,7 +6,9 @@
 package translator
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -33,6 +35,8 @@ import (
 	randomv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/random/v3"
 	round_robinv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/round_robin/v3"
 	wrr_localityv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/wrr_locality/v3"
+	caresv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/network/dns_resolver/cares/v3"
+	getaddrinfov3 "github.com/envoyproxy/go-control-plane/envoy/extensions/network/dns_resolver/getaddrinfo/v3"
 	proxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
 	rawbufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
 	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
@@ -41,6 +45,7 @@ import (
 	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	gproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -66,6 +71,9 @@ const (
 	// (envoy.extensions.clusters.dns.v3.DnsCluster). Note the singular "cluster": this is the
 	// factory name set in Envoy's ConfigurableClusterFactoryBase ctor, not the plural extension key.
 	dnsClusterTypeName = "envoy.cluster.dns"
+	// caresDNSResolverName and getAddrInfoDNSResolverName are the names of the DNS resolver extensions.
+	caresDNSResolverName       = "envoy.network.dns_resolver.cares"
+	getAddrInfoDNSResolverName = "envoy.network.dns_resolver.getaddrinfo"
 )
 
 type xdsClusterArgs struct {
@@ -209,11 +217,24 @@ func dfpCacheName(ipFamily *egv1a1.IPFamily, dns *ir.DNS) string {
 	if dns != nil && dns.Name != "" {
 		suffix = dns.Name
 	}
+	// The caches with the same name must have the same config, so the DNS resolver,
+	// which may come from the Backend or the EnvoyProxy, is part of the name.
+	if dns != nil && dns.Resolver != nil {
+		suffix = fmt.Sprintf("%s-%s", suffix, dnsResolverHash(dns.Resolver))
+	}
 	// Hash to keep names short and avoid collisions when new DNS settings are added.
 	return fmt.Sprintf("%s-%s", base, suffix)
 }
 
-func buildDFPDNSCacheConfig(name string, dns *ir.DNS, dnsLookupFamily clusterv3.Cluster_DnsLookupFamily) *commondfpv3.DnsCacheConfig {
+// dnsResolverHash returns the first 16 hex characters of the SHA-256 digest of the DNS resolver.
+func dnsResolverHash(resolver *ir.DNSResolver) string {
+	// The resolver only holds strings, numbers and booleans, so marshaling can't fail.
+	b, _ := json.Marshal(resolver)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
+func buildDFPDNSCacheConfig(name string, dns *ir.DNS, dnsLookupFamily clusterv3.Cluster_DnsLookupFamily) (*commondfpv3.DnsCacheConfig, error) {
 	dnsCacheConfig := &commondfpv3.DnsCacheConfig{
 		Name:            name,
 		DnsLookupFamily: dnsLookupFamily,
@@ -224,7 +245,84 @@ func buildDFPDNSCacheConfig(name string, dns *ir.DNS, dnsLookupFamily clusterv3.
 		dnsCacheConfig.DnsRefreshRate = durationpb.New(dns.DNSRefreshRate.Duration)
 	}
 
-	return dnsCacheConfig
+	if dns != nil {
+		resolverConfig, err := buildDNSResolverConfig(dns.Resolver)
+		if err != nil {
+			return nil, err
+		}
+		dnsCacheConfig.TypedDnsResolverConfig = resolverConfig
+	}
+
+	return dnsCacheConfig, nil
+}
+
+// clusterDNS returns the DNS settings of a cluster. If the DNS settings of the route don't
+// define a DNS resolver, the DNS resolver of the first destination that defines one is used.
+// The destinations resolved with DNS are validated to use the same DNS resolver during the
+// gateway API translation.
+func clusterDNS(dns *ir.DNS, settings []*ir.DestinationSetting) *ir.DNS {
+	if dns != nil && dns.Resolver != nil {
+		return dns
+	}
+	for _, ds := range settings {
+		if ds.DNSResolver == nil {
+			continue
+		}
+		out := &ir.DNS{}
+		if dns != nil {
+			out = dns.DeepCopy()
+		}
+		out.Resolver = ds.DNSResolver
+		return out
+	}
+	return dns
+}
+
+// buildDNSResolverConfig builds the typed config of the DNS resolver extension.
+func buildDNSResolverConfig(resolver *ir.DNSResolver) (*corev3.TypedExtensionConfig, error) {
+	if resolver == nil {
+		return nil, nil
+	}
+
+	var (
+		name   string
+		config gproto.Message
+	)
+	switch resolver.Type {
+	case egv1a1.GetAddrInfoDNSResolverType:
+		name = getAddrInfoDNSResolverName
+		config = &getaddrinfov3.GetAddrInfoDnsResolverConfig{}
+	default:
+		name = caresDNSResolverName
+		cares := &caresv3.CaresDnsResolverConfig{
+			DnsResolverOptions: &corev3.DnsResolverOptions{
+				UseTcpForDnsLookups:   resolver.UseTCP,
+				NoDefaultSearchDomain: resolver.NoDefaultSearchDomain,
+			},
+		}
+		for _, address := range resolver.Addresses {
+			cares.Resolvers = append(cares.Resolvers, &corev3.Address{
+				Address: &corev3.Address_SocketAddress{
+					SocketAddress: &corev3.SocketAddress{
+						Address: address.Host,
+						PortSpecifier: &corev3.SocketAddress_PortValue{
+							PortValue: address.Port,
+						},
+					},
+				},
+			})
+		}
+		config = cares
+	}
+
+	configAny, err := proto.ToAnyWithValidation(config)
+	if err != nil {
+		return nil, err
+	}
+	return &corev3.TypedExtensionConfig{
+		Name:        name,
+		TypedConfig: configAny,
+	}, nil
 }
 
 type buildClusterResult struct {
@@ -233,7 +331,8 @@ type buildClusterResult struct {
 }
 
 func buildXdsCluster(args *xdsClusterArgs) (*buildClusterResult, error) {
-	dnsLookupFamily := computeDNSLookupFamily(args.ipFamily, args.dns)
+	dns := clusterDNS(args.dns, args.settings)
+	dnsLookupFamily := computeDNSLookupFamily(args.ipFamily, dns)
 
 	cluster := &clusterv3.Cluster{
 		Name:                          args.name,
@@ -506,8 +605,11 @@ func buildXdsCluster(args *xdsClusterArgs) (*buildClusterResult, error) {
 
 	switch args.endpointType {
 	case EndpointTypeDynamicResolver:
-		cacheName := dfpCacheName(args.ipFamily, args.dns)
-		dnsCacheConfig := buildDFPDNSCacheConfig(cacheName, args.dns, dnsLookupFamily)
+		cacheName := dfpCacheName(args.ipFamily, dns)
+		dnsCacheConfig, err := buildDFPDNSCacheConfig(cacheName, dns, dnsLookupFamily)
+		if err != nil {
+			return nil, err
+		}
 
 		dfp := &dfpv3.ClusterConfig{
 			ClusterImplementationSpecifier: &dfpv3.ClusterConfig_DnsCacheConfig{
@@ -557,13 +659,18 @@ func buildXdsCluster(args *xdsClusterArgs) (*buildClusterResult, error) {
 			// ignores the top-level Cluster.dns_lookup_family, so carry it over here to preserve behavior.
 			DnsLookupFamily: toCommonDNSLookupFamily(dnsLookupFamily),
 		}
-		if args.dns != nil {
-			if args.dns.DNSRefreshRate != nil {
-				dnsCluster.DnsRefreshRate = durationpb.New(args.dns.DNSRefreshRate.Duration)
+		if dns != nil {
+			if dns.DNSRefreshRate != nil {
+				dnsCluster.DnsRefreshRate = durationpb.New(dns.DNSRefreshRate.Duration)
+			}
+			if dns.RespectDNSTTL != nil {
+				dnsCluster.RespectDnsTtl = ptr.Deref(dns.RespectDNSTTL, true)
 			}
-			if args.dns.RespectDNSTTL != nil {
-				dnsCluster.RespectDnsTtl = ptr.Deref(args.dns.RespectDNSTTL, true)
+			resolverConfig, err := buildDNSResolverConfig(dns.Resolver)
+			if err != nil {
+				return nil, err
 			}
+			dnsCluster.TypedDnsResolverConfig = resolverConfig
 		}
 		dnsClusterAny, err := proto.ToAnyWithValidation(dnsCluster)
 		if err != nil {
@@ -1473,15 +1580,17 @@ func (route *UDPRouteTranslator) asClusterArgs(name string,
 	metadata *ir.ResourceMetadata,
 ) *xdsClusterArgs {
 	return &xdsClusterArgs{
-		name:         name,
-		settings:     settings,
-		loadBalancer: route.LoadBalancer,
-		endpointType: buildEndpointType(settings),
-		metrics:      extra.metrics,
-		dns:          route.DNS,
-		ipFamily:     extra.ipFamily,
-		metadata:     metadata,
-		isRoute:      true,
+		name:           name,
+		settings:       settings,
+		loadBalancer:   route.LoadBalancer,
+		endpointType:   buildEndpointType(settings),
+		metrics:        extra.metrics,
+		dns:            route.DNS,
+		ipFamily:       extra.ipFamily,
+		metadata:       metadata,
+		isRoute:        true,
+		circuitBreaker: route.CircuitBreaker,
+		healthCheck:    route.HealthCheck,
 	}
 }
 
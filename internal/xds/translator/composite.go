@@ -0,0 +1,153 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	cncfv3 "github.com/cncf/xds/go/xds/core/v3"
+	matcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	matchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	compositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/proto"
+)
+
+const compositeFilterName = "envoy.filters.http.composite"
+
+// wrapFilterWithMatch wraps the filter in a composite filter, which only executes the
+// filter for the requests matching the given conditions.
+//
+// The name of the filter is kept, so the filter can still be enabled and configured
+// per route with its own name.
+func wrapFilterWithMatch(filter *hcmv3.HttpFilter, match *ir.ExtensionMatch) (*hcmv3.HttpFilter, error) {
+	if match == nil {
+		return filter, nil
+	}
+
+	predicate, err := buildExtensionMatchPredicate(match)
+	if err != nil {
+		return nil, err
+	}
+
+	executeFilterAny, err := proto.ToAnyWithValidation(&compositev3.ExecuteFilterAction{
+		TypedConfig: &corev3.TypedExtensionConfig{
+			Name:        filter.Name,
+			TypedConfig: filter.GetTypedConfig(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	compositeAny, err := proto.ToAnyWithValidation(&compositev3.Composite{})
+	if err != nil {
+		return nil, err
+	}
+
+	extensionWithMatcherAny, err := proto.ToAnyWithValidation(&matchingv3.ExtensionWithMatcher{
+		XdsMatcher: &matcherv3.Matcher{
+			MatcherType: &matcherv3.Matcher_MatcherList_{
+				MatcherList: &matcherv3.Matcher_MatcherList{
+					Matchers: []*matcherv3.Matcher_MatcherList_FieldMatcher{
+						{
+							Predicate: predicate,
+							OnMatch: &matcherv3.Matcher_OnMatch{
+								OnMatch: &matcherv3.Matcher_OnMatch_Action{
+									Action: &cncfv3.TypedExtensionConfig{
+										Name:        filter.Name,
+										TypedConfig: executeFilterAny,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ExtensionConfig: &corev3.TypedExtensionConfig{
+			Name:        compositeFilterName,
+			TypedConfig: compositeAny,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     filter.Name,
+		Disabled: filter.Disabled,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: extensionWithMatcherAny,
+		},
+	}, nil
+}
+
+// buildExtensionMatchPredicate builds the predicate of the conditions of an extension.
+// All the conditions are ANDed together, and the methods are ORed together.
+func buildExtensionMatchPredicate(match *ir.ExtensionMatch) (*matcherv3.Matcher_MatcherList_Predicate, error) {
+	var predicates []*matcherv3.Matcher_MatcherList_Predicate
+
+	if len(match.Headers) > 0 {
+		headerPredicates, err := buildHeadersPredicate(match.Headers)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, headerPredicates...)
+	}
+
+	if match.Path != nil {
+		pathPredicate, err := buildPathPredicate(match.Path)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pathPredicate)
+	}
+
+	if len(match.Methods) > 0 {
+		methodPredicates, err := buildMethodsPredicate(match.Methods)
+		if err != nil {
+			return nil, err
+		}
+		if len(methodPredicates) > 1 {
+			predicates = append(predicates, &matcherv3.Matcher_MatcherList_Predicate{
+				MatchType: &matcherv3.Matcher_MatcherList_Predicate_OrMatcher{
+					OrMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+						Predicate: methodPredicates,
+					},
+				},
+			})
+		} else {
+			predicates = append(predicates, methodPredicates...)
+		}
+	}
+
+	if match.CEL != nil {
+		celPredicate, err := buildCELPredicate(*match.CEL)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, celPredicate)
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, errors.New("at least one of headers, path, methods or cel must be specified in match")
+	case 1:
+		return predicates[0], nil
+	default:
+		return &matcherv3.Matcher_MatcherList_Predicate{
+			MatchType: &matcherv3.Matcher_MatcherList_Predicate_AndMatcher{
+				AndMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+					Predicate: predicates,
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	brotliv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/decompressor/v3"
+	gzipv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/decompressor/v3"
+	zstdv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/decompressor/v3"
+	decompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/proto"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+// decompressionDirection is the direction of the streams decompressed by a decompressor filter.
+type decompressionDirection string
+
+const (
+	decompressRequest  decompressionDirection = "request"
+	decompressResponse decompressionDirection = "response"
+)
+
+func init() {
+	registerHTTPFilter(&decompressor{})
+}
+
+type decompressor struct{}
+
+var _ httpFilter = &decompressor{}
+
+// patchHCM builds and appends the decompressor Filters to the HTTP Connection Manager
+// if applicable, and they do not already exist.
+// Note: the request and the response directions are decompressed by two different
+// filters, so that the response decompression can be enabled independently on each route.
+func (*decompressor) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		if !routeContainsDecompression(route) {
+			continue
+		}
+		for _, decompressionType := range route.Traffic.Decompression.Types {
+			directions := []decompressionDirection{decompressRequest}
+			if route.Traffic.Decompression.Response {
+				directions = append(directions, decompressResponse)
+			}
+			for _, direction := range directions {
+				if hcmContainsFilter(mgr, decompressorFilterName(decompressionType, direction)) {
+					continue
+				}
+				filter, err := buildDecompressorFilter(decompressionType, direction)
+				if err != nil {
+					return err
+				}
+				mgr.HttpFilters = append(mgr.HttpFilters, filter)
+			}
+		}
+	}
+
+	return nil
+}
+
+func decompressorFilterName(decompressionType egv1a1.CompressorType, direction decompressionDirection) string {
+	return fmt.Sprintf("%s.%s.%s", egv1a1.EnvoyFilterDecompressor.String(), strings.ToLower(string(decompressionType)), direction)
+}
+
+// buildDecompressorFilter builds a decompressor filter that only decompresses the streams
+// of the provided direction.
+func buildDecompressorFilter(decompressionType egv1a1.CompressorType, direction decompressionDirection) (*hcmv3.HttpFilter, error) {
+	var (
+		extensionName string
+		extensionMsg  protobuf.Message
+	)
+
+	switch decompressionType {
+	case egv1a1.BrotliCompressorType:
+		extensionName = "envoy.compression.brotli.decompressor"
+		extensionMsg = &brotliv3.Brotli{}
+	case egv1a1.GzipCompressorType:
+		extensionName = "envoy.compression.gzip.decompressor"
+		extensionMsg = &gzipv3.Gzip{}
+	case egv1a1.ZstdCompressorType:
+		extensionName = "envoy.compression.zstd.decompressor"
+		extensionMsg = &zstdv3.Zstd{}
+	default:
+		return nil, fmt.Errorf("unsupported decompressor type %s", decompressionType)
+	}
+
+	extensionAny, err := proto.ToAnyWithValidation(extensionMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	decompressorProto := &decompressorv3.Decompressor{
+		DecompressorLibrary: &corev3.TypedExtensionConfig{
+			Name:        extensionName,
+			TypedConfig: extensionAny,
+		},
+		RequestDirectionConfig: &decompressorv3.Decompressor_RequestDirectionConfig{
+			CommonConfig: &decompressorv3.Decompressor_CommonDirectionConfig{
+				Enabled: decompressionEnabled(direction == decompressRequest),
+			},
+			// Only advertise the encoding to the backends if their responses are decompressed.
+			AdvertiseAcceptEncoding: wrapperspb.Bool(direction == decompressResponse),
+		},
+		ResponseDirectionConfig: &decompressorv3.Decompressor_ResponseDirectionConfig{
+			CommonConfig: &decompressorv3.Decompressor_CommonDirectionConfig{
+				Enabled: decompressionEnabled(direction == decompressResponse),
+			},
+		},
+	}
+
+	decompressorAny, err := proto.ToAnyWithValidation(decompressorProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name: decompressorFilterName(decompressionType, direction),
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: decompressorAny,
+		},
+		Disabled: true,
+	}, nil
+}
+
+func decompressionEnabled(enabled bool) *corev3.RuntimeFeatureFlag {
+	return &corev3.RuntimeFeatureFlag{
+		DefaultValue: wrapperspb.Bool(enabled),
+	}
+}
+
+// routeContainsDecompression returns true if decompression exists for the provided route.
+func routeContainsDecompression(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.Traffic != nil && irRoute.Traffic.Decompression != nil
+}
+
+func (*decompressor) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route so the decompressor filters are enabled if applicable.
+func (*decompressor) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute, _ *ir.HTTPListener) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsDecompression(irRoute) {
+		return nil
+	}
+
+	decompression := irRoute.Traffic.Decompression
+	for _, decompressionType := range decompression.Types {
+		if err := enableFilterOnRoute(route, decompressorFilterName(decompressionType, decompressRequest), &routev3.FilterConfig{
+			Config: &anypb.Any{},
+		}); err != nil {
+			return err
+		}
+		if !decompression.Response {
+			continue
+		}
+		if err := enableFilterOnRoute(route, decompressorFilterName(decompressionType, decompressResponse), &routev3.FilterConfig{
+			Config: &anypb.Any{},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
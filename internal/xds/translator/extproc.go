@@ -83,13 +83,13 @@ func buildHCMExtProcFilter(extProc *ir.ExtProc) (*hcmv3.HttpFilter, error) {
 
 	// All extproc filters for all Routes are aggregated on HCM and disabled by default
 	// Per-route config is used to enable the relevant filters on appropriate routes
-	return &hcmv3.HttpFilter{
+	return wrapFilterWithMatch(&hcmv3.HttpFilter{
 		Name:     extProcFilterName(extProc),
 		Disabled: true,
 		ConfigType: &hcmv3.HttpFilter_TypedConfig{
 			TypedConfig: extAuthAny,
 		},
-	}, nil
+	}, extProc.Match)
 }
 
 func extProcFilterName(extProc *ir.ExtProc) string {
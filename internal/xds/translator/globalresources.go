@@ -54,6 +54,12 @@ func (t *Translator) patchGlobalResources(tCtx *types.ResourceVersionTable, irXd
 			}
 		}
 	}
+
+	if t.RateLimitQuota != nil && containsRateLimitQuota(irXds.HTTP) {
+		if err := t.createRateLimitQuotaServiceCluster(tCtx, irXds.GlobalResources, irXds.Metrics); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
 	return errs
 }
 
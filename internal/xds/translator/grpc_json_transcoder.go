@@ -0,0 +1,133 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	grpcjsontranscoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&grpcJSONTranscoder{})
+}
+
+type grpcJSONTranscoder struct{}
+
+var _ httpFilter = &grpcJSONTranscoder{}
+
+// patchHCM builds and appends the gRPC-JSON transcoder Filters to the HTTP Connection Manager.
+// gRPC-JSON transcoder filters are created in disabled mode.
+func (*grpcJSONTranscoder) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	var errs error
+	for _, route := range irListener.Routes {
+		if !routeContainsGRPCJSONTranscoder(route) {
+			continue
+		}
+		transcoder := route.EnvoyExtensions.GRPCJSONTranscoder
+		if hcmContainsFilter(mgr, grpcJSONTranscoderFilterName(transcoder)) {
+			continue
+		}
+		filter, err := buildHCMGRPCJSONTranscoderFilter(transcoder)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// buildHCMGRPCJSONTranscoderFilter returns a gRPC-JSON transcoder filter for HCM.
+func buildHCMGRPCJSONTranscoderFilter(transcoder *ir.GRPCJSONTranscoder) (*hcmv3.HttpFilter, error) {
+	transcoderProto := &grpcjsontranscoderv3.GrpcJsonTranscoder{
+		DescriptorSet: &grpcjsontranscoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: transcoder.ProtoDescriptor,
+		},
+		Services:                     transcoder.Services,
+		IgnoreUnknownQueryParameters: transcoder.IgnoreUnknownQueryParameters,
+	}
+	if po := transcoder.PrintOptions; po != nil {
+		transcoderProto.PrintOptions = &grpcjsontranscoderv3.GrpcJsonTranscoder_PrintOptions{
+			AddWhitespace:              po.AddWhitespace,
+			AlwaysPrintPrimitiveFields: po.AlwaysPrintPrimitiveFields,
+			AlwaysPrintEnumsAsInts:     po.AlwaysPrintEnumsAsInts,
+			PreserveProtoFieldNames:    po.PreserveProtoFieldNames,
+		}
+	}
+	if transcoder.RejectUnknownQueryParameters {
+		transcoderProto.RequestValidationOptions = &grpcjsontranscoderv3.GrpcJsonTranscoder_RequestValidationOptions{
+			RejectUnknownQueryParameters: true,
+		}
+	}
+	if err := transcoderProto.ValidateAll(); err != nil {
+		return nil, err
+	}
+	transcoderAny, err := anypb.New(transcoderProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     grpcJSONTranscoderFilterName(transcoder),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: transcoderAny,
+		},
+	}, nil
+}
+
+func grpcJSONTranscoderFilterName(transcoder *ir.GRPCJSONTranscoder) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterGRPCJSONTranscoder, transcoder.Name)
+}
+
+// routeContainsGRPCJSONTranscoder returns true if a gRPC-JSON transcoder exists for the provided route.
+func routeContainsGRPCJSONTranscoder(irRoute *ir.HTTPRoute) bool {
+	if irRoute == nil {
+		return false
+	}
+
+	return irRoute.EnvoyExtensions != nil && irRoute.EnvoyExtensions.GRPCJSONTranscoder != nil
+}
+
+// patchResources patches the resources for the gRPC-JSON transcoder.
+// The proto descriptor set is inlined in the filter, so no resources are needed.
+func (*grpcJSONTranscoder) patchResources(_ *types.ResourceVersionTable, _ []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route so the gRPC-JSON transcoder filter is enabled if applicable.
+func (*grpcJSONTranscoder) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute, _ *ir.HTTPListener) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsGRPCJSONTranscoder(irRoute) {
+		return nil
+	}
+
+	filterName := grpcJSONTranscoderFilterName(irRoute.EnvoyExtensions.GRPCJSONTranscoder)
+	return enableFilterOnRoute(route, filterName, &routev3.FilterConfig{
+		Config: &anypb.Any{},
+	})
+}
@@ -111,25 +111,44 @@ func newOrderedHTTPFilter(filter *hcmv3.HttpFilter) *OrderedHTTPFilter {
 		// the cors filter, and before the authn/authz filters, so that cross-site
 		// mutating requests are rejected without invoking external auth services.
 		order = 4
+	case isFilterType(filter, egv1a1.EnvoyFilterIPTagging):
+		// Ensure the client IPs are tagged before the waf, authn/authz and extension
+		// filters, so that the tags are set for all of them as well as for the
+		// rbac and ratelimit filters, which match on the tags.
+		order = 5
+	case isFilterType(filter, egv1a1.EnvoyFilterDecompressor) &&
+		strings.HasSuffix(filter.Name, "."+string(decompressResponse)):
+		// Ensure the response decompressor runs right before the router, so that all the
+		// filters receive the decompressed response body on the encode path.
+		order = 314
+	case isFilterType(filter, egv1a1.EnvoyFilterDecompressor):
+		// Ensure the request decompressor runs before the filters that inspect the request
+		// body, such as waf, ext_authz, lua and ext_proc, so that they receive the
+		// decompressed body.
+		order = 6
+	case isFilterType(filter, egv1a1.EnvoyFilterWAF):
+		// Ensure the WAF inspects the requests before the header mutation and
+		// authn/authz filters, so that malicious requests are rejected early.
+		order = 7
 	case isFilterType(filter, egv1a1.EnvoyFilterHeaderMutation):
 		// Ensure header mutation run before ext auth which might consume the header.
-		order = 5
+		order = 8
 	case isFilterType(filter, egv1a1.EnvoyFilterExtAuthz):
-		order = 6
+		order = 9
 	case isFilterType(filter, egv1a1.EnvoyFilterAPIKeyAuth):
-		order = 7
+		order = 10
 	case isFilterType(filter, egv1a1.EnvoyFilterBasicAuth):
-		order = 8
+		order = 11
 	case isFilterType(filter, egv1a1.EnvoyFilterOAuth2):
-		order = 9
+		order = 12
 	case isFilterType(filter, egv1a1.EnvoyFilterJWTAuthn):
-		order = 10
+		order = 13
 	case isFilterType(filter, egv1a1.EnvoyFilterSessionPersistence):
-		order = 11
+		order = 14
 	case isFilterType(filter, egv1a1.EnvoyFilterBuffer):
-		order = 12
+		order = 15
 	case isFilterType(filter, egv1a1.EnvoyFilterLua):
-		order = 13 + mustGetFilterIndex(filter.Name)
+		order = 16 + mustGetFilterIndex(filter.Name)
 	case isFilterType(filter, egv1a1.EnvoyFilterExtProc):
 		order = 100 + mustGetFilterIndex(filter.Name)
 	case isFilterType(filter, egv1a1.EnvoyFilterWasm):
@@ -144,20 +163,35 @@ func newOrderedHTTPFilter(filter *hcmv3.HttpFilter) *OrderedHTTPFilter {
 		order = 302
 	case isFilterType(filter, egv1a1.EnvoyFilterRateLimit):
 		order = 303
-	case isFilterType(filter, egv1a1.EnvoyFilterBandwidthLimit):
+	case isFilterType(filter, egv1a1.EnvoyFilterRateLimitQuota):
 		order = 304
-	case isFilterType(filter, egv1a1.EnvoyFilterGRPCWeb):
+	case isFilterType(filter, egv1a1.EnvoyFilterBandwidthLimit):
 		order = 305
-	case isFilterType(filter, egv1a1.EnvoyFilterGRPCStats):
+	case isFilterType(filter, egv1a1.EnvoyFilterGRPCJSONTranscoder):
+		// Ensure the gRPC-JSON transcoder runs after the filters that match on
+		// the original RESTful request, such as rbac and ratelimit.
 		order = 306
-	case isFilterType(filter, egv1a1.EnvoyFilterCredentialInjector):
+	case isFilterType(filter, egv1a1.EnvoyFilterGRPCWeb):
 		order = 307
-	case isFilterType(filter, egv1a1.EnvoyFilterCompressor):
+	case isFilterType(filter, egv1a1.EnvoyFilterGRPCStats):
 		order = 308
-	case isFilterType(filter, egv1a1.EnvoyFilterDynamicForwardProxy):
+	case isFilterType(filter, egv1a1.EnvoyFilterCredentialInjector):
 		order = 309
-	case isFilterType(filter, egv1a1.EnvoyFilterRouter):
+	case isFilterType(filter, egv1a1.EnvoyFilterCache):
+		// Ensure the cache runs after the authn/authz and ratelimit filters, so
+		// that cached responses are only served to the permitted requests.
 		order = 310
+	case isFilterType(filter, egv1a1.EnvoyFilterAdaptiveConcurrency):
+		// Ensure the adaptive concurrency filter runs after the filters that reject
+		// or answer the requests locally, such as ratelimit and cache, so that only
+		// the latencies of the requests forwarded to the backends are sampled.
+		order = 311
+	case isFilterType(filter, egv1a1.EnvoyFilterCompressor):
+		order = 312
+	case isFilterType(filter, egv1a1.EnvoyFilterDynamicForwardProxy):
+		order = 313
+	case isFilterType(filter, egv1a1.EnvoyFilterRouter):
+		order = 315
 	}
 
 	return &OrderedHTTPFilter{
@@ -298,6 +332,12 @@ func (t *Translator) patchHCMWithFilters(mgr *hcmv3.HttpConnectionManager, irLis
 	// rate limit server configuration.
 	t.patchHCMWithRateLimit(mgr, irListener)
 
+	// RateLimitQuota filter is handled separately because it relies on the
+	// rate limit quota server configuration.
+	if err := t.patchHCMWithRateLimitQuota(mgr, irListener); err != nil {
+		return err
+	}
+
 	// Add the router filter if it doesn't exist.
 	hasRouter := false
 	for _, filter := range mgr.HttpFilters {
@@ -341,6 +381,10 @@ func patchRouteWithPerRouteConfig(route *routev3.Route, irRoute *ir.HTTPRoute, h
 		return nil
 	}
 
+	if err := patchRouteWithRateLimitQuota(route, irRoute); err != nil {
+		return err
+	}
+
 	return nil
 }
 
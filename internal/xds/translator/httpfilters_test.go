@@ -44,12 +44,15 @@ func Test_sortHTTPFilters(t *testing.T) {
 				httpFilterForTest(egv1a1.EnvoyFilterRBAC + "/securitypolicy/default/policy-for-http-route-1"),
 				httpFilterForTest(wellknown.HealthCheck),
 				httpFilterForTest(egv1a1.EnvoyFilterBuffer),
+				httpFilterForTest(egv1a1.EnvoyFilter(decompressorFilterName(egv1a1.GzipCompressorType, decompressResponse))),
+				httpFilterForTest(egv1a1.EnvoyFilter(decompressorFilterName(egv1a1.GzipCompressorType, decompressRequest))),
 			},
 			want: []*hcmv3.HttpFilter{
 				httpFilterForTest(egv1a1.EnvoyFilterCustomResponse),
 				httpFilterForTest(wellknown.HealthCheck),
 				httpFilterForTest(egv1a1.EnvoyFilterFault),
 				httpFilterForTest(egv1a1.EnvoyFilterCORS),
+				httpFilterForTest(egv1a1.EnvoyFilter(decompressorFilterName(egv1a1.GzipCompressorType, decompressRequest))),
 				httpFilterForTest(egv1a1.EnvoyFilterHeaderMutation),
 				httpFilterForTest(egv1a1.EnvoyFilterExtAuthz + "/securitypolicy/default/policy-for-http-route-1"),
 				httpFilterForTest(egv1a1.EnvoyFilterBasicAuth),
@@ -64,6 +67,7 @@ func Test_sortHTTPFilters(t *testing.T) {
 				httpFilterForTest(egv1a1.EnvoyFilterRBAC + "/securitypolicy/default/policy-for-http-route-1"),
 				httpFilterForTest(egv1a1.EnvoyFilterLocalRateLimit),
 				httpFilterForTest(egv1a1.EnvoyFilterRateLimit),
+				httpFilterForTest(egv1a1.EnvoyFilter(decompressorFilterName(egv1a1.GzipCompressorType, decompressResponse))),
 				httpFilterForTest(egv1a1.EnvoyFilterRouter),
 			},
 		},
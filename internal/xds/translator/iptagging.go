@@ -0,0 +1,142 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"path/filepath"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	iptaggingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ip_tagging/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/proto"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+// ipTaggingFileFilterName is the name of the ip_tagging filter that loads the IP tags
+// from a file, when inline IP tags are also configured.
+var ipTaggingFileFilterName = egv1a1.EnvoyFilterIPTagging.String() + "/file"
+
+func init() {
+	registerHTTPFilter(&ipTagging{})
+}
+
+type ipTagging struct{}
+
+var _ httpFilter = &ipTagging{}
+
+// patchHCM builds and appends the ip_tagging filters to the HTTP Connection Manager
+// if the listener has IP tagging configured.
+//
+// The inline IP tags and the IP tags loaded from a file are configured in separate
+// filters, as the ip_tagging filter only supports one of them. The first filter
+// overwrites any tags header sent by the client, and the second one appends to it.
+func (*ipTagging) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+	if irListener.IPTagging == nil {
+		return nil
+	}
+	if hcmContainsFilter(mgr, egv1a1.EnvoyFilterIPTagging.String()) {
+		return nil
+	}
+
+	ipTagging := irListener.IPTagging
+	action := iptaggingv3.IPTagging_IpTagHeader_SANITIZE
+	if len(ipTagging.Tags) > 0 || ipTagging.FilePath == nil {
+		cfg := &iptaggingv3.IPTagging{
+			IpTags: buildIPTags(ipTagging.Tags),
+		}
+		filter, err := buildHCMIPTaggingFilter(egv1a1.EnvoyFilterIPTagging.String(), cfg, action)
+		if err != nil {
+			return err
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+		action = iptaggingv3.IPTagging_IpTagHeader_APPEND_IF_EXISTS_OR_ADD
+	}
+
+	if ipTagging.FilePath != nil {
+		name := egv1a1.EnvoyFilterIPTagging.String()
+		if action == iptaggingv3.IPTagging_IpTagHeader_APPEND_IF_EXISTS_OR_ADD {
+			name = ipTaggingFileFilterName
+		}
+		cfg := &iptaggingv3.IPTagging{
+			// Watch the directory of the file, so that the IP tags are reloaded when
+			// the file is replaced, for example by an update of a mounted ConfigMap.
+			IpTagsDatasource: &corev3.DataSource{
+				Specifier: &corev3.DataSource_Filename{
+					Filename: *ipTagging.FilePath,
+				},
+				WatchedDirectory: &corev3.WatchedDirectory{
+					Path: filepath.Dir(*ipTagging.FilePath),
+				},
+			},
+		}
+		filter, err := buildHCMIPTaggingFilter(name, cfg, action)
+		if err != nil {
+			return err
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return nil
+}
+
+func buildHCMIPTaggingFilter(name string, cfg *iptaggingv3.IPTagging,
+	action iptaggingv3.IPTagging_IpTagHeader_HeaderAction,
+) (*hcmv3.HttpFilter, error) {
+	cfg.IpTagHeader = &iptaggingv3.IPTagging_IpTagHeader{
+		Header: ir.IPTagsHeader,
+		Action: action,
+	}
+
+	typedConfig, err := proto.ToAnyWithValidation(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name: name,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
+
+func buildIPTags(tags []*ir.IPTag) []*iptaggingv3.IPTagging_IPTag {
+	ipTags := make([]*iptaggingv3.IPTagging_IPTag, 0, len(tags))
+	for _, tag := range tags {
+		ipTag := &iptaggingv3.IPTagging_IPTag{
+			IpTagName: tag.Name,
+			IpList:    make([]*corev3.CidrRange, 0, len(tag.CIDRs)),
+		}
+		for _, cidr := range tag.CIDRs {
+			ipTag.IpList = append(ipTag.IpList, &corev3.CidrRange{
+				AddressPrefix: cidr.AddressPrefix(),
+				PrefixLen:     wrapperspb.UInt32(cidr.MaskLen),
+			})
+		}
+		ipTags = append(ipTags, ipTag)
+	}
+	return ipTags
+}
+
+func (*ipTagging) patchRoute(*routev3.Route, *ir.HTTPRoute, *ir.HTTPListener) error {
+	return nil
+}
+
+func (*ipTagging) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
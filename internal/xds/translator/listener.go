@@ -16,6 +16,7 @@ import (
 	matcher "github.com/cncf/xds/go/xds/type/matcher/v3"
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	grpcstatsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_stats/v3"
 	tls_inspectorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	connection_limitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
@@ -472,7 +473,13 @@ func (t *Translator) addHCMToXDSListener(
 			mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCWeb)
 		}
 		if ptr.Deref(irListener.GRPC.EnableGRPCStats, false) {
-			mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCStats)
+			grpcStatsFilter := xdsfilters.GRPCStats
+			if irListener.GRPC.GRPCStats != nil {
+				if grpcStatsFilter, err = buildGRPCStatsFilter(irListener.GRPC.GRPCStats); err != nil {
+					return err
+				}
+			}
+			mgr.HttpFilters = append(mgr.HttpFilters, grpcStatsFilter)
 		}
 	}
 
@@ -604,6 +611,42 @@ func tlsListenerFilterChainName(irRoute *ir.TCPRoute) string {
 	return irRoute.Name
 }
 
+// buildGRPCStatsFilter builds the gRPC stats filter with the given settings.
+func buildGRPCStatsFilter(stats *ir.GRPCStats) (*hcmv3.HttpFilter, error) {
+	cfg := &grpcstatsv3.FilterConfig{
+		EmitFilterState:              true,
+		EnableUpstreamStats:          stats.EnableUpstreamStats,
+		ReplaceDotsInGrpcServiceName: true,
+	}
+	if len(stats.Services) > 0 {
+		methods := &corev3.GrpcMethodList{}
+		for _, service := range stats.Services {
+			methods.Services = append(methods.Services, &corev3.GrpcMethodList_Service{
+				Name:        service.Name,
+				MethodNames: service.Methods,
+			})
+		}
+		cfg.PerMethodStatSpecifier = &grpcstatsv3.FilterConfig_IndividualMethodStatsAllowlist{
+			IndividualMethodStatsAllowlist: methods,
+		}
+	} else {
+		cfg.PerMethodStatSpecifier = &grpcstatsv3.FilterConfig_StatsForAllMethods{
+			StatsForAllMethods: wrapperspb.Bool(true),
+		}
+	}
+
+	typedConfig, err := proto.ToAnyWithValidation(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &hcmv3.HttpFilter{
+		Name: wellknown.HTTPGRPCStats,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
+
 func buildEarlyHeaderMutation(headers *ir.HeaderSettings) []*corev3.TypedExtensionConfig {
 	if headers == nil || (len(headers.EarlyAddRequestHeaders) == 0 && len(headers.EarlyRemoveRequestHeaders) == 0 && len(headers.EarlyRemoveRequestHeadersOnMatch) == 0) {
 		return nil
@@ -753,7 +796,13 @@ func (t *Translator) addXdsTCPFilterChain(
 		filterChain.TransportSocket = tSocket
 	}
 
+	rateLimitFilterChains, err := buildTCPRateLimitFilterChains(irRoute, filterChain, statPrefix)
+	if err != nil {
+		return err
+	}
+
 	xdsListener.FilterChains = append(xdsListener.FilterChains, filterChain)
+	xdsListener.FilterChains = append(xdsListener.FilterChains, rateLimitFilterChains...)
 
 	return nil
 }
@@ -779,6 +828,16 @@ func buildTCPFilterChain(
 		filters = append(filters, authzFilter)
 	}
 
+	// Connection rate limit (if configured), the filter chains of the rules matching
+	// the source CIDR are built from this filter chain with their own limit.
+	if local := tcpLocalRateLimit(irRoute); local != nil {
+		rateLimitFilter, err := buildTCPLocalRateLimitFilter(statPrefix, local.Default)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, rateLimitFilter)
+	}
+
 	// Connection limit (if configured)
 	if connection != nil && connection.ConnectionLimit != nil && connection.ConnectionLimit.Value != nil {
 		cl := buildConnectionLimitFilter(statPrefix, connection)
@@ -805,6 +864,15 @@ func buildTCPFilterChain(
 		filters = append(filters, sniDFP)
 	}
 
+	// Bandwidth limit (if configured)
+	if irRoute.BandwidthLimit != nil {
+		bandwidthLimitFilter, err := buildTCPBandwidthLimitFilter(statPrefix, irRoute.BandwidthLimit)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, bandwidthLimitFilter)
+	}
+
 	// TCP proxy last
 	mgr := &tcpv3.TcpProxy{
 		AccessLog:  al,
@@ -1203,6 +1271,18 @@ func buildXdsUDPListener(
 			},
 		},
 	}
+	if r := udpListener.Route; r != nil {
+		if r.Timeout != nil && r.Timeout.UDP != nil && r.Timeout.UDP.IdleTimeout != nil {
+			udpProxy.IdleTimeout = durationpb.New(r.Timeout.UDP.IdleTimeout.Duration)
+		}
+		// Hash the downstream sessions by source IP so that the sessions of a client
+		// are consistently sent to the same upstream host.
+		if r.LoadBalancer != nil && r.LoadBalancer.ConsistentHash != nil && ptr.Deref(r.LoadBalancer.ConsistentHash.SourceIP, false) {
+			udpProxy.HashPolicies = []*udpv3.UdpProxyConfig_HashPolicy{{
+				PolicySpecifier: &udpv3.UdpProxyConfig_HashPolicy_SourceIp{SourceIp: true},
+			}}
+		}
+	}
 	udpProxyAny, err := proto.ToAnyWithValidation(udpProxy)
 	if err != nil {
 		return nil, err
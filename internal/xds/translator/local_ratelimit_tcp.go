@@ -0,0 +1,137 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+	"slices"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	networklocalrlv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	tcpbwlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_bandwidth_limit/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/ratelimit"
+)
+
+const (
+	// https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/local_ratelimit/v3/local_rate_limit.proto
+	networkLocalRateLimit = "envoy.filters.network.local_ratelimit"
+	// https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/tcp_bandwidth_limit/v3/tcp_bandwidth_limit.proto
+	networkTCPBandwidthLimit = "envoy.filters.network.tcp_bandwidth_limit"
+)
+
+// buildTCPLocalRateLimitFilter builds the network local rate limit filter, which limits the
+// rate of the new connections.
+func buildTCPLocalRateLimitFilter(statPrefix string, limit ir.RateLimitValue) (*listenerv3.Filter, error) {
+	return toNetworkFilter(networkLocalRateLimit, &networklocalrlv3.LocalRateLimit{
+		StatPrefix: statPrefix,
+		TokenBucket: &typev3.TokenBucket{
+			MaxTokens:     limit.Requests,
+			TokensPerFill: wrapperspb.UInt32(limit.Requests),
+			FillInterval:  ratelimit.UnitToDuration(limit.Unit),
+		},
+	})
+}
+
+// buildTCPBandwidthLimitFilter builds the TCP bandwidth limit filter, the request limit
+// applies to the data read from the client, and the response limit to the data written to it.
+func buildTCPBandwidthLimitFilter(statPrefix string, bandwidthLimit *ir.BandwidthLimit) (*listenerv3.Filter, error) {
+	bwl := &tcpbwlimitv3.TcpBandwidthLimit{
+		StatPrefix: statPrefix,
+	}
+	if bandwidthLimit.Request != nil {
+		bwl.ReadLimitKbps = wrapperspb.UInt64(bandwidthLimit.Request.LimitKibps)
+	}
+	if bandwidthLimit.Response != nil {
+		bwl.WriteLimitKbps = wrapperspb.UInt64(bandwidthLimit.Response.LimitKibps)
+	}
+	return toNetworkFilter(networkTCPBandwidthLimit, bwl)
+}
+
+// tcpLocalRateLimit returns the local rate limit of the TCP route, if any.
+func tcpLocalRateLimit(irRoute *ir.TCPRoute) *ir.LocalRateLimit {
+	if irRoute.RateLimit == nil {
+		return nil
+	}
+	return irRoute.RateLimit.Local
+}
+
+// buildTCPRateLimitFilterChains builds a filter chain for each source CIDR of the local rate limit
+// rules of the TCP route. The filter chains are copies of the filter chain of the route matching the
+// source CIDR, with the rate limits of the rules matching it.
+//
+// Envoy selects the filter chain of a connection with the longest source prefix matching the client
+// address, so only the rules of the most specific CIDR apply, regardless of the order of the rules.
+// The rules sharing the same CIDR are merged into a single filter chain, where all their limits apply.
+func buildTCPRateLimitFilterChains(
+	irRoute *ir.TCPRoute,
+	filterChain *listenerv3.FilterChain,
+	statPrefix string,
+) ([]*listenerv3.FilterChain, error) {
+	local := tcpLocalRateLimit(irRoute)
+	if local == nil {
+		return nil, nil
+	}
+
+	var (
+		filterChains []*listenerv3.FilterChain
+		// The index of the filter chain of each source CIDR in filterChains.
+		cidrFilterChains = map[string]int{}
+	)
+	for i, rule := range local.Rules {
+		if rule.CIDRMatch == nil {
+			continue
+		}
+
+		rateLimitFilter, err := buildTCPLocalRateLimitFilter(statPrefix, rule.Limit)
+		if err != nil {
+			return nil, err
+		}
+
+		cidr := fmt.Sprintf("%s/%d", rule.CIDRMatch.AddressPrefix(), rule.CIDRMatch.MaskLen)
+		if j, ok := cidrFilterChains[cidr]; ok {
+			filterChains[j].Filters = addTCPRateLimitFilter(filterChains[j].Filters, rateLimitFilter)
+			continue
+		}
+
+		ruleFilterChain := protobuf.Clone(filterChain).(*listenerv3.FilterChain)
+		ruleFilterChain.Name = fmt.Sprintf("%s/ratelimit/%d", filterChain.Name, i)
+		for j, filter := range ruleFilterChain.Filters {
+			if filter.Name == networkLocalRateLimit {
+				ruleFilterChain.Filters[j] = rateLimitFilter
+			}
+		}
+		if ruleFilterChain.FilterChainMatch == nil {
+			ruleFilterChain.FilterChainMatch = &listenerv3.FilterChainMatch{}
+		}
+		ruleFilterChain.FilterChainMatch.SourcePrefixRanges = []*corev3.CidrRange{
+			{
+				AddressPrefix: rule.CIDRMatch.AddressPrefix(),
+				PrefixLen:     wrapperspb.UInt32(rule.CIDRMatch.MaskLen),
+			},
+		}
+		cidrFilterChains[cidr] = len(filterChains)
+		filterChains = append(filterChains, ruleFilterChain)
+	}
+
+	return filterChains, nil
+}
+
+// addTCPRateLimitFilter inserts the rate limit filter after the last rate limit filter of the filters.
+func addTCPRateLimitFilter(filters []*listenerv3.Filter, rateLimitFilter *listenerv3.Filter) []*listenerv3.Filter {
+	i := len(filters)
+	for j, filter := range filters {
+		if filter.Name == networkLocalRateLimit {
+			i = j + 1
+		}
+	}
+	return slices.Insert(filters, i, rateLimitFilter)
+}
@@ -7,6 +7,7 @@ package translator
 
 import (
 	"errors"
+	"fmt"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
@@ -82,13 +83,13 @@ func buildHCMLuaFilter(lua ir.Lua) (*hcmv3.HttpFilter, error) {
 		return nil, err
 	}
 
-	return &hcmv3.HttpFilter{
+	return wrapFilterWithMatch(&hcmv3.HttpFilter{
 		Name:     luaFilterName(lua),
 		Disabled: true,
 		ConfigType: &hcmv3.HttpFilter_TypedConfig{
 			TypedConfig: luaAny,
 		},
-	}, nil
+	}, lua.Match)
 }
 
 func luaFilterName(lua ir.Lua) string {
@@ -141,6 +142,12 @@ func buildLuaRouteFilterConfig(lua ir.Lua) (proto.Message, error) {
 		}, nil
 	}
 
+	// The filter with a match is wrapped in a composite filter, which doesn't accept
+	// the LuaPerRoute configuration.
+	if lua.Match != nil {
+		return nil, fmt.Errorf("lua %s: filter context is not supported with match", lua.Name)
+	}
+
 	filterCtx := &structpb.Struct{}
 	if err := protojson.Unmarshal(lua.FilterContext.Raw, filterCtx); err != nil {
 		return nil, err
@@ -0,0 +1,486 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	cncfv3 "github.com/cncf/xds/go/xds/core/v3"
+	matcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	rlqsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rate_limit_quota/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	networkinput "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/proto"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	rateLimitQuotaBucketKeyName       = "name"
+	rateLimitQuotaBucketKeyRoute      = "route"
+	rateLimitQuotaBucketKeySourceIP   = "source_ip"
+	rateLimitQuotaBucketSettingsName  = "rate_limit_quota_bucket_settings"
+	rateLimitQuotaBucketKeyHeader     = "header:"
+	rateLimitQuotaBucketKeyQueryParam = "query_param:"
+)
+
+// patchHCMWithRateLimitQuota builds and appends the Rate Limit Quota Filters to the HTTP connection
+// manager if applicable and they do not already exist.
+// Note: a separate filter is created for each route, because the bucket matchers can't be
+// overridden per route without the filter being enabled on all the routes.
+func (t *Translator) patchHCMWithRateLimitQuota(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	// Return early if the rate limit quota service is not configured.
+	if t.RateLimitQuota == nil {
+		return nil
+	}
+
+	for _, route := range irListener.Routes {
+		if !routeContainsRateLimitQuota(route) {
+			continue
+		}
+
+		filterName := rateLimitQuotaFilterName(route)
+		if hcmContainsFilter(mgr, filterName) {
+			continue
+		}
+
+		filter, err := buildRateLimitQuotaFilter(route)
+		if err != nil {
+			return err
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return nil
+}
+
+func rateLimitQuotaFilterName(route *ir.HTTPRoute) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterRateLimitQuota, route.Name)
+}
+
+// buildRateLimitQuotaFilter returns a Rate Limit Quota filter from the provided route.
+func buildRateLimitQuotaFilter(route *ir.HTTPRoute) (*hcmv3.HttpFilter, error) {
+	quota := route.Traffic.RateLimit.Quota
+
+	bucketMatchers, err := buildRateLimitQuotaBucketMatchers(route, quota)
+	if err != nil {
+		return nil, err
+	}
+
+	rlqsProto := &rlqsv3.RateLimitQuotaFilterConfig{
+		RlqsServer: &corev3.GrpcService{
+			TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
+					ClusterName: getRateLimitQuotaServiceClusterName(),
+				},
+			},
+		},
+		Domain:         quota.Domain,
+		BucketMatchers: bucketMatchers,
+	}
+
+	rlqsAny, err := proto.ToAnyWithValidation(rlqsProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name: rateLimitQuotaFilterName(route),
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: rlqsAny,
+		},
+		Disabled: true,
+	}, nil
+}
+
+// buildRateLimitQuotaBucketMatchers builds the matcher that assigns the requests to the buckets.
+// The rules are evaluated in order, and the first match wins. The rule without match
+// conditions, if any, is used for the requests that don't match any other rule.
+func buildRateLimitQuotaBucketMatchers(route *ir.HTTPRoute, quota *ir.QuotaRateLimit) (*matcherv3.Matcher, error) {
+	var (
+		matchers  []*matcherv3.Matcher_MatcherList_FieldMatcher
+		onNoMatch *matcherv3.Matcher_OnMatch
+	)
+
+	for _, rule := range quota.Rules {
+		action, err := buildRateLimitQuotaBucketAction(route, quota, rule)
+		if err != nil {
+			return nil, err
+		}
+
+		if !rule.IsMatchSet() {
+			onNoMatch = &matcherv3.Matcher_OnMatch{
+				OnMatch: &matcherv3.Matcher_OnMatch_Action{Action: action},
+			}
+			continue
+		}
+
+		predicates, err := buildRateLimitQuotaPredicates(rule)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, &matcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: andPredicate(predicates),
+			OnMatch: &matcherv3.Matcher_OnMatch{
+				OnMatch: &matcherv3.Matcher_OnMatch_Action{Action: action},
+			},
+		})
+	}
+
+	bucketMatchers := &matcherv3.Matcher{
+		OnNoMatch: onNoMatch,
+	}
+	if len(matchers) > 0 {
+		bucketMatchers.MatcherType = &matcherv3.Matcher_MatcherList_{
+			MatcherList: &matcherv3.Matcher_MatcherList{
+				Matchers: matchers,
+			},
+		}
+	}
+
+	return bucketMatchers, nil
+}
+
+// buildRateLimitQuotaPredicates builds the predicates that must all hold true for
+// a request to be assigned to the bucket of the provided rule.
+func buildRateLimitQuotaPredicates(rule *ir.RateLimitRule) ([]*matcherv3.Matcher_MatcherList_Predicate, error) {
+	var predicates []*matcherv3.Matcher_MatcherList_Predicate
+
+	for _, header := range rule.HeaderMatches {
+		input, err := proto.ToAnyWithValidation(&envoymatcherv3.HttpRequestHeaderMatchInput{
+			HeaderName: header.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		predicate, err := buildRateLimitQuotaStringPredicate("http_header", input, header)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	// Multiple methods are ORed together.
+	if len(rule.MethodMatches) > 0 {
+		input, err := proto.ToAnyWithValidation(&envoymatcherv3.HttpRequestHeaderMatchInput{
+			HeaderName: ":method",
+		})
+		if err != nil {
+			return nil, err
+		}
+		methodPredicates := make([]*matcherv3.Matcher_MatcherList_Predicate, 0, len(rule.MethodMatches))
+		for _, method := range rule.MethodMatches {
+			predicate, err := buildRateLimitQuotaStringPredicate("http_header", input, method)
+			if err != nil {
+				return nil, err
+			}
+			methodPredicates = append(methodPredicates, predicate)
+		}
+		if len(methodPredicates) == 1 {
+			predicates = append(predicates, methodPredicates[0])
+		} else {
+			predicates = append(predicates, &matcherv3.Matcher_MatcherList_Predicate{
+				MatchType: &matcherv3.Matcher_MatcherList_Predicate_OrMatcher{
+					OrMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+						Predicate: methodPredicates,
+					},
+				},
+			})
+		}
+	}
+
+	if rule.PathMatch != nil {
+		input, err := proto.ToAnyWithValidation(&envoymatcherv3.HttpRequestHeaderMatchInput{
+			HeaderName: ":path",
+		})
+		if err != nil {
+			return nil, err
+		}
+		predicate, err := buildRateLimitQuotaStringPredicate("http_header", input, rule.PathMatch)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	if rule.CIDRMatch != nil {
+		predicate, err := buildIPPredicate([]*ir.CIDRMatch{rule.CIDRMatch})
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, wrapPredicateWithNot(predicate, rule.CIDRMatch.Invert))
+	}
+
+	for _, queryParam := range rule.QueryParamMatches {
+		input, err := proto.ToAnyWithValidation(&envoymatcherv3.HttpRequestQueryParamMatchInput{
+			QueryParam: queryParam.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		predicate, err := buildRateLimitQuotaStringPredicate("http_query_param", input, &queryParam.StringMatch)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	return predicates, nil
+}
+
+// buildRateLimitQuotaStringPredicate builds a predicate matching the provided input.
+// A distinct match only requires the input to be present, each of its unique values
+// is assigned to a separate bucket by the bucket ID builder.
+func buildRateLimitQuotaStringPredicate(inputName string, input *anypb.Any, match *ir.StringMatch) (*matcherv3.Matcher_MatcherList_Predicate, error) {
+	var (
+		stringMatcher *matcherv3.StringMatcher
+		err           error
+	)
+
+	if match.Distinct {
+		stringMatcher = &matcherv3.StringMatcher{
+			MatchPattern: &matcherv3.StringMatcher_SafeRegex{SafeRegex: &matcherv3.RegexMatcher{
+				Regex:      ".*",
+				EngineType: &matcherv3.RegexMatcher_GoogleRe2{GoogleRe2: &matcherv3.RegexMatcher_GoogleRE2{}},
+			}},
+		}
+	} else if stringMatcher, err = buildStringMatcher(*match); err != nil {
+		return nil, err
+	}
+
+	return wrapPredicateWithNot(&matcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &cncfv3.TypedExtensionConfig{
+					Name:        inputName,
+					TypedConfig: input,
+				},
+				Matcher: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+					ValueMatch: stringMatcher,
+				},
+			},
+		},
+	}, match.Invert != nil && *match.Invert), nil
+}
+
+// buildRateLimitQuotaBucketAction builds the bucket settings action of the provided rule.
+func buildRateLimitQuotaBucketAction(route *ir.HTTPRoute, quota *ir.QuotaRateLimit, rule *ir.RateLimitRule) (*cncfv3.TypedExtensionConfig, error) {
+	bucketIDBuilder, err := buildRateLimitQuotaBucketIDBuilder(route, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	blanketRule := typev3.RateLimitStrategy_ALLOW_ALL
+	if quota.FallbackDenyAll {
+		blanketRule = typev3.RateLimitStrategy_DENY_ALL
+	}
+	fallback := &typev3.RateLimitStrategy{
+		Strategy: &typev3.RateLimitStrategy_BlanketRule_{
+			BlanketRule: blanketRule,
+		},
+	}
+
+	settings := &rlqsv3.RateLimitQuotaBucketSettings{
+		BucketIdBuilder:   bucketIDBuilder,
+		ReportingInterval: durationpb.New(quota.ReportingInterval.Duration),
+		NoAssignmentBehavior: &rlqsv3.RateLimitQuotaBucketSettings_NoAssignmentBehavior{
+			NoAssignmentBehavior: &rlqsv3.RateLimitQuotaBucketSettings_NoAssignmentBehavior_FallbackRateLimit{
+				FallbackRateLimit: fallback,
+			},
+		},
+		ExpiredAssignmentBehavior: &rlqsv3.RateLimitQuotaBucketSettings_ExpiredAssignmentBehavior{
+			ExpiredAssignmentBehavior: &rlqsv3.RateLimitQuotaBucketSettings_ExpiredAssignmentBehavior_FallbackRateLimit{
+				FallbackRateLimit: fallback,
+			},
+		},
+	}
+
+	settingsAny, err := proto.ToAnyWithValidation(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cncfv3.TypedExtensionConfig{
+		Name:        rateLimitQuotaBucketSettingsName,
+		TypedConfig: settingsAny,
+	}, nil
+}
+
+// buildRateLimitQuotaBucketIDBuilder builds the bucket ID of the provided rule.
+// The bucket ID contains the rule name, the route name unless the rule is shared,
+// and the values of the distinct matches.
+func buildRateLimitQuotaBucketIDBuilder(route *ir.HTTPRoute, rule *ir.RateLimitRule) (*rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder, error) {
+	builder := map[string]*rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder_ValueBuilder{
+		rateLimitQuotaBucketKeyName: stringBucketIDValue(rule.Name),
+	}
+	if rule.Shared == nil || !*rule.Shared {
+		builder[rateLimitQuotaBucketKeyRoute] = stringBucketIDValue(route.Name)
+	}
+
+	for _, header := range rule.HeaderMatches {
+		if !header.Distinct {
+			continue
+		}
+		value, err := customBucketIDValue("http_header", &envoymatcherv3.HttpRequestHeaderMatchInput{
+			HeaderName: header.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		builder[rateLimitQuotaBucketKeyHeader+header.Name] = value
+	}
+
+	for _, queryParam := range rule.QueryParamMatches {
+		if !queryParam.Distinct {
+			continue
+		}
+		value, err := customBucketIDValue("http_query_param", &envoymatcherv3.HttpRequestQueryParamMatchInput{
+			QueryParam: queryParam.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		builder[rateLimitQuotaBucketKeyQueryParam+queryParam.Name] = value
+	}
+
+	if rule.CIDRMatch != nil && rule.CIDRMatch.Distinct {
+		value, err := customBucketIDValue("client_ip", &networkinput.SourceIPInput{})
+		if err != nil {
+			return nil, err
+		}
+		builder[rateLimitQuotaBucketKeySourceIP] = value
+	}
+
+	return &rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder{
+		BucketIdBuilder: builder,
+	}, nil
+}
+
+func stringBucketIDValue(value string) *rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder_ValueBuilder {
+	return &rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder_ValueBuilder{
+		ValueSpecifier: &rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder_ValueBuilder_StringValue{
+			StringValue: value,
+		},
+	}
+}
+
+func customBucketIDValue(name string, input protobuf.Message) (*rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder_ValueBuilder, error) {
+	inputAny, err := proto.ToAnyWithValidation(input)
+	if err != nil {
+		return nil, err
+	}
+	return &rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder_ValueBuilder{
+		ValueSpecifier: &rlqsv3.RateLimitQuotaBucketSettings_BucketIdBuilder_ValueBuilder_CustomValue{
+			CustomValue: &corev3.TypedExtensionConfig{
+				Name:        name,
+				TypedConfig: inputAny,
+			},
+		},
+	}, nil
+}
+
+// patchRouteWithRateLimitQuota enables the Rate Limit Quota filter of the provided route if applicable.
+func patchRouteWithRateLimitQuota(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsRateLimitQuota(irRoute) {
+		return nil
+	}
+
+	return enableFilterOnRoute(route, rateLimitQuotaFilterName(irRoute), &routev3.FilterConfig{
+		Config: &anypb.Any{},
+	})
+}
+
+// routeContainsRateLimitQuota returns true if rate limit quota exists for the provided route.
+func routeContainsRateLimitQuota(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil &&
+		irRoute.Traffic != nil &&
+		irRoute.Traffic.RateLimit != nil &&
+		irRoute.Traffic.RateLimit.Quota != nil
+}
+
+func containsRateLimitQuota(httpListeners []*ir.HTTPListener) bool {
+	for _, httpListener := range httpListeners {
+		for _, route := range httpListener.Routes {
+			if routeContainsRateLimitQuota(route) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getRateLimitQuotaServiceClusterName() string {
+	return "rate_limit_quota_cluster"
+}
+
+func (t *Translator) createRateLimitQuotaServiceCluster(tCtx *types.ResourceVersionTable, globalResources *ir.GlobalResources, metrics *ir.Metrics) error {
+	clusterName := getRateLimitQuotaServiceClusterName()
+	u, err := url.Parse(t.RateLimitQuota.ServiceURL)
+	if err != nil {
+		return fmt.Errorf("invalid rate limit quota service url %q: %w", t.RateLimitQuota.ServiceURL, err)
+	}
+	port, err := strconv.ParseUint(u.Port(), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid rate limit quota service url %q: %w", t.RateLimitQuota.ServiceURL, err)
+	}
+
+	ds := &ir.DestinationSetting{
+		Weight:    new(uint32(1)),
+		Protocol:  ir.GRPC,
+		Endpoints: []*ir.DestinationEndpoint{ir.NewDestEndpoint(nil, u.Hostname(), uint32(port), false, nil)},
+		Name:      destinationSettingName(clusterName),
+	}
+
+	if t.RateLimitQuota.TLSEnabled {
+		// Don't fall back to plaintext when the TLS config couldn't be built.
+		if globalResources == nil || globalResources.RateLimitQuotaTLS == nil {
+			return fmt.Errorf("missing TLS config for the rate limit quota service %q", t.RateLimitQuota.ServiceURL)
+		}
+		ds.TLS = globalResources.RateLimitQuotaTLS.DeepCopy()
+		if net.ParseIP(u.Hostname()) == nil {
+			ds.TLS.SNI = new(u.Hostname())
+		}
+	}
+
+	var timeout *ir.ClusterTimeout
+	if t.RateLimitQuota.Timeout > 0 {
+		timeout = &ir.ClusterTimeout{
+			TCP: &ir.TCPTimeout{
+				ConnectTimeout: ir.MetaV1DurationPtr(t.RateLimitQuota.Timeout),
+			},
+		}
+	}
+
+	if err := addXdsCluster(tCtx, &xdsClusterArgs{
+		name:         clusterName,
+		settings:     []*ir.DestinationSetting{ds},
+		endpointType: EndpointTypeDNS,
+		timeout:      timeout,
+		metrics:      metrics,
+	}); err != nil {
+		return err
+	}
+	return processClientCertificates(tCtx, []*ir.DestinationSetting{ds})
+}
@@ -143,6 +143,11 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute, httpListener *ir.HTTPListener, backe
 		} else {
 			return nil, err
 		}
+		if httpRoute.GetRetry().Hedge != nil {
+			router.GetRoute().HedgePolicy = &routev3.HedgePolicy{
+				HedgeOnPerTryTimeout: true,
+			}
+		}
 	}
 
 	// Telemetry
@@ -847,6 +852,34 @@ func buildRetryPolicy(route *ir.HTTPRoute) (*routev3.RetryPolicy, error) {
 		rp.NumRetries = &wrapperspb.UInt32Value{Value: *rr.NumRetries}
 	}
 
+	if rr.HostSelection != nil {
+		if !rr.HostSelection.AvoidPreviousHosts {
+			rp.RetryHostPredicate = nil
+		}
+		if rr.HostSelection.MaxAttempts != nil {
+			rp.HostSelectionRetryMaxAttempts = int64(*rr.HostSelection.MaxAttempts)
+		}
+	}
+
+	// A hedged request may be processed more than once by the upstream, so the hedging,
+	// and the retries, are limited to the requests with the given methods.
+	if rr.Hedge != nil && len(rr.Hedge.Methods) > 0 {
+		rp.RetriableRequestHeaders = []*routev3.HeaderMatcher{
+			{
+				Name: ":method",
+				HeaderMatchSpecifier: &routev3.HeaderMatcher_StringMatch{
+					StringMatch: &matcherv3.StringMatcher{
+						MatchPattern: &matcherv3.StringMatcher_SafeRegex{
+							SafeRegex: &matcherv3.RegexMatcher{
+								Regex: "^(" + strings.Join(rr.Hedge.Methods, "|") + ")$",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
 	if rr.NumAttemptsPerPriority != nil && *rr.NumAttemptsPerPriority > 0 {
 		anyCfgPriority, err := proto.ToAnyWithValidation(&previouspriority.PreviousPrioritiesConfig{
 			UpdateFrequency: *rr.NumAttemptsPerPriority,
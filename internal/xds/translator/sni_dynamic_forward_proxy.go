@@ -48,9 +48,17 @@ func buildSNIDynamicForwardProxyFilter(irRoute *ir.TCPRoute) (*listenerv3.Filter
 	// The DNS cache must match the one used by the dynamic forward proxy cluster so that both
 	// share the same resolved addresses. The cluster is built with a nil IP family for TCP routes
 	// (see processTCPListenerXdsTranslation), so the same is used here to keep the cache name in sync.
-	dnsLookupFamily := computeDNSLookupFamily(nil, irRoute.DNS)
-	cacheName := dfpCacheName(nil, irRoute.DNS)
-	dnsCacheConfig := buildDFPDNSCacheConfig(cacheName, irRoute.DNS, dnsLookupFamily)
+	var settings []*ir.DestinationSetting
+	if irRoute.Destination != nil {
+		settings = irRoute.Destination.Settings
+	}
+	dns := clusterDNS(irRoute.DNS, settings)
+	dnsLookupFamily := computeDNSLookupFamily(nil, dns)
+	cacheName := dfpCacheName(nil, dns)
+	dnsCacheConfig, err := buildDFPDNSCacheConfig(cacheName, dns, dnsLookupFamily)
+	if err != nil {
+		return nil, err
+	}
 
 	cfg := &snidfpv3.FilterConfig{
 		DnsCacheConfig: dnsCacheConfig,
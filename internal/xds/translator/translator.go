@@ -58,6 +58,10 @@ type Translator struct {
 	// required during xds translation.
 	GlobalRateLimit *GlobalRateLimitSettings
 
+	// RateLimitQuota holds the rate limit quota service settings
+	// required during xds translation.
+	RateLimitQuota *RateLimitQuotaSettings
+
 	// ExtensionManager holds the config for interacting with extensions when generating xDS
 	// resources. Only required during xds translation.
 	ExtensionManager *extensionTypes.Manager
@@ -96,6 +100,20 @@ type GlobalRateLimitSettings struct {
 	FailClosed bool
 }
 
+type RateLimitQuotaSettings struct {
+	// ServiceURL is the URL of the rate limit
+	// quota service, in the form grpc://<host>:<port>.
+	ServiceURL string
+
+	// Timeout specifies the timeout period for the proxy to connect to the
+	// rate limit quota server. If not set, timeout is 10s.
+	Timeout time.Duration
+
+	// TLSEnabled is true when the proxy connects to the rate limit quota
+	// server over TLS, with the TLS config in the global resources of the IR.
+	TLSEnabled bool
+}
+
 // Translate translates the XDS IR into xDS resources
 func (t *Translator) Translate(xdsIR *ir.Xds) (*types.ResourceVersionTable, error) {
 	if xdsIR == nil {
@@ -115,6 +115,9 @@ func TestTranslateXds(t *testing.T) {
 		"tracing-unknown-provider-type": {
 			errMsg: "unknown tracing provider type: AwesomeTelemetry",
 		},
+		"lua-with-match-and-filter-context": {
+			errMsg: "filter context is not supported with match",
+		},
 		"xds-name-scheme-v2": {
 			runtimeFlags: &egv1a1.RuntimeFlags{
 				Enabled: []egv1a1.RuntimeFlag{egv1a1.XDSNameSchemeV2},
@@ -162,6 +165,10 @@ func TestTranslateXds(t *testing.T) {
 				GlobalRateLimit: &GlobalRateLimitSettings{
 					ServiceURL: ratelimit.GetServiceURL("envoy-gateway-system", dnsDomain),
 				},
+				RateLimitQuota: &RateLimitQuotaSettings{
+					ServiceURL: "grpc://rlqs.quota-system.svc.cluster.local:18081",
+					TLSEnabled: x.GlobalResources != nil && x.GlobalResources.RateLimitQuotaTLS != nil,
+				},
 				FilterOrder:  x.FilterOrder,
 				RuntimeFlags: cfg.runtimeFlags,
 			}
@@ -0,0 +1,143 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&waf{})
+}
+
+type waf struct{}
+
+var _ httpFilter = &waf{}
+
+// patchHCM builds and appends the WAF Filters to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: this method creates a WAF filter for each unique WAF config, the filter
+// is disabled by default. It is enabled on the route level.
+func (*waf) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	var errs error
+	for _, route := range irListener.Routes {
+		if !routeContainsWAF(route) {
+			continue
+		}
+		if hcmContainsFilter(mgr, wafFilterName(route.Security.WAF)) {
+			continue
+		}
+		filter, err := buildHCMWAFFilter(route.Security.WAF)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// buildHCMWAFFilter returns a Wasm or dynamic module HTTP filter running the WAF rule engine.
+func buildHCMWAFFilter(w *ir.WAF) (*hcmv3.HttpFilter, error) {
+	var (
+		wafProto proto.Message
+		err      error
+	)
+
+	switch {
+	case w.Wasm != nil:
+		wafProto, err = wasmConfig(w.Wasm)
+	case w.DynamicModule != nil:
+		wafProto, err = dynamicModuleConfig(w.DynamicModule)
+	default:
+		err = fmt.Errorf("WAF %s has no rule engine", w.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	wafAny, err := anypb.New(wafProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     wafFilterName(w),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: wafAny,
+		},
+	}, nil
+}
+
+func wafFilterName(w *ir.WAF) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterWAF, w.Name)
+}
+
+// routeContainsWAF returns true if WAF exists for the provided route.
+func routeContainsWAF(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.Security != nil && irRoute.Security.WAF != nil
+}
+
+// patchResources creates the clusters for the remote dynamic module sources of
+// the WAF rule engines. The Wasm code is served by the built-in HTTP server of
+// Envoy Gateway, which has been configured in the bootstrap configuration.
+func (*waf) patchResources(tCtx *types.ResourceVersionTable, routes []*ir.HTTPRoute) error {
+	if tCtx == nil || tCtx.XdsResources == nil {
+		return errors.New("xds resource table is nil")
+	}
+
+	var errs error
+	for _, route := range routes {
+		if !routeContainsWAF(route) {
+			continue
+		}
+		dm := route.Security.WAF.DynamicModule
+		if dm == nil || dm.Remote == nil {
+			continue
+		}
+		if err := addClusterFromURL(dm.Remote.URL, nil, tCtx); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// patchRoute patches the provided route so the WAF filter is enabled if applicable.
+func (*waf) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute, _ *ir.HTTPListener) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsWAF(irRoute) {
+		return nil
+	}
+
+	return enableFilterOnRoute(route, wafFilterName(irRoute.Security.WAF), &routev3.FilterConfig{
+		Config: &anypb.Any{},
+	})
+}
@@ -95,13 +95,13 @@ func buildHCMWasmFilter(wasm *ir.Wasm) (*hcmv3.HttpFilter, error) {
 
 	// All wasm filters for all Routes are aggregated on HCM and disabled by default
 	// Per-route config is used to enable the relevant filters on appropriate routes
-	return &hcmv3.HttpFilter{
+	return wrapFilterWithMatch(&hcmv3.HttpFilter{
 		Name:     wasmFilterName(wasm),
 		Disabled: true,
 		ConfigType: &hcmv3.HttpFilter_TypedConfig{
 			TypedConfig: wasmAny,
 		},
-	}, nil
+	}, wasm.Match)
 }
 
 func wasmFilterName(wasm *ir.Wasm) string {
@@ -403,6 +403,67 @@ func TestBackend(t *testing.T) {
 			},
 			wantErrors: []string{"DynamicResolver type cannot use autoSNIFromEndpointHostname"},
 		},
+		{
+			desc: "dnsResolver with FQDN endpoints",
+			mutate: func(backend *egv1a1.Backend) {
+				backend.Spec = egv1a1.BackendSpec{
+					Endpoints: []egv1a1.BackendEndpoint{
+						{
+							FQDN: &egv1a1.FQDNEndpoint{
+								Hostname: "example.com",
+								Port:     443,
+							},
+						},
+					},
+					DNSResolver: &egv1a1.DNSResolver{
+						Addresses: []egv1a1.IPEndpoint{
+							{
+								Address: "10.0.0.53",
+								Port:    53,
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "dnsResolver with IP endpoints",
+			mutate: func(backend *egv1a1.Backend) {
+				backend.Spec = egv1a1.BackendSpec{
+					Endpoints: []egv1a1.BackendEndpoint{
+						{
+							IP: &egv1a1.IPEndpoint{
+								Address: "1.1.1.1",
+								Port:    443,
+							},
+						},
+					},
+					DNSResolver: &egv1a1.DNSResolver{
+						UseTCP: new(true),
+					},
+				}
+			},
+			wantErrors: []string{"dnsResolver can only be used with FQDN endpoints or the DynamicResolver type"},
+		},
+		{
+			desc: "dnsResolver with GetAddrInfo and addresses",
+			mutate: func(backend *egv1a1.Backend) {
+				backend.Spec = egv1a1.BackendSpec{
+					Type: new(egv1a1.BackendTypeDynamicResolver),
+					DNSResolver: &egv1a1.DNSResolver{
+						Type: new(egv1a1.GetAddrInfoDNSResolverType),
+						Addresses: []egv1a1.IPEndpoint{
+							{
+								Address: "10.0.0.53",
+								Port:    53,
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"addresses, useTCP and noDefaultSearchDomain are only supported by the CAres resolver"},
+		},
 		{
 			desc: "autoSNIFromEndpointHostname enabled with IP and Unix endpoint with hostname",
 			mutate: func(backend *egv1a1.Backend) {
@@ -323,6 +323,74 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 			},
 			wantErrors: []string{"admissionControl can only be used with HTTPRoute, GRPCRoute, Gateway, or ListenerSet targets"},
 		},
+		{
+			desc: "cache allowed on HTTPRoute target",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("HTTPRoute"),
+								Name:  gwapiv1.ObjectName("http-route"),
+							},
+						},
+					},
+					Cache: &egv1a1.Cache{
+						Type: egv1a1.FileSystemCacheType,
+						Key: &egv1a1.CacheKey{
+							ExcludeHost:             new(true),
+							IncludedQueryParameters: []string{"page"},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "cache rejected on TCPRoute target",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("TCPRoute"),
+								Name:  gwapiv1.ObjectName("tcp-route"),
+							},
+						},
+					},
+					Cache: &egv1a1.Cache{
+						Type: egv1a1.FileSystemCacheType,
+					},
+				}
+			},
+			wantErrors: []string{"cache can only be used with HTTPRoute, GRPCRoute, Gateway, or ListenerSet targets"},
+		},
+		{
+			desc: "cache key with both included and excluded query parameters",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("Gateway"),
+								Name:  gwapiv1.ObjectName("eg"),
+							},
+						},
+					},
+					Cache: &egv1a1.Cache{
+						Type: egv1a1.FileSystemCacheType,
+						Key: &egv1a1.CacheKey{
+							IncludedQueryParameters: []string{"page"},
+							ExcludedQueryParameters: []string{"session"},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"only one of includedQueryParameters or excludedQueryParameters can be set"},
+		},
 		{
 			desc: "admissionControl allowed on Gateway target",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
@@ -3393,7 +3461,7 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 					},
 				}
 			},
-			wantErrors: []string{"at least one of headers, methods, path, sourceCIDR or queryParams must be specified"},
+			wantErrors: []string{"at least one of headers, methods, path, sourceCIDR, queryParams or clientIPTags must be specified"},
 		},
 		{
 			desc: "panicThreshold is set",
@@ -3823,6 +3891,193 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 			},
 			wantErrors: []string{"either compression or compressor can be set, not both"},
 		},
+		{
+			desc: "valid decompression",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					Decompression: &egv1a1.Decompression{
+						Decompressor: []*egv1a1.Decompressor{
+							{Type: egv1a1.GzipCompressorType},
+							{Type: egv1a1.ZstdCompressorType},
+						},
+						Response: new(true),
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "decompression with duplicated types",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					Decompression: &egv1a1.Decompression{
+						Decompressor: []*egv1a1.Decompressor{
+							{Type: egv1a1.GzipCompressorType},
+							{Type: egv1a1.GzipCompressorType},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"decompressor types must be unique"},
+		},
+		{
+			desc: "valid quota rateLimit",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					RateLimit: &egv1a1.RateLimitSpec{
+						Quota: &egv1a1.QuotaRateLimit{
+							Domain:            new("shared-quota"),
+							ReportingInterval: new(gwapiv1.Duration("5s")),
+							FallbackAction:    new(egv1a1.QuotaFallbackActionDenyAll),
+							Rules: []egv1a1.QuotaRateLimitRule{
+								{
+									ClientSelectors: []egv1a1.RateLimitSelectCondition{
+										{
+											Headers: []egv1a1.HeaderMatch{
+												{
+													Type: new(egv1a1.HeaderMatchDistinct),
+													Name: "x-org-id",
+												},
+											},
+										},
+									},
+								},
+								{},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "quota rateLimit with multiple rules without clientSelectors",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					RateLimit: &egv1a1.RateLimitSpec{
+						Quota: &egv1a1.QuotaRateLimit{
+							Rules: []egv1a1.QuotaRateLimitRule{
+								{},
+								{Shared: new(true)},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"at most one rule without clientSelectors can be specified"},
+		},
+		{
+			desc: "valid adaptiveConcurrency",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					AdaptiveConcurrency: &egv1a1.AdaptiveConcurrency{
+						SampleAggregatePercentile: new(uint32(90)),
+						ConcurrencyLimit: &egv1a1.AdaptiveConcurrencyLimit{
+							Min:            new(uint32(10)),
+							Max:            new(uint32(500)),
+							UpdateInterval: new(gwapiv1.Duration("100ms")),
+						},
+						MinRTT: &egv1a1.AdaptiveConcurrencyMinRTT{
+							Interval:      new(gwapiv1.Duration("30s")),
+							RequestCount:  new(uint32(50)),
+							JitterPercent: new(uint32(15)),
+							BufferPercent: new(uint32(25)),
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "adaptiveConcurrency with min greater than max",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					AdaptiveConcurrency: &egv1a1.AdaptiveConcurrency{
+						ConcurrencyLimit: &egv1a1.AdaptiveConcurrencyLimit{
+							Min: new(uint32(100)),
+							Max: new(uint32(10)),
+						},
+					},
+				}
+			},
+			wantErrors: []string{"min must be less than or equal to max"},
+		},
+		{
+			desc: "adaptiveConcurrency with zero minRTT interval",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					AdaptiveConcurrency: &egv1a1.AdaptiveConcurrency{
+						MinRTT: &egv1a1.AdaptiveConcurrencyMinRTT{
+							Interval: new(gwapiv1.Duration("0s")),
+						},
+					},
+				}
+			},
+			wantErrors: []string{"interval must be greater than 0s"},
+		},
 		{
 			desc: "valid bandwidthLimit with request only",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
@@ -4201,6 +4456,84 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 			},
 			wantErrors: []string{},
 		},
+		{
+			desc: "valid retry hedge",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						Retry: &egv1a1.Retry{
+							PerRetry: &egv1a1.PerRetryPolicy{
+								Timeout: new(gwapiv1.Duration("50ms")),
+							},
+							Hedge: &egv1a1.HedgePolicy{
+								Methods: []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodGet, gwapiv1.HTTPMethodHead},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "invalid retry hedge without per-retry timeout",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						Retry: &egv1a1.Retry{
+							Hedge: &egv1a1.HedgePolicy{
+								Methods: []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodGet},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"perRetry.timeout must be set when hedge is set"},
+		},
+		{
+			desc: "invalid retry hedge with non-idempotent method",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						Retry: &egv1a1.Retry{
+							PerRetry: &egv1a1.PerRetryPolicy{
+								Timeout: new(gwapiv1.Duration("50ms")),
+							},
+							Hedge: &egv1a1.HedgePolicy{
+								Methods: []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodGet, gwapiv1.HTTPMethodPost},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"only the idempotent methods GET, HEAD, OPTIONS, TRACE, PUT and DELETE can be hedged"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -943,6 +943,61 @@ func TestEnvoyExtensionPolicyTarget(t *testing.T) {
 			},
 			wantErrors: nil,
 		},
+		{
+			desc: "Valid Lua filter with match",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					Lua: []egv1a1.Lua{
+						{
+							Type:   egv1a1.LuaValueTypeInline,
+							Inline: new("function envoy_on_response(response_handle) -- Do something -- end"),
+							Match: &egv1a1.ExtensionMatch{
+								Headers: []egv1a1.AuthorizationHeaderMatch{
+									{Name: "x-tenant", Values: []string{"tenant-a"}},
+								},
+								Methods: []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodGet},
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: nil,
+		},
+		{
+			desc: "Invalid Lua filter with empty match",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					Lua: []egv1a1.Lua{
+						{
+							Type:   egv1a1.LuaValueTypeInline,
+							Inline: new("function envoy_on_response(response_handle) -- Do something -- end"),
+							Match:  &egv1a1.ExtensionMatch{},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.lua[0].match: Invalid value: \"object\": at least one of headers, path, methods or cel must be specified",
+			},
+		},
 		{
 			desc: "Invalid Lua filter (type inline but source configmap)",
 			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
@@ -1059,6 +1114,111 @@ func TestEnvoyExtensionPolicyTarget(t *testing.T) {
 				": Only a reference to an object of kind ConfigMap belonging to default v1 API group is supported.",
 			},
 		},
+		{
+			desc: "Valid gRPC-JSON transcoder (source configmap)",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+						ProtoDescriptorRef: gwapiv1.LocalObjectReference{
+							Kind:  gwapiv1.Kind("ConfigMap"),
+							Name:  gwapiv1.ObjectName("eg"),
+							Group: gwapiv1.Group("v1"),
+						},
+						Services: []string{"helloworld.Greeter"},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: nil,
+		},
+		{
+			desc: "Valid gRPC-JSON transcoder (source secret)",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+						ProtoDescriptorRef: gwapiv1.LocalObjectReference{
+							Kind:  gwapiv1.Kind("Secret"),
+							Name:  gwapiv1.ObjectName("eg"),
+							Group: gwapiv1.Group("v1"),
+						},
+						Services: []string{"helloworld.Greeter"},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: nil,
+		},
+		{
+			desc: "Invalid gRPC-JSON transcoder (source object kind not configmap or secret)",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+						ProtoDescriptorRef: gwapiv1.LocalObjectReference{
+							Kind:  gwapiv1.Kind("NotConfigMap"),
+							Name:  gwapiv1.ObjectName("eg"),
+							Group: gwapiv1.Group("v1"),
+						},
+						Services: []string{"helloworld.Greeter"},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.grpcJSONTranscoder.protoDescriptorRef: Invalid value:",
+				": Only a reference to an object of kind ConfigMap or Secret belonging to default v1 API group is supported.",
+			},
+		},
+		{
+			desc: "Invalid gRPC-JSON transcoder (no services)",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+						ProtoDescriptorRef: gwapiv1.LocalObjectReference{
+							Kind:  gwapiv1.Kind("ConfigMap"),
+							Name:  gwapiv1.ObjectName("eg"),
+							Group: gwapiv1.Group("v1"),
+						},
+						Services: []string{},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.grpcJSONTranscoder.services: Invalid value:",
+			},
+		},
 		{
 			desc: "Invalid Lua filter (source both inline and configmap)",
 			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
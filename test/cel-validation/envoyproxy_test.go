@@ -1071,6 +1071,38 @@ func TestEnvoyProxyProvider(t *testing.T) {
 			},
 			wantErrors: []string{"If MetricSink type is OpenTelemetry, openTelemetry field needs to be set"},
 		},
+		{
+			desc: "ProxyMetrics-grpcStats-pass",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Telemetry: &egv1a1.ProxyTelemetry{
+						Metrics: &egv1a1.ProxyMetrics{
+							EnableGRPCStats: new(true),
+							GRPCStats: &egv1a1.ProxyGRPCStats{
+								Services: []egv1a1.GRPCStatsService{
+									{Name: "helloworld.Greeter", Methods: []string{"SayHello"}},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "ProxyMetrics-grpcStats-with-disabled-grpc-stats",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Telemetry: &egv1a1.ProxyTelemetry{
+						Metrics: &egv1a1.ProxyMetrics{
+							EnableGRPCStats: new(false),
+							GRPCStats:       &egv1a1.ProxyGRPCStats{},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"grpcStats can't be set when enableGRPCStats is false"},
+		},
 		{
 			desc: "ProxyMetrics-sinks-pass",
 			mutate: func(envoy *egv1a1.EnvoyProxy) {
@@ -1636,7 +1636,36 @@ func TestSecurityPolicyTarget(t *testing.T) {
 					},
 				}
 			},
-			wantErrors: []string{"at least one of clientCIDRs, jwt, headers, or clientIPGeoLocations must be specified"},
+			wantErrors: []string{"at least one of clientCIDRs, jwt, headers, clientIPGeoLocations, clientIPTags, or clientCertificate must be specified"},
+		},
+		{
+			desc: "authorization-empty-client-certificate",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: new(gwapiv1.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action: egv1a1.AuthorizationActionAllow,
+								Principal: &egv1a1.Principal{
+									ClientCertificate: &egv1a1.ClientCertificatePrincipal{},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"at least one of subject, dnsNames, or uris must be specified"},
 		},
 		{
 			desc: "authorization-cel-only",
@@ -2213,6 +2242,214 @@ func TestSecurityPolicyTarget(t *testing.T) {
 			},
 			wantErrors: []string{"forwardAccessToken cannot be true when forwardIDToken.header is Authorization"},
 		},
+		{
+			desc: "waf-valid",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("HTTPRoute"),
+								Name:  gwapiv1.ObjectName("httproute"),
+							},
+						},
+					},
+					WAF: &egv1a1.WAF{
+						Engine: egv1a1.WAFEngine{
+							Type: egv1a1.WAFEngineTypeWasm,
+							Wasm: &egv1a1.WasmCodeSource{
+								Type: egv1a1.HTTPWasmCodeSourceType,
+								HTTP: &egv1a1.HTTPWasmCodeSource{
+									URL: "https://www.example.com/coraza-proxy-wasm.wasm",
+								},
+							},
+						},
+						RuleSets: []gwapiv1.LocalObjectReference{
+							{Kind: "ConfigMap", Name: "crs"},
+						},
+						Exclusions: []egv1a1.WAFRuleExclusion{
+							{
+								RuleIDs:    []uint32{942100},
+								PathPrefix: new("/search"),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "waf-engine-type-mismatch",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("HTTPRoute"),
+								Name:  gwapiv1.ObjectName("httproute"),
+							},
+						},
+					},
+					WAF: &egv1a1.WAF{
+						Engine: egv1a1.WAFEngine{
+							Type: egv1a1.WAFEngineTypeDynamicModule,
+							Wasm: &egv1a1.WasmCodeSource{
+								Type: egv1a1.HTTPWasmCodeSourceType,
+								HTTP: &egv1a1.HTTPWasmCodeSource{
+									URL: "https://www.example.com/coraza-proxy-wasm.wasm",
+								},
+							},
+						},
+						RuleSets: []gwapiv1.LocalObjectReference{
+							{Kind: "ConfigMap", Name: "crs"},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"If type is Wasm, wasm field needs to be set.", "If type is DynamicModule, dynamicModule field needs to be set."},
+		},
+		{
+			desc: "waf-rule-set-secret",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("HTTPRoute"),
+								Name:  gwapiv1.ObjectName("httproute"),
+							},
+						},
+					},
+					WAF: &egv1a1.WAF{
+						Engine: egv1a1.WAFEngine{
+							Type: egv1a1.WAFEngineTypeWasm,
+							Wasm: &egv1a1.WasmCodeSource{
+								Type: egv1a1.HTTPWasmCodeSourceType,
+								HTTP: &egv1a1.HTTPWasmCodeSource{
+									URL: "https://www.example.com/coraza-proxy-wasm.wasm",
+								},
+							},
+						},
+						RuleSets: []gwapiv1.LocalObjectReference{
+							{Kind: "Secret", Name: "crs"},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"Only a reference to an object of kind ConfigMap belonging to default v1 API group is supported."},
+		},
+		{
+			desc: "waf-empty-exclusion",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("HTTPRoute"),
+								Name:  gwapiv1.ObjectName("httproute"),
+							},
+						},
+					},
+					WAF: &egv1a1.WAF{
+						Engine: egv1a1.WAFEngine{
+							Type: egv1a1.WAFEngineTypeWasm,
+							Wasm: &egv1a1.WasmCodeSource{
+								Type: egv1a1.HTTPWasmCodeSourceType,
+								HTTP: &egv1a1.HTTPWasmCodeSource{
+									URL: "https://www.example.com/coraza-proxy-wasm.wasm",
+								},
+							},
+						},
+						RuleSets: []gwapiv1.LocalObjectReference{
+							{Kind: "ConfigMap", Name: "crs"},
+						},
+						Exclusions: []egv1a1.WAFRuleExclusion{
+							{
+								PathPrefix: new("/search"),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"at least one of ruleIDs or ruleTags must be specified"},
+		},
+		{
+			desc: "waf-exclusion-path-prefix-with-backslash",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("HTTPRoute"),
+								Name:  gwapiv1.ObjectName("httproute"),
+							},
+						},
+					},
+					WAF: &egv1a1.WAF{
+						Engine: egv1a1.WAFEngine{
+							Type: egv1a1.WAFEngineTypeWasm,
+							Wasm: &egv1a1.WasmCodeSource{
+								Type: egv1a1.HTTPWasmCodeSourceType,
+								HTTP: &egv1a1.HTTPWasmCodeSource{
+									URL: "https://www.example.com/coraza-proxy-wasm.wasm",
+								},
+							},
+						},
+						RuleSets: []gwapiv1.LocalObjectReference{
+							{Kind: "ConfigMap", Name: "crs"},
+						},
+						Exclusions: []egv1a1.WAFRuleExclusion{
+							{
+								PathPrefix: new(`/search\`),
+								RuleIDs:    []uint32{942100},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"spec.waf.exclusions[0].pathPrefix"},
+		},
+		{
+			desc: "waf-exclusion-rule-tag-with-backslash",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+								Group: gwapiv1.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1.Kind("HTTPRoute"),
+								Name:  gwapiv1.ObjectName("httproute"),
+							},
+						},
+					},
+					WAF: &egv1a1.WAF{
+						Engine: egv1a1.WAFEngine{
+							Type: egv1a1.WAFEngineTypeWasm,
+							Wasm: &egv1a1.WasmCodeSource{
+								Type: egv1a1.HTTPWasmCodeSourceType,
+								HTTP: &egv1a1.HTTPWasmCodeSource{
+									URL: "https://www.example.com/coraza-proxy-wasm.wasm",
+								},
+							},
+						},
+						RuleSets: []gwapiv1.LocalObjectReference{
+							{Kind: "ConfigMap", Name: "crs"},
+						},
+						Exclusions: []egv1a1.WAFRuleExclusion{
+							{
+								RuleTags: []string{`attack-sqli\`},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"spec.waf.exclusions[0].ruleTags[0]"},
+		},
 	}
 
 	for _, tc := range cases {
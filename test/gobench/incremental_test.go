@@ -0,0 +1,190 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gobench
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+// genIncrementalResources generates the given number of Gateways, and HTTPRoutes spread over
+// these Gateways, each with its own Service, with a BackendTrafficPolicy for every tenth HTTPRoute.
+//
+// The resources are built directly rather than loaded from YAML, because loading validates
+// every document, which takes minutes with thousands of resources.
+// Like the API server, a resource version is set on every resource.
+func genIncrementalResources(gateways, routes int) *resource.Resources {
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: "1"}
+	}
+
+	rs := resource.NewResources()
+	rs.GatewayClass = &gwapiv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "eg", ResourceVersion: "1"},
+		Spec:       gwapiv1.GatewayClassSpec{ControllerName: egv1a1.GatewayControllerName},
+	}
+	rs.Namespaces = append(rs.Namespaces, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", ResourceVersion: "1"},
+	})
+
+	for i := 0; i < gateways; i++ {
+		rs.Gateways = append(rs.Gateways, &gwapiv1.Gateway{
+			ObjectMeta: objectMeta(fmt.Sprintf("eg-%d", i)),
+			Spec: gwapiv1.GatewaySpec{
+				GatewayClassName: "eg",
+				Listeners: []gwapiv1.Listener{
+					{Name: "http", Protocol: gwapiv1.HTTPProtocolType, Port: 80},
+				},
+			},
+		})
+	}
+
+	for i := 0; i < routes; i++ {
+		name := fmt.Sprintf("backend-%d", i)
+		service := fmt.Sprintf("service-backend-%d", i)
+		rs.HTTPRoutes = append(rs.HTTPRoutes, &gwapiv1.HTTPRoute{
+			ObjectMeta: objectMeta(name),
+			Spec: gwapiv1.HTTPRouteSpec{
+				CommonRouteSpec: gwapiv1.CommonRouteSpec{
+					ParentRefs: []gwapiv1.ParentReference{{Name: gwapiv1.ObjectName(fmt.Sprintf("eg-%d", i%gateways))}},
+				},
+				Hostnames: []gwapiv1.Hostname{gwapiv1.Hostname(fmt.Sprintf("www.example-%d.com", i))},
+				Rules: []gwapiv1.HTTPRouteRule{
+					{
+						BackendRefs: []gwapiv1.HTTPBackendRef{
+							{
+								BackendRef: gwapiv1.BackendRef{
+									BackendObjectReference: gwapiv1.BackendObjectReference{
+										Name: gwapiv1.ObjectName(service),
+										Port: new(gwapiv1.PortNumber(8000)),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		rs.Services = append(rs.Services, &corev1.Service{
+			ObjectMeta: objectMeta(service),
+			Spec: corev1.ServiceSpec{
+				ClusterIP: "10.11.12.13",
+				Ports:     []corev1.ServicePort{{Name: "http", Port: 8000, Protocol: corev1.ProtocolTCP}},
+			},
+		})
+		slice := objectMeta(service + "-slice")
+		slice.Labels = map[string]string{discoveryv1.LabelServiceName: service}
+		rs.EndpointSlices = append(rs.EndpointSlices, &discoveryv1.EndpointSlice{
+			ObjectMeta:  slice,
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Ports: []discoveryv1.EndpointPort{
+				{Name: new("http"), Port: new(int32(8000)), Protocol: new(corev1.ProtocolTCP)},
+			},
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"192.168.1.1"}, Conditions: discoveryv1.EndpointConditions{Ready: new(true)}},
+			},
+		})
+
+		if i%10 == 0 {
+			rs.BackendTrafficPolicies = append(rs.BackendTrafficPolicies, &egv1a1.BackendTrafficPolicy{
+				ObjectMeta: objectMeta(fmt.Sprintf("backend-traffic-policy-%d", i)),
+				Spec: egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRefs: []gwapiv1.LocalPolicyTargetReferenceWithSectionName{
+							{
+								LocalPolicyTargetReference: gwapiv1.LocalPolicyTargetReference{
+									Group: gwapiv1.GroupName,
+									Kind:  resource.KindHTTPRoute,
+									Name:  gwapiv1.ObjectName(name),
+								},
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						LoadBalancer: &egv1a1.LoadBalancer{Type: egv1a1.RoundRobinLoadBalancerType},
+					},
+				},
+			})
+		}
+	}
+	return rs
+}
+
+// BenchmarkGatewayAPIIncrementalTranslate measures the translation of the Gateway API resources
+// after a single HTTPRoute is updated, with a full and with an incremental translation.
+func BenchmarkGatewayAPIIncrementalTranslate(b *testing.B) {
+	type benchCase struct {
+		name     string
+		gateways int
+		routes   int
+	}
+	cases := []benchCase{
+		{name: "small", gateways: 10, routes: 100},
+		{name: "medium", gateways: 50, routes: 1000},
+		{name: "large", gateways: 100, routes: 8000},
+	}
+
+	for _, tc := range cases {
+		b.Run(tc.name, func(b *testing.B) {
+			rs := genIncrementalResources(tc.gateways, tc.routes)
+			newTranslator := func() *gatewayapi.Translator {
+				return &gatewayapi.Translator{
+					GatewayControllerName: string(rs.GatewayClass.Spec.ControllerName),
+					GatewayClassName:      gwapiv1.ObjectName(rs.GatewayClass.Name),
+					BackendEnabled:        true,
+					Logger:                logging.DefaultLogger(io.Discard, egv1a1.LogLevelInfo),
+				}
+			}
+			// updateRoute replaces the first HTTPRoute with an updated copy, as the provider does.
+			updates := 0
+			updateRoute := func() {
+				updates++
+				route := rs.HTTPRoutes[0].DeepCopy()
+				route.Spec.Hostnames = []gwapiv1.Hostname{gwapiv1.Hostname(fmt.Sprintf("www.updated-%d.com", updates))}
+				route.ResourceVersion = strconv.Itoa(updates + 1)
+				rs.HTTPRoutes[0] = route
+			}
+
+			b.Run("full", func(b *testing.B) {
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					updateRoute()
+					// Translation errors, such as the missing Envoy TLS secret, are reported in the
+					// result and don't matter here.
+					_, _ = newTranslator().Translate(rs)
+				}
+			})
+
+			b.Run("incremental", func(b *testing.B) {
+				it := &gatewayapi.IncrementalTranslator{}
+				_, _, _ = it.Translate(newTranslator(), rs)
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					updateRoute()
+					_, irKeys, _ := it.Translate(newTranslator(), rs)
+					if irKeys.Len() != 1 {
+						b.Fatalf("expected a single Gateway to be translated, got %v", irKeys)
+					}
+				}
+			})
+		})
+	}
+}